@@ -29,6 +29,7 @@ func (server *Server) createAccount(ctx *gin.Context) {
 		Owner:    authPayload.Username,
 		Currency: req.Currency,
 		Balance:  0,
+		Type:     db.AccountTypeChecking,
 	}
 
 	account, err := server.store.CreateAccount(ctx, arg)
@@ -151,7 +152,7 @@ func (server *Server) updateAccount(ctx *gin.Context) {
 
 	arg := db.UpdateAccountParams{
 		ID:      uriReq.ID,
-		Balance: util.FloatToCents(jsonReq.Balance),
+		Balance: util.ToMinorUnits(jsonReq.Balance, account.Currency),
 	}
 
 	updatedAccount, err := server.store.UpdateAccount(ctx, arg)
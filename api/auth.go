@@ -6,6 +6,7 @@ import (
 	"time"
 
 	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
+	"github.com/a7medalyapany/GoBank.git/token"
 	"github.com/a7medalyapany/GoBank.git/util"
 	"github.com/gin-gonic/gin"
 	"github.com/jackc/pgx/v5"
@@ -53,6 +54,7 @@ func (server *Server)loginUser(ctx *gin.Context) {
 
 	accessToken, accessPayload, err := server.tokenMaker.CreateToken(
 		user.Username,
+		token.TokenTypeAccess,
 		server.config.ACCESS_TOKEN_DURATION,
 	)
 	if err != nil {
@@ -62,6 +64,7 @@ func (server *Server)loginUser(ctx *gin.Context) {
 
 	refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(
 		user.Username,
+		token.TokenTypeRefresh,
 		server.config.REFRESH_TOKEN_DURATION,
 	)
 	if err != nil {
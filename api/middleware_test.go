@@ -14,7 +14,7 @@ import (
 
 
 func addAuthorization(t *testing.T, request *http.Request, tokenMaker token.Maker, authorizationType string, username string, duration time.Duration) {
-	token, _, err := tokenMaker.CreateToken(username, duration)
+	token, _, err := tokenMaker.CreateToken(username, token.TokenTypeAccess, duration)
 	require.NoError(t, err)
 
 	authorizationHeader := fmt.Sprintf("%s %s",  authorizationType, token)
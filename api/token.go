@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/a7medalyapany/GoBank.git/token"
 	"github.com/gin-gonic/gin"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -28,7 +29,7 @@ func (server *Server)renewAccessToken(ctx *gin.Context) {
 		return
 	}
 
-	refreshPayload, err := server.tokenMaker.VerifyToken(req.RefreshToken)
+	refreshPayload, err := server.tokenMaker.VerifyToken(req.RefreshToken, token.TokenTypeRefresh)
 	if err != nil {
 		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
 		return
@@ -71,7 +72,10 @@ func (server *Server)renewAccessToken(ctx *gin.Context) {
 
 	accessToken, accessPayload, err := server.tokenMaker.CreateToken(
 		refreshPayload.Username,
+		token.TokenTypeAccess,
 		server.config.ACCESS_TOKEN_DURATION,
+		token.WithScopes(refreshPayload.Scopes...),
+		token.WithAudience(refreshPayload.Audience),
 	)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
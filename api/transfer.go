@@ -26,8 +26,8 @@ func (server *Server) createTransfer(ctx *gin.Context) {
         return
     }
 
-    // Convert dollars to cents
-    amountCents := util.FloatToCents(req.Amount) // ← e.g., 10.50 → 1050
+    // Convert to minor units of the currency
+    amount := util.ToMinorUnits(req.Amount, req.Currency) // ← e.g., 10.50 USD → 1050
 
     // Validate accounts
     fromAccount, valid := server.validAccount(ctx, req.FromAccountID, req.Currency)
@@ -47,11 +47,11 @@ func (server *Server) createTransfer(ctx *gin.Context) {
     }
 
     // Check sufficient balance (simple integer comparison!)
-    if fromAccount.Balance < amountCents {
-        err := fmt.Errorf("insufficient balance: account %d has $%.2f, but transfer requires $%.2f",
+    if fromAccount.Balance < amount {
+        err := fmt.Errorf("insufficient balance: account %d has %s, but transfer requires %s",
             req.FromAccountID,
-            util.CentsToFloat(fromAccount.Balance),
-            req.Amount,
+            util.FormatMoney(fromAccount.Balance, fromAccount.Currency),
+            util.FormatMoney(amount, req.Currency),
         )
         ctx.JSON(http.StatusBadRequest, errorResponse(err))
         return
@@ -60,7 +60,7 @@ func (server *Server) createTransfer(ctx *gin.Context) {
     arg := db.TransferTxParams{
         FromAccountID: req.FromAccountID,
         ToAccountID:   req.ToAccountID,
-        Amount:        amountCents,
+        Amount:        amount,
     }
 
     result, err := server.store.TransferTx(ctx, arg)
@@ -46,6 +46,7 @@ func (server *Server) createUser(ctx *gin.Context) {
 		HashedPassword: hashedPassword,
 		FullName: req.FullName,
 		Email: req.Email,
+		Locale: util.DefaultLocale,
 	}
 
 	user, err := server.store.CreateUser(ctx, arg)
@@ -0,0 +1,28 @@
+CREATE TABLE "notifications" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "type" varchar NOT NULL,
+  "title" varchar NOT NULL,
+  "body" varchar NOT NULL,
+  "transfer_id" bigint,
+  "emailed_at" timestamptz,
+  "read_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "notification_preferences" (
+  "username" varchar PRIMARY KEY,
+  "transfer_email" boolean NOT NULL DEFAULT true,
+  "transfer_in_app" boolean NOT NULL DEFAULT true,
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "notifications" ("username", "id");
+
+CREATE UNIQUE INDEX ON "notifications" ("username", "type", "transfer_id");
+
+ALTER TABLE "notifications" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "notifications" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "notification_preferences" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
@@ -0,0 +1,19 @@
+CREATE TABLE "email_changes" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "old_email" varchar NOT NULL,
+  "old_email_verified" boolean NOT NULL,
+  "new_email" varchar NOT NULL,
+  "secret_code" varchar NOT NULL,
+  "cancel_code" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "expires_at" timestamptz NOT NULL DEFAULT (now() + interval '24 hours'),
+  "completed_at" timestamptz
+);
+
+CREATE INDEX ON "email_changes" ("username", "status");
+
+COMMENT ON COLUMN "email_changes"."status" IS 'pending | confirmed | cancelled';
+
+ALTER TABLE "email_changes" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
@@ -0,0 +1,3 @@
+ALTER TABLE "outbox" ADD COLUMN "headers" jsonb NOT NULL DEFAULT '{}';
+
+COMMENT ON COLUMN "outbox"."headers" IS 'task headers, e.g. W3C trace context';
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "ledger_chain";
+DROP FUNCTION IF EXISTS "ledger_chain_append_only"();
@@ -0,0 +1,28 @@
+CREATE TABLE "ledger_chain" (
+  "seq" bigserial PRIMARY KEY,
+  "transfer_id" bigint UNIQUE NOT NULL,
+  "prev_hash" bytea NOT NULL,
+  "hash" bytea NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "ledger_chain" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+COMMENT ON COLUMN "ledger_chain"."prev_hash" IS 'hash of the previous link; 32 zero bytes for the first';
+
+COMMENT ON COLUMN "ledger_chain"."hash" IS 'SHA-256 of prev_hash and the transfer with its entries';
+
+-- Links are append-only, like audit_events.
+CREATE FUNCTION "ledger_chain_append_only"() RETURNS trigger AS $$
+BEGIN
+  RAISE EXCEPTION 'ledger_chain is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER "ledger_chain_no_update_delete"
+  BEFORE UPDATE OR DELETE ON "ledger_chain"
+  FOR EACH ROW EXECUTE FUNCTION "ledger_chain_append_only"();
+
+CREATE TRIGGER "ledger_chain_no_truncate"
+  BEFORE TRUNCATE ON "ledger_chain"
+  FOR EACH STATEMENT EXECUTE FUNCTION "ledger_chain_append_only"();
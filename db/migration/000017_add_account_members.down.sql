@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS "accounts_add_owner_member" ON "accounts";
+DROP FUNCTION IF EXISTS "account_members_add_owner"();
+DROP TABLE IF EXISTS "account_members";
@@ -0,0 +1,41 @@
+CREATE TABLE "account_members" (
+  "account_id" bigint NOT NULL,
+  "username" varchar NOT NULL,
+  "role" varchar NOT NULL,
+  "spend_limit" bigint,
+  "status" varchar NOT NULL DEFAULT 'invited',
+  "invited_by" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "accepted_at" timestamptz,
+  PRIMARY KEY ("account_id", "username")
+);
+
+CREATE INDEX ON "account_members" ("username", "status");
+
+COMMENT ON COLUMN "account_members"."role" IS 'owner | co_owner | viewer | spender';
+
+COMMENT ON COLUMN "account_members"."spend_limit" IS 'largest transfer a spender may make, in minor units of the account currency';
+
+COMMENT ON COLUMN "account_members"."status" IS 'invited | active';
+
+ALTER TABLE "account_members" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "account_members" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "account_members" ADD FOREIGN KEY ("invited_by") REFERENCES "users" ("username");
+
+-- Every account has its owner as an active member, however it was created.
+CREATE FUNCTION "account_members_add_owner"() RETURNS trigger AS $$
+BEGIN
+  INSERT INTO "account_members" ("account_id", "username", "role", "status", "invited_by", "accepted_at")
+  VALUES (NEW."id", NEW."owner", 'owner', 'active', NEW."owner", NEW."created_at");
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER "accounts_add_owner_member"
+  AFTER INSERT ON "accounts"
+  FOR EACH ROW EXECUTE FUNCTION "account_members_add_owner"();
+
+INSERT INTO "account_members" ("account_id", "username", "role", "status", "invited_by", "accepted_at")
+SELECT "id", "owner", 'owner', 'active', "owner", "created_at" FROM "accounts";
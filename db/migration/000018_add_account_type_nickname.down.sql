@@ -0,0 +1,6 @@
+ALTER TABLE IF EXISTS "accounts" DROP COLUMN IF EXISTS "nickname";
+
+ALTER TABLE IF EXISTS "accounts" DROP COLUMN IF EXISTS "type";
+
+-- Fails while any user still has two accounts in the same currency.
+ALTER TABLE IF EXISTS "accounts" ADD CONSTRAINT "owner_currency_key" UNIQUE ("owner", "currency");
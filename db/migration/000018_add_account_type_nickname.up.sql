@@ -0,0 +1,9 @@
+ALTER TABLE "accounts" DROP CONSTRAINT IF EXISTS "owner_currency_key";
+
+ALTER TABLE "accounts" ADD COLUMN "type" varchar NOT NULL DEFAULT 'checking';
+
+ALTER TABLE "accounts" ADD COLUMN "nickname" varchar NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN "accounts"."type" IS 'checking | savings';
+
+COMMENT ON COLUMN "accounts"."nickname" IS 'name the owner or a co-owner gave the account, empty when unnamed';
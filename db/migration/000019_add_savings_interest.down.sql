@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS "interest_accruals";
+DROP TABLE IF EXISTS "interest_postings";
+DROP INDEX IF EXISTS "accounts_currency_idx";
+
+COMMENT ON COLUMN "accounts"."type" IS 'checking | savings';
+
+-- The system user and its accounts are kept: their transfers are part of the
+-- ledger.
@@ -0,0 +1,60 @@
+CREATE TABLE "interest_accruals" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "accrual_date" date NOT NULL,
+  "balance" bigint NOT NULL,
+  "annual_rate_bps" bigint NOT NULL,
+  "amount_micros" bigint NOT NULL,
+  "posting_id" bigint,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "interest_postings" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "transfer_id" bigint,
+  "amount" bigint NOT NULL,
+  "accrued_micros" bigint NOT NULL,
+  "carry_micros" bigint NOT NULL,
+  "period_start" date NOT NULL,
+  "period_end" date NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX ON "interest_accruals" ("account_id", "accrual_date");
+
+CREATE INDEX ON "interest_postings" ("account_id", "id");
+
+-- At most one interest expense account per currency.
+CREATE UNIQUE INDEX ON "accounts" ("currency") WHERE "type" = 'interest_expense';
+
+COMMENT ON COLUMN "accounts"."type" IS 'checking | savings | interest_expense (system accounts that pay interest)';
+
+COMMENT ON COLUMN "interest_accruals"."balance" IS 'balance the day''s interest was computed on, in minor units';
+
+COMMENT ON COLUMN "interest_accruals"."annual_rate_bps" IS 'annual rate in basis points, 250 = 2.50%';
+
+COMMENT ON COLUMN "interest_accruals"."amount_micros" IS 'interest for the day in millionths of a minor unit';
+
+COMMENT ON COLUMN "interest_accruals"."posting_id" IS 'posting that paid this accrual; NULL until posted';
+
+COMMENT ON COLUMN "interest_postings"."transfer_id" IS 'transfer from the interest expense account; NULL when amount is 0';
+
+COMMENT ON COLUMN "interest_postings"."amount" IS 'interest credited, in minor units';
+
+COMMENT ON COLUMN "interest_postings"."accrued_micros" IS 'accruals posted plus the previous carry, in millionths of a minor unit';
+
+COMMENT ON COLUMN "interest_postings"."carry_micros" IS 'fraction of a minor unit left over, added to the next posting';
+
+ALTER TABLE "interest_accruals" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "interest_accruals" ADD FOREIGN KEY ("posting_id") REFERENCES "interest_postings" ("id");
+
+ALTER TABLE "interest_postings" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "interest_postings" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+-- Owner of the interest expense accounts. It has no password, so nobody can
+-- log in as it.
+INSERT INTO "users" ("username", "hashed_password", "full_name", "email", "is_email_verified")
+VALUES ('gobank_system', '', 'GoBank', 'system@gobank.invalid', true);
@@ -0,0 +1,32 @@
+ALTER TABLE "accounts" ADD COLUMN "account_number" varchar;
+
+ALTER TABLE "accounts" ADD CONSTRAINT "accounts_account_number_key" UNIQUE ("account_number");
+
+COMMENT ON COLUMN "accounts"."account_number" IS 'public IBAN-style number: GO, two mod-97 check digits and 12 random digits';
+
+-- Account numbers look like IBANs: the prefix GO, two check digits and 12
+-- random digits. The digits are not derived from the id, so they reveal
+-- neither how many accounts exist nor their order. As in IBAN (ISO 7064
+-- MOD 97-10), the check digits make the number, with its first four
+-- characters moved to the end and letters replaced by 10-35, equal 1 mod 97,
+-- so a mistyped number is rejected before it is looked up.
+CREATE FUNCTION "generate_account_number"() RETURNS varchar AS $$
+DECLARE
+  bban varchar;
+  number varchar;
+BEGIN
+  LOOP
+    bban := lpad(floor(random() * 1e12)::bigint::text, 12, '0');
+    -- 1624 is GO; 00 stands in for the check digits.
+    number := 'GO' || lpad((98 - (bban || '162400')::numeric % 97)::text, 2, '0') || bban;
+    EXIT WHEN NOT EXISTS (SELECT 1 FROM "accounts" WHERE "account_number" = number);
+  END LOOP;
+  RETURN number;
+END;
+$$ LANGUAGE plpgsql VOLATILE;
+
+UPDATE "accounts" SET "account_number" = generate_account_number();
+
+ALTER TABLE "accounts" ALTER COLUMN "account_number" SET DEFAULT generate_account_number();
+
+ALTER TABLE "accounts" ALTER COLUMN "account_number" SET NOT NULL;
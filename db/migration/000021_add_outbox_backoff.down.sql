@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS "outbox_next_attempt_at_idx";
+
+CREATE INDEX ON "outbox" ("id") WHERE "published_at" IS NULL;
+
+ALTER TABLE "outbox" DROP COLUMN IF EXISTS "dead_at";
+
+ALTER TABLE "outbox" DROP COLUMN IF EXISTS "next_attempt_at";
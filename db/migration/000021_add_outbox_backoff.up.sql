@@ -0,0 +1,11 @@
+ALTER TABLE "outbox" ADD COLUMN "next_attempt_at" timestamptz NOT NULL DEFAULT (now());
+
+ALTER TABLE "outbox" ADD COLUMN "dead_at" timestamptz;
+
+COMMENT ON COLUMN "outbox"."next_attempt_at" IS 'the relay skips the message until then; pushed back exponentially after each failure';
+
+COMMENT ON COLUMN "outbox"."dead_at" IS 'set once publishing failed max attempts times; dead messages are no longer retried';
+
+DROP INDEX IF EXISTS "outbox_id_idx";
+
+CREATE INDEX ON "outbox" ("next_attempt_at") WHERE "published_at" IS NULL AND "dead_at" IS NULL;
@@ -0,0 +1,5 @@
+COMMENT ON COLUMN "account_members"."spend_limit" IS 'largest transfer a spender may make, in minor units of the account currency';
+
+DROP INDEX IF EXISTS "transfers_from_account_id_initiated_by_created_at_idx";
+
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "initiated_by";
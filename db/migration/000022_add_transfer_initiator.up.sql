@@ -0,0 +1,7 @@
+ALTER TABLE "transfers" ADD COLUMN "initiated_by" varchar;
+
+COMMENT ON COLUMN "transfers"."initiated_by" IS 'username that made the transfer; null for transfers the bank makes, such as interest';
+
+CREATE INDEX ON "transfers" ("from_account_id", "initiated_by", "created_at");
+
+COMMENT ON COLUMN "account_members"."spend_limit" IS 'most a spender may transfer from the account in any 24 hours, in minor units of the account currency';
@@ -0,0 +1 @@
+ALTER TABLE "ledger_chain" DROP COLUMN IF EXISTS "version";
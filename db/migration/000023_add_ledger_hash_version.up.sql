@@ -0,0 +1,5 @@
+-- Links written so far used the first encoding, which leaves out
+-- transfers.initiated_by; new links name the encoding they use.
+ALTER TABLE "ledger_chain" ADD COLUMN "version" smallint NOT NULL DEFAULT 1;
+
+COMMENT ON COLUMN "ledger_chain"."version" IS 'encoding of the hash: 1 leaves out transfers.initiated_by, 2 covers it';
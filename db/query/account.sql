@@ -1,12 +1,16 @@
 -- name: CreateAccount :one
-INSERT INTO accounts (owner, balance, currency) 
-VALUES ($1, $2, $3)
+INSERT INTO accounts (owner, balance, currency, type, nickname) 
+VALUES ($1, $2, $3, $4, $5)
 RETURNING *;
 
 -- name: GetAccount :one
 SELECT * FROM accounts 
 WHERE id = $1;
 
+-- name: GetAccountByNumber :one
+SELECT * FROM accounts
+WHERE account_number = $1;
+
 -- name: GetAccountForUpdate :one
 SELECT * FROM accounts 
 WHERE id = $1
@@ -25,6 +29,12 @@ SET balance = $2
 WHERE id = $1
 RETURNING *;
 
+-- name: UpdateAccountNickname :one
+UPDATE accounts
+SET nickname = $2
+WHERE id = $1
+RETURNING *;
+
 -- name: AddAccountBalance :one
 UPDATE accounts
 SET balance = balance + sqlc.arg(amount)
@@ -0,0 +1,48 @@
+-- name: CreateAccountMember :one
+INSERT INTO account_members (account_id, username, role, spend_limit, invited_by)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetAccountMember :one
+SELECT * FROM account_members
+WHERE account_id = $1 AND username = $2 LIMIT 1;
+
+-- name: ListAccountMembers :many
+SELECT * FROM account_members
+WHERE account_id = $1
+ORDER BY created_at, username;
+
+-- name: ListActiveMemberUsernames :many
+-- Everyone with access to an account, its owner included.
+SELECT username FROM account_members
+WHERE account_id = $1 AND status = 'active'
+ORDER BY created_at, username;
+
+-- name: ListAccountInvitations :many
+-- Pending invitations sent to username, newest first.
+SELECT * FROM account_members
+WHERE username = $1 AND status = 'invited'
+ORDER BY created_at DESC;
+
+-- name: AcceptAccountInvitation :one
+UPDATE account_members
+SET status = 'active',
+    accepted_at = now()
+WHERE account_id = $1 AND username = $2 AND status = 'invited'
+RETURNING *;
+
+-- name: DeleteAccountMember :execrows
+DELETE FROM account_members
+WHERE account_id = $1 AND username = $2;
+
+-- name: ListMemberAccounts :many
+-- Accounts username is an active member of, in any role. The currency and
+-- type filters are optional.
+SELECT accounts.* FROM accounts
+JOIN account_members m ON m.account_id = accounts.id
+WHERE m.username = sqlc.arg(username) AND m.status = 'active'
+  AND (sqlc.narg(currency)::varchar IS NULL OR accounts.currency = sqlc.narg(currency))
+  AND (sqlc.narg(type)::varchar IS NULL OR accounts.type = sqlc.narg(type))
+ORDER BY accounts.id
+LIMIT sqlc.arg(limit_arg)
+OFFSET sqlc.arg(offset_arg);
@@ -0,0 +1,28 @@
+-- name: CreateApiKey :one
+INSERT INTO api_keys (username, name, prefix, hashed_key, scopes, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetApiKeyByPrefix :one
+SELECT * FROM api_keys
+WHERE prefix = $1 LIMIT 1;
+
+-- name: ListApiKeys :many
+SELECT * FROM api_keys
+WHERE username = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3;
+
+-- name: RevokeApiKey :one
+UPDATE api_keys
+SET revoked_at = now()
+WHERE id = $1
+  AND username = $2
+  AND revoked_at IS NULL
+RETURNING *;
+
+-- name: UpdateApiKeyLastUsed :exec
+UPDATE api_keys
+SET last_used_at = now()
+WHERE id = $1;
@@ -0,0 +1,43 @@
+-- name: CreateEmailChange :one
+INSERT INTO email_changes (
+  username,
+  old_email,
+  old_email_verified,
+  new_email,
+  secret_code,
+  cancel_code
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: GetEmailChange :one
+SELECT * FROM email_changes
+WHERE id = $1 LIMIT 1;
+
+-- name: GetEmailChangeForUpdate :one
+SELECT * FROM email_changes
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: GetPendingEmailChange :one
+SELECT * FROM email_changes
+WHERE username = $1
+  AND status = 'pending'
+  AND expires_at > now()
+ORDER BY id DESC
+LIMIT 1;
+
+-- name: CancelPendingEmailChanges :execrows
+-- Supersedes earlier requests when the user asks for another address.
+UPDATE email_changes
+SET status = 'cancelled',
+    completed_at = now()
+WHERE username = $1
+  AND status = 'pending';
+
+-- name: UpdateEmailChangeStatus :one
+UPDATE email_changes
+SET status = $2,
+    completed_at = now()
+WHERE id = $1
+RETURNING *;
@@ -19,6 +19,7 @@ ORDER BY id
 LIMIT $2 OFFSET $3;
 
 -- name: ListActivityEntries :many
+-- Entries of every account username is an active member of.
 SELECT
   e.id,
   e.account_id,
@@ -37,6 +38,16 @@ LEFT JOIN accounts ca ON ca.id = CASE
   WHEN t.to_account_id = e.account_id THEN t.from_account_id
   ELSE NULL
 END
-WHERE a.owner = sqlc.arg(owner)
+WHERE EXISTS (
+  SELECT 1 FROM account_members m
+  WHERE m.account_id = a.id
+    AND m.username = sqlc.arg(username)
+    AND m.status = 'active'
+)
 ORDER BY e.created_at DESC, e.id DESC
 LIMIT sqlc.arg(limit_arg) OFFSET sqlc.arg(offset_arg);
+
+-- name: ListTransferEntries :many
+SELECT * FROM entries
+WHERE transfer_id = ANY(sqlc.arg(transfer_ids)::bigint[])
+ORDER BY id;
@@ -0,0 +1,87 @@
+-- name: CreateInterestAccrual :one
+-- Returns no row when the day was already accrued for the account.
+INSERT INTO interest_accruals (
+  account_id,
+  accrual_date,
+  balance,
+  annual_rate_bps,
+  amount_micros
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+ON CONFLICT (account_id, accrual_date) DO NOTHING
+RETURNING *;
+
+-- name: ListSavingsAccounts :many
+-- Savings accounts with an id above after_id, for jobs that page through them.
+SELECT * FROM accounts
+WHERE type = 'savings' AND id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT sqlc.arg(limit_arg);
+
+-- name: ListUnpostedInterestAccounts :many
+-- Accounts with an id above after_id that have accruals before before not
+-- posted yet.
+SELECT DISTINCT account_id FROM interest_accruals
+WHERE posting_id IS NULL
+  AND accrual_date < sqlc.arg(before)
+  AND account_id > sqlc.arg(after_id)
+ORDER BY account_id
+LIMIT sqlc.arg(limit_arg);
+
+-- name: GetUnpostedInterest :one
+-- Total of the accruals of account_id before before that are not posted yet.
+SELECT
+  COUNT(*) AS accruals,
+  COALESCE(SUM(amount_micros), 0)::bigint AS amount_micros,
+  MIN(accrual_date)::date AS period_start,
+  MAX(accrual_date)::date AS period_end
+FROM interest_accruals
+WHERE account_id = sqlc.arg(account_id)
+  AND posting_id IS NULL
+  AND accrual_date < sqlc.arg(before);
+
+-- name: MarkInterestAccrualsPosted :execrows
+UPDATE interest_accruals
+SET posting_id = sqlc.arg(posting_id)
+WHERE account_id = sqlc.arg(account_id)
+  AND posting_id IS NULL
+  AND accrual_date < sqlc.arg(before);
+
+-- name: CreateInterestPosting :one
+INSERT INTO interest_postings (
+  account_id,
+  transfer_id,
+  amount,
+  accrued_micros,
+  carry_micros,
+  period_start,
+  period_end
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+)
+RETURNING *;
+
+-- name: GetLastInterestPosting :one
+SELECT * FROM interest_postings
+WHERE account_id = $1
+ORDER BY id DESC
+LIMIT 1;
+
+-- name: ListInterestPostings :many
+-- Newest first.
+SELECT * FROM interest_postings
+WHERE account_id = sqlc.arg(account_id)
+ORDER BY id DESC
+LIMIT sqlc.arg(limit_arg)
+OFFSET sqlc.arg(offset_arg);
+
+-- name: CreateInterestExpenseAccount :exec
+-- Opens the interest expense account of currency unless it already exists.
+INSERT INTO accounts (owner, balance, currency, type, nickname)
+VALUES (sqlc.arg(owner), 0, sqlc.arg(currency), 'interest_expense', 'Interest expense')
+ON CONFLICT (currency) WHERE type = 'interest_expense' DO NOTHING;
+
+-- name: GetInterestExpenseAccount :one
+SELECT * FROM accounts
+WHERE type = 'interest_expense' AND currency = $1;
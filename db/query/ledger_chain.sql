@@ -0,0 +1,26 @@
+-- name: LockLedgerChain :exec
+-- Serializes appends to the chain until the transaction ends.
+SELECT pg_advisory_xact_lock(hashtext('ledger_chain'));
+
+-- name: GetLedgerChainHead :one
+SELECT * FROM ledger_chain
+ORDER BY seq DESC
+LIMIT 1;
+
+-- name: CreateLedgerLink :one
+INSERT INTO ledger_chain (
+  transfer_id,
+  prev_hash,
+  hash,
+  version
+) VALUES (
+  $1, $2, $3, $4
+)
+RETURNING *;
+
+-- name: ListLedgerChain :many
+-- Links in chain order, starting after after_seq.
+SELECT * FROM ledger_chain
+WHERE seq > sqlc.arg(after_seq)
+ORDER BY seq
+LIMIT sqlc.arg(limit_arg);
@@ -0,0 +1,44 @@
+-- name: CreateNotification :one
+-- Idempotent per (username, type, transfer_id): a retried task gets the
+-- existing row back instead of a duplicate.
+INSERT INTO notifications (username, type, title, body, transfer_id)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (username, type, transfer_id)
+DO UPDATE SET username = EXCLUDED.username
+RETURNING *;
+
+-- name: ListNotifications :many
+SELECT * FROM notifications
+WHERE username = sqlc.arg(username)
+  AND (NOT sqlc.arg(unread_only)::bool OR read_at IS NULL)
+ORDER BY id DESC
+LIMIT sqlc.arg(limit_arg)
+OFFSET sqlc.arg(offset_arg);
+
+-- name: MarkNotificationRead :one
+UPDATE notifications
+SET read_at = COALESCE(read_at, now())
+WHERE id = $1 AND username = $2
+RETURNING *;
+
+-- name: MarkNotificationEmailed :exec
+UPDATE notifications
+SET emailed_at = now()
+WHERE id = $1;
+
+-- name: GetNotificationPreferences :one
+SELECT * FROM notification_preferences
+WHERE username = $1 LIMIT 1;
+
+-- name: UpsertNotificationPreferences :one
+INSERT INTO notification_preferences (username, transfer_email, transfer_in_app)
+VALUES (
+  sqlc.arg(username),
+  COALESCE(sqlc.narg(transfer_email), true),
+  COALESCE(sqlc.narg(transfer_in_app), true)
+)
+ON CONFLICT (username) DO UPDATE SET
+  transfer_email = COALESCE(sqlc.narg(transfer_email), notification_preferences.transfer_email),
+  transfer_in_app = COALESCE(sqlc.narg(transfer_in_app), notification_preferences.transfer_in_app),
+  updated_at = now()
+RETURNING *;
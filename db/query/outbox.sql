@@ -0,0 +1,41 @@
+-- name: CreateOutboxMessage :one
+INSERT INTO outbox (task_type, payload, queue, max_retry, process_at, headers)
+VALUES ($1, $2, $3, $4, COALESCE(sqlc.narg(process_at)::timestamptz, now()), COALESCE(sqlc.narg(headers)::jsonb, '{}'))
+RETURNING *;
+
+-- name: GetOutboxMessage :one
+SELECT * FROM outbox
+WHERE id = $1 LIMIT 1;
+
+-- name: ListPendingOutboxMessages :many
+-- Locks the returned rows so concurrent relays never publish the same message.
+-- Dead messages and messages waiting out a backoff are skipped.
+SELECT * FROM outbox
+WHERE published_at IS NULL
+  AND dead_at IS NULL
+  AND next_attempt_at <= now()
+ORDER BY id
+LIMIT $1
+FOR UPDATE SKIP LOCKED;
+
+-- name: MarkOutboxMessagePublished :exec
+UPDATE outbox
+SET published_at = now(),
+    attempts = attempts + 1,
+    last_error = NULL
+WHERE id = $1;
+
+-- name: MarkOutboxMessageFailed :one
+-- Schedules the next attempt, or marks the message dead once it has failed
+-- max_attempts times.
+UPDATE outbox
+SET attempts = attempts + 1,
+    last_error = sqlc.arg(last_error),
+    next_attempt_at = sqlc.arg(next_attempt_at),
+    dead_at = CASE WHEN attempts + 1 >= sqlc.arg(max_attempts)::int THEN now() END
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: DeletePublishedOutboxMessages :execrows
+DELETE FROM outbox
+WHERE published_at < $1;
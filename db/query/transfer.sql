@@ -1,13 +1,25 @@
 -- name: CreateTransfer :one
-INSERT INTO transfers (from_account_id, to_account_id, amount) 
-VALUES ($1, $2, $3)
+INSERT INTO transfers (from_account_id, to_account_id, amount, initiated_by) 
+VALUES ($1, $2, $3, $4)
 RETURNING *;
 
 -- name: GetTransfer :one
 SELECT * FROM transfers 
 WHERE id = $1;
 
+-- name: SumTransfersByInitiator :one
+-- Totals what initiated_by has transferred from an account since a given time.
+SELECT COALESCE(SUM(amount), 0)::bigint AS total
+FROM transfers
+WHERE from_account_id = sqlc.arg(from_account_id)
+  AND initiated_by = sqlc.arg(initiated_by)
+  AND created_at > sqlc.arg(since);
+
 -- name: ListTransfers :many
 SELECT * FROM transfers
 ORDER BY id
-LIMIT $1 OFFSET $2;
\ No newline at end of file
+LIMIT $1 OFFSET $2;
+-- name: ListTransfersByID :many
+SELECT * FROM transfers
+WHERE id = ANY(sqlc.arg(ids)::bigint[])
+ORDER BY id;
@@ -1,6 +1,6 @@
 -- name: CreateUser :one
-INSERT INTO users (username, hashed_password, full_name, email) 
-VALUES ($1, $2, $3, $4)
+INSERT INTO users (username, hashed_password, full_name, email, locale) 
+VALUES ($1, $2, $3, $4, $5)
 RETURNING *;
 
 -- name: GetUser :one
@@ -14,6 +14,14 @@ SET
 	password_changed_at = COALESCE(sqlc.narg(password_changed_at), password_changed_at),
 	full_name = COALESCE(sqlc.narg(full_name), full_name),
 	email = COALESCE(sqlc.narg(email), email),
-	is_email_verified = COALESCE(sqlc.narg(is_email_verified), is_email_verified)
+	is_email_verified = COALESCE(sqlc.narg(is_email_verified), is_email_verified),
+	locale = COALESCE(sqlc.narg(locale), locale)
 WHERE username = sqlc.arg(username)
 RETURNING *;
+
+-- name: GetUserForUpdate :one
+-- Locks the user row until the end of the transaction, so per-user checks
+-- such as resend cooldowns cannot race.
+SELECT * FROM users
+WHERE username = $1 LIMIT 1
+FOR NO KEY UPDATE;
@@ -9,4 +9,28 @@ WHERE id = $1
   AND secret_code = $2
   AND is_used = false
   AND expires_at > now()
-RETURNING *;
\ No newline at end of file
+RETURNING *;
+
+-- name: GetVerifyEmail :one
+SELECT * FROM verify_emails
+WHERE id = $1 LIMIT 1;
+
+-- name: GetLatestVerifyEmail :one
+SELECT * FROM verify_emails
+WHERE username = $1
+ORDER BY id DESC
+LIMIT 1;
+
+-- name: ExpireVerifyEmails :execrows
+-- Invalidates every unused code of a user, so only the newest link works.
+UPDATE verify_emails SET expires_at = now()
+WHERE username = $1
+  AND is_used = false
+  AND expires_at > now();
+
+-- name: DeleteExpiredVerifyEmails :execrows
+-- Purges unused codes that expired before the given time. Used codes are
+-- kept as a record of when the address was verified.
+DELETE FROM verify_emails
+WHERE is_used = false
+  AND expires_at < $1;
@@ -0,0 +1,69 @@
+-- name: CreateWebhookEndpoint :one
+INSERT INTO webhook_endpoints (username, url, secret, event_types)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetWebhookEndpoint :one
+SELECT * FROM webhook_endpoints
+WHERE id = $1 LIMIT 1;
+
+-- name: ListWebhookEndpoints :many
+SELECT * FROM webhook_endpoints
+WHERE username = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3;
+
+-- name: ListWebhookEndpointsForEvent :many
+SELECT * FROM webhook_endpoints
+WHERE username = sqlc.arg(username)
+  AND sqlc.arg(event_type)::varchar = ANY(event_types)
+ORDER BY id;
+
+-- name: DeleteWebhookEndpoint :execrows
+DELETE FROM webhook_endpoints
+WHERE id = $1 AND username = $2;
+
+-- name: CreateWebhookDelivery :one
+-- Idempotent per (endpoint_id, event_id): a retried dispatch gets the
+-- existing delivery back instead of a duplicate.
+INSERT INTO webhook_deliveries (endpoint_id, event_id, event_type, payload)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (endpoint_id, event_id)
+DO UPDATE SET endpoint_id = EXCLUDED.endpoint_id
+RETURNING *;
+
+-- name: GetWebhookDelivery :one
+SELECT * FROM webhook_deliveries
+WHERE id = $1 LIMIT 1;
+
+-- name: ListWebhookDeliveries :many
+SELECT * FROM webhook_deliveries
+WHERE endpoint_id = sqlc.arg(endpoint_id)
+  AND (sqlc.narg(status)::varchar IS NULL OR status = sqlc.narg(status))
+ORDER BY id DESC
+LIMIT sqlc.arg(limit_arg)
+OFFSET sqlc.arg(offset_arg);
+
+-- name: MarkWebhookDeliverySucceeded :exec
+UPDATE webhook_deliveries
+SET status = 'succeeded',
+    attempts = attempts + 1,
+    response_status = $2,
+    last_error = NULL,
+    delivered_at = now()
+WHERE id = $1;
+
+-- name: MarkWebhookDeliveryFailed :exec
+UPDATE webhook_deliveries
+SET status = sqlc.arg(status),
+    attempts = attempts + 1,
+    response_status = sqlc.narg(response_status),
+    last_error = sqlc.arg(last_error)
+WHERE id = sqlc.arg(id);
+
+-- name: ResetWebhookDelivery :one
+UPDATE webhook_deliveries
+SET status = 'pending'
+WHERE id = $1
+RETURNING *;
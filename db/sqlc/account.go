@@ -0,0 +1,16 @@
+package db
+
+// Types of an account, stored in accounts.type. A user can hold any number
+// of accounts of each type in each currency.
+const (
+	AccountTypeChecking = "checking"
+	AccountTypeSavings  = "savings"
+	// AccountTypeInterestExpense is the system account, one per currency,
+	// that savings interest is paid from. Its balance goes negative as
+	// interest is paid. Users cannot open accounts of this type.
+	AccountTypeInterestExpense = "interest_expense"
+)
+
+// SystemUsername owns the system accounts. It is created by a migration and
+// has no password, so nobody can log in as it.
+const SystemUsername = "gobank_system"
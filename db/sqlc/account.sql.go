@@ -13,7 +13,7 @@ const addAccountBalance = `-- name: AddAccountBalance :one
 UPDATE accounts
 SET balance = balance + $1
 WHERE id = $2
-RETURNING id, owner, balance, currency, created_at
+RETURNING id, owner, balance, currency, created_at, type, nickname, account_number
 `
 
 type AddAccountBalanceParams struct {
@@ -30,24 +30,35 @@ func (q *Queries) AddAccountBalance(ctx context.Context, arg AddAccountBalancePa
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.Type,
+		&i.Nickname,
+		&i.AccountNumber,
 	)
 	return i, err
 }
 
 const createAccount = `-- name: CreateAccount :one
-INSERT INTO accounts (owner, balance, currency) 
-VALUES ($1, $2, $3)
-RETURNING id, owner, balance, currency, created_at
+INSERT INTO accounts (owner, balance, currency, type, nickname) 
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, owner, balance, currency, created_at, type, nickname, account_number
 `
 
 type CreateAccountParams struct {
 	Owner    string `json:"owner"`
 	Balance  int64  `json:"balance"`
 	Currency string `json:"currency"`
+	Type     string `json:"type"`
+	Nickname string `json:"nickname"`
 }
 
 func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error) {
-	row := q.db.QueryRow(ctx, createAccount, arg.Owner, arg.Balance, arg.Currency)
+	row := q.db.QueryRow(ctx, createAccount,
+		arg.Owner,
+		arg.Balance,
+		arg.Currency,
+		arg.Type,
+		arg.Nickname,
+	)
 	var i Account
 	err := row.Scan(
 		&i.ID,
@@ -55,6 +66,9 @@ func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (A
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.Type,
+		&i.Nickname,
+		&i.AccountNumber,
 	)
 	return i, err
 }
@@ -70,7 +84,7 @@ func (q *Queries) DeleteAccount(ctx context.Context, id int64) error {
 }
 
 const getAccount = `-- name: GetAccount :one
-SELECT id, owner, balance, currency, created_at FROM accounts 
+SELECT id, owner, balance, currency, created_at, type, nickname, account_number FROM accounts 
 WHERE id = $1
 `
 
@@ -83,6 +97,9 @@ func (q *Queries) GetAccount(ctx context.Context, id int64) (Account, error) {
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.Type,
+		&i.Nickname,
+		&i.AccountNumber,
 	)
 	return i, err
 }
@@ -106,8 +123,29 @@ func (q *Queries) GetAccountBasic(ctx context.Context, id int64) (GetAccountBasi
 	return i, err
 }
 
+const getAccountByNumber = `-- name: GetAccountByNumber :one
+SELECT id, owner, balance, currency, created_at, type, nickname, account_number FROM accounts
+WHERE account_number = $1
+`
+
+func (q *Queries) GetAccountByNumber(ctx context.Context, accountNumber string) (Account, error) {
+	row := q.db.QueryRow(ctx, getAccountByNumber, accountNumber)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.Type,
+		&i.Nickname,
+		&i.AccountNumber,
+	)
+	return i, err
+}
+
 const getAccountForUpdate = `-- name: GetAccountForUpdate :one
-SELECT id, owner, balance, currency, created_at FROM accounts 
+SELECT id, owner, balance, currency, created_at, type, nickname, account_number FROM accounts 
 WHERE id = $1
 FOR NO KEY UPDATE
 `
@@ -121,12 +159,15 @@ func (q *Queries) GetAccountForUpdate(ctx context.Context, id int64) (Account, e
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.Type,
+		&i.Nickname,
+		&i.AccountNumber,
 	)
 	return i, err
 }
 
 const listAccounts = `-- name: ListAccounts :many
-SELECT id, owner, balance, currency, created_at FROM accounts 
+SELECT id, owner, balance, currency, created_at, type, nickname, account_number FROM accounts 
 WHERE owner = $1
 ORDER BY id
 LIMIT $2
@@ -154,6 +195,9 @@ func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]A
 			&i.Balance,
 			&i.Currency,
 			&i.CreatedAt,
+			&i.Type,
+			&i.Nickname,
+			&i.AccountNumber,
 		); err != nil {
 			return nil, err
 		}
@@ -169,7 +213,7 @@ const updateAccount = `-- name: UpdateAccount :one
 UPDATE accounts
 SET balance = $2
 WHERE id = $1
-RETURNING id, owner, balance, currency, created_at
+RETURNING id, owner, balance, currency, created_at, type, nickname, account_number
 `
 
 type UpdateAccountParams struct {
@@ -186,6 +230,37 @@ func (q *Queries) UpdateAccount(ctx context.Context, arg UpdateAccountParams) (A
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.Type,
+		&i.Nickname,
+		&i.AccountNumber,
+	)
+	return i, err
+}
+
+const updateAccountNickname = `-- name: UpdateAccountNickname :one
+UPDATE accounts
+SET nickname = $2
+WHERE id = $1
+RETURNING id, owner, balance, currency, created_at, type, nickname, account_number
+`
+
+type UpdateAccountNicknameParams struct {
+	ID       int64  `json:"id"`
+	Nickname string `json:"nickname"`
+}
+
+func (q *Queries) UpdateAccountNickname(ctx context.Context, arg UpdateAccountNicknameParams) (Account, error) {
+	row := q.db.QueryRow(ctx, updateAccountNickname, arg.ID, arg.Nickname)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.Type,
+		&i.Nickname,
+		&i.AccountNumber,
 	)
 	return i, err
 }
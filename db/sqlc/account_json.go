@@ -14,7 +14,7 @@ func (a Account) MarshalJSON() ([]byte, error) {
 		Balance float64 `json:"balance"` // Override balance field
 		*Alias
 	}{
-		Balance: util.CentsToFloat(a.Balance),
+		Balance: util.FromMinorUnits(a.Balance, a.Currency),
 		Alias:   (*Alias)(&a),
 	})
 }
\ No newline at end of file
@@ -0,0 +1,18 @@
+package db
+
+// Roles of an account member, stored in account_members.role. Every account
+// has exactly one owner, its accounts.owner, added by a trigger when the
+// account is created.
+const (
+	AccountRoleOwner   = "owner"
+	AccountRoleCoOwner = "co_owner"
+	AccountRoleViewer  = "viewer"
+	AccountRoleSpender = "spender"
+)
+
+// Statuses of an account member, stored in account_members.status. Invited
+// members have no access until they accept.
+const (
+	AccountMemberInvited = "invited"
+	AccountMemberActive  = "active"
+)
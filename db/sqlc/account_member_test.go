@@ -0,0 +1,108 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomAccountMember(t *testing.T, account Account, role string) AccountMember {
+	user := createRandomUser(t)
+	arg := CreateAccountMemberParams{
+		AccountID:  account.ID,
+		Username:   user.Username,
+		Role:       role,
+		SpendLimit: pgtype.Int8{Int64: 500, Valid: role == AccountRoleSpender},
+		InvitedBy:  account.Owner,
+	}
+
+	member, err := testQueries.CreateAccountMember(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.AccountID, member.AccountID)
+	require.Equal(t, arg.Username, member.Username)
+	require.Equal(t, arg.Role, member.Role)
+	require.Equal(t, arg.SpendLimit, member.SpendLimit)
+	require.Equal(t, AccountMemberInvited, member.Status)
+	require.Equal(t, account.Owner, member.InvitedBy)
+	require.False(t, member.AcceptedAt.Valid)
+	return member
+}
+
+func TestCreateAccountAddsOwnerMember(t *testing.T) {
+	account := createRandomAccount(t)
+
+	owner, err := testQueries.GetAccountMember(context.Background(), GetAccountMemberParams{
+		AccountID: account.ID,
+		Username:  account.Owner,
+	})
+	require.NoError(t, err)
+	require.Equal(t, AccountRoleOwner, owner.Role)
+	require.Equal(t, AccountMemberActive, owner.Status)
+	require.True(t, owner.AcceptedAt.Valid)
+}
+
+func TestAcceptAccountInvitation(t *testing.T) {
+	account := createRandomAccount(t)
+	invited := createRandomAccountMember(t, account, AccountRoleSpender)
+
+	invitations, err := testQueries.ListAccountInvitations(context.Background(), invited.Username)
+	require.NoError(t, err)
+	require.Len(t, invitations, 1)
+	require.Equal(t, account.ID, invitations[0].AccountID)
+
+	// Invited members do not see the account yet.
+	accounts, err := testQueries.ListMemberAccounts(context.Background(), ListMemberAccountsParams{
+		Username: invited.Username,
+		LimitArg: 5,
+	})
+	require.NoError(t, err)
+	require.Empty(t, accounts)
+
+	member, err := testQueries.AcceptAccountInvitation(context.Background(), AcceptAccountInvitationParams{
+		AccountID: account.ID,
+		Username:  invited.Username,
+	})
+	require.NoError(t, err)
+	require.Equal(t, AccountMemberActive, member.Status)
+	require.True(t, member.AcceptedAt.Valid)
+
+	_, err = testQueries.AcceptAccountInvitation(context.Background(), AcceptAccountInvitationParams{
+		AccountID: account.ID,
+		Username:  invited.Username,
+	})
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	accounts, err = testQueries.ListMemberAccounts(context.Background(), ListMemberAccountsParams{
+		Username: invited.Username,
+		LimitArg: 5,
+	})
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	require.Equal(t, account.ID, accounts[0].ID)
+	require.Equal(t, account.Owner, accounts[0].Owner)
+}
+
+func TestListAndDeleteAccountMembers(t *testing.T) {
+	account := createRandomAccount(t)
+	viewer := createRandomAccountMember(t, account, AccountRoleViewer)
+
+	members, err := testQueries.ListAccountMembers(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Len(t, members, 2)
+	require.Equal(t, account.Owner, members[0].Username)
+	require.Equal(t, viewer.Username, members[1].Username)
+
+	rows, err := testQueries.DeleteAccountMember(context.Background(), DeleteAccountMemberParams{
+		AccountID: account.ID,
+		Username:  viewer.Username,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+
+	members, err = testQueries.ListAccountMembers(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+}
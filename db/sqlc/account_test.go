@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/a7medalyapany/GoBank.git/util"
+	"github.com/a7medalyapany/GoBank.git/val"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
 )
 
@@ -16,6 +18,8 @@ func createRandomAccount(t *testing.T) Account {
 		Owner:    user.Username,
 		Balance:  util.RandomMoney(),
 		Currency: util.RandomCurrency(),
+		Type:     AccountTypeChecking,
+		Nickname: util.RandomString(8),
 	}
 
 	account, err := testQueries.CreateAccount(context.Background(), arg)
@@ -26,6 +30,9 @@ func createRandomAccount(t *testing.T) Account {
 	require.Equal(t, arg.Owner, account.Owner)
 	require.Equal(t, arg.Balance, account.Balance)
 	require.Equal(t, arg.Currency, account.Currency)
+	require.Equal(t, arg.Type, account.Type)
+	require.Equal(t, arg.Nickname, account.Nickname)
+	require.NoError(t, val.ValidateAccountNumber(account.AccountNumber))
 
 	require.NotZero(t, account.ID)
 	require.NotZero(t, account.CreatedAt)
@@ -50,6 +57,18 @@ func TestGetAccount(t *testing.T) {
 	require.WithinDuration(t, account.CreatedAt.Time, retrievedAccount.CreatedAt.Time, time.Second)
 }
 
+func TestGetAccountByNumber(t *testing.T) {
+	account := createRandomAccount(t)
+
+	retrievedAccount, err := testQueries.GetAccountByNumber(context.Background(), account.AccountNumber)
+	require.NoError(t, err)
+	require.Equal(t, account.ID, retrievedAccount.ID)
+	require.Equal(t, account.AccountNumber, retrievedAccount.AccountNumber)
+
+	other := createRandomAccount(t)
+	require.NotEqual(t, account.AccountNumber, other.AccountNumber)
+}
+
 func TestUpdateAccount(t *testing.T) {
 	account := createRandomAccount(t)
 
@@ -101,4 +120,63 @@ func TestListAccounts(t *testing.T) {
 		require.NotEmpty(t, account)
 		require.Equal(t, lastAccount.Owner, account.Owner)
 	}
-}
\ No newline at end of file
+}
+
+func TestCreateAccountsInSameCurrency(t *testing.T) {
+	user := createRandomUser(t)
+
+	checking, err := testQueries.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    user.Username,
+		Currency: util.USD,
+		Type:     AccountTypeChecking,
+	})
+	require.NoError(t, err)
+
+	savings, err := testQueries.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    user.Username,
+		Currency: util.USD,
+		Type:     AccountTypeSavings,
+		Nickname: "Rainy day",
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, checking.ID, savings.ID)
+
+	_, err = testQueries.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    user.Username,
+		Currency: util.EUR,
+		Type:     AccountTypeSavings,
+	})
+	require.NoError(t, err)
+
+	accounts, err := testQueries.ListMemberAccounts(context.Background(), ListMemberAccountsParams{
+		Username: user.Username,
+		Currency: pgtype.Text{String: util.USD, Valid: true},
+		LimitArg: 5,
+	})
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+
+	accounts, err = testQueries.ListMemberAccounts(context.Background(), ListMemberAccountsParams{
+		Username: user.Username,
+		Currency: pgtype.Text{String: util.USD, Valid: true},
+		Type:     pgtype.Text{String: AccountTypeSavings, Valid: true},
+		LimitArg: 5,
+	})
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	require.Equal(t, savings.ID, accounts[0].ID)
+	require.Equal(t, "Rainy day", accounts[0].Nickname)
+}
+
+func TestUpdateAccountNickname(t *testing.T) {
+	account := createRandomAccount(t)
+
+	updatedAccount, err := testQueries.UpdateAccountNickname(context.Background(), UpdateAccountNicknameParams{
+		ID:       account.ID,
+		Nickname: "Rent",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Rent", updatedAccount.Nickname)
+	require.Equal(t, account.Balance, updatedAccount.Balance)
+	require.Equal(t, account.Type, updatedAccount.Type)
+}
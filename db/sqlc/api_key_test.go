@@ -0,0 +1,111 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/a7medalyapany/GoBank.git/token"
+	"github.com/a7medalyapany/GoBank.git/util"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomApiKey(t *testing.T, user User) ApiKey {
+	generated, err := token.GenerateAPIKey()
+	require.NoError(t, err)
+
+	arg := CreateApiKeyParams{
+		Username:  user.Username,
+		Name:      util.RandomString(8),
+		Prefix:    generated.Prefix,
+		HashedKey: generated.HashedKey,
+		Scopes:    []string{token.ScopeAccountsRead},
+		ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(time.Hour), Valid: true},
+	}
+
+	apiKey, err := testQueries.CreateApiKey(context.Background(), arg)
+	require.NoError(t, err)
+
+	require.Equal(t, arg.Username, apiKey.Username)
+	require.Equal(t, arg.Name, apiKey.Name)
+	require.Equal(t, arg.Prefix, apiKey.Prefix)
+	require.Equal(t, arg.HashedKey, apiKey.HashedKey)
+	require.Equal(t, arg.Scopes, apiKey.Scopes)
+	require.WithinDuration(t, arg.ExpiresAt.Time, apiKey.ExpiresAt.Time, time.Second)
+	require.False(t, apiKey.LastUsedAt.Valid)
+	require.False(t, apiKey.RevokedAt.Valid)
+	require.NotZero(t, apiKey.ID)
+	return apiKey
+}
+
+func TestCreateApiKey(t *testing.T) {
+	createRandomApiKey(t, createRandomUser(t))
+}
+
+func TestGetApiKeyByPrefix(t *testing.T) {
+	apiKey := createRandomApiKey(t, createRandomUser(t))
+
+	found, err := testQueries.GetApiKeyByPrefix(context.Background(), apiKey.Prefix)
+	require.NoError(t, err)
+	require.Equal(t, apiKey.ID, found.ID)
+	require.Equal(t, apiKey.HashedKey, found.HashedKey)
+}
+
+func TestListApiKeys(t *testing.T) {
+	user := createRandomUser(t)
+	for range 3 {
+		createRandomApiKey(t, user)
+	}
+	createRandomApiKey(t, createRandomUser(t))
+
+	apiKeys, err := testQueries.ListApiKeys(context.Background(), ListApiKeysParams{
+		Username: user.Username,
+		Limit:    5,
+		Offset:   0,
+	})
+	require.NoError(t, err)
+	require.Len(t, apiKeys, 3)
+	for _, apiKey := range apiKeys {
+		require.Equal(t, user.Username, apiKey.Username)
+	}
+}
+
+func TestRevokeApiKey(t *testing.T) {
+	user := createRandomUser(t)
+	apiKey := createRandomApiKey(t, user)
+
+	// Another user cannot revoke the key.
+	_, err := testQueries.RevokeApiKey(context.Background(), RevokeApiKeyParams{
+		ID:       apiKey.ID,
+		Username: createRandomUser(t).Username,
+	})
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	revoked, err := testQueries.RevokeApiKey(context.Background(), RevokeApiKeyParams{
+		ID:       apiKey.ID,
+		Username: user.Username,
+	})
+	require.NoError(t, err)
+	require.True(t, revoked.RevokedAt.Valid)
+
+	// Revoking twice is a no-op that finds nothing.
+	_, err = testQueries.RevokeApiKey(context.Background(), RevokeApiKeyParams{
+		ID:       apiKey.ID,
+		Username: user.Username,
+	})
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+}
+
+func TestUpdateApiKeyLastUsed(t *testing.T) {
+	apiKey := createRandomApiKey(t, createRandomUser(t))
+
+	err := testQueries.UpdateApiKeyLastUsed(context.Background(), apiKey.ID)
+	require.NoError(t, err)
+
+	found, err := testQueries.GetApiKeyByPrefix(context.Background(), apiKey.Prefix)
+	require.NoError(t, err)
+	require.True(t, found.LastUsedAt.Valid)
+	require.WithinDuration(t, time.Now(), found.LastUsedAt.Time, time.Second)
+}
@@ -0,0 +1,102 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Audited actions stored in audit_events.action.
+const (
+	AuditUserLogin                = "user.login"
+	AuditUserLoginFailed          = "user.login_failed"
+	AuditUserUpdated              = "user.updated"
+	AuditUserEmailChangeConfirmed = "user.email_change_confirmed"
+	AuditUserEmailChangeCancelled = "user.email_change_cancelled"
+	AuditAccountCreated           = "account.created"
+	AuditAccountUpdated           = "account.updated"
+	AuditAccountDeleted           = "account.deleted"
+	AuditAccountMemberInvited     = "account.member_invited"
+	AuditAccountMemberJoined      = "account.member_joined"
+	AuditAccountMemberRemoved     = "account.member_removed"
+	AuditTransferCreated          = "transfer.created"
+)
+
+// Audit target types stored in audit_events.target_type.
+const (
+	AuditTargetUser     = "user"
+	AuditTargetSession  = "session"
+	AuditTargetAccount  = "account"
+	AuditTargetTransfer = "transfer"
+)
+
+// AuditEntry describes one audited action. Before and After hold the state of
+// the target around the change and are stored as JSON; nil is stored as NULL.
+type AuditEntry struct {
+	Actor      string
+	Action     string
+	TargetType string
+	TargetID   string
+	Before     any
+	After      any
+	ClientIP   string
+	UserAgent  string
+	RequestID  string
+}
+
+// RecordAuditEvent writes entry to audit_events. Call it on the Queries of a
+// transaction so the event is committed or rolled back with the change.
+func (q *Queries) RecordAuditEvent(ctx context.Context, entry AuditEntry) (AuditEvent, error) {
+	before, err := marshalAuditState(entry.Before)
+	if err != nil {
+		return AuditEvent{}, err
+	}
+	after, err := marshalAuditState(entry.After)
+	if err != nil {
+		return AuditEvent{}, err
+	}
+
+	return q.CreateAuditEvent(ctx, CreateAuditEventParams{
+		Actor:      entry.Actor,
+		Action:     entry.Action,
+		TargetType: entry.TargetType,
+		TargetID:   entry.TargetID,
+		Before:     before,
+		After:      after,
+		ClientIp:   entry.ClientIP,
+		UserAgent:  entry.UserAgent,
+		RequestID:  entry.RequestID,
+	})
+}
+
+func marshalAuditState(state any) ([]byte, error) {
+	if state == nil {
+		return nil, nil
+	}
+	return json.Marshal(state)
+}
+
+// AuditUser is the audited state of a user. It leaves out the password hash;
+// a password change shows up as a new password_changed_at.
+type AuditUser struct {
+	Username          string    `json:"username"`
+	FullName          string    `json:"full_name"`
+	Email             string    `json:"email"`
+	IsEmailVerified   bool      `json:"is_email_verified"`
+	Locale            string    `json:"locale"`
+	Role              string    `json:"role"`
+	PasswordChangedAt time.Time `json:"password_changed_at"`
+}
+
+// NewAuditUser returns the audited state of user.
+func NewAuditUser(user User) AuditUser {
+	return AuditUser{
+		Username:          user.Username,
+		FullName:          user.FullName,
+		Email:             user.Email,
+		IsEmailVerified:   user.IsEmailVerified,
+		Locale:            user.Locale,
+		Role:              user.Role,
+		PasswordChangedAt: user.PasswordChangedAt.Time,
+	}
+}
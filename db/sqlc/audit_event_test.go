@@ -0,0 +1,139 @@
+package db
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/a7medalyapany/GoBank.git/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func recordRandomAuditEvent(t *testing.T, before, after any) AuditEvent {
+	t.Helper()
+
+	entry := AuditEntry{
+		Actor:      util.RandomOwner(),
+		Action:     AuditAccountUpdated,
+		TargetType: AuditTargetAccount,
+		TargetID:   util.RandomString(8),
+		Before:     before,
+		After:      after,
+		ClientIP:   "127.0.0.1",
+		UserAgent:  "go-test",
+		RequestID:  util.RandomString(16),
+	}
+
+	event, err := testQueries.RecordAuditEvent(context.Background(), entry)
+	require.NoError(t, err)
+	require.NotZero(t, event.ID)
+	require.Equal(t, entry.Actor, event.Actor)
+	require.Equal(t, entry.Action, event.Action)
+	require.Equal(t, entry.TargetType, event.TargetType)
+	require.Equal(t, entry.TargetID, event.TargetID)
+	require.Equal(t, entry.ClientIP, event.ClientIp)
+	require.Equal(t, entry.UserAgent, event.UserAgent)
+	require.Equal(t, entry.RequestID, event.RequestID)
+	require.NotZero(t, event.CreatedAt)
+
+	return event
+}
+
+func TestRecordAuditEvent(t *testing.T) {
+	event := recordRandomAuditEvent(t, nil, map[string]int64{"balance": 100})
+
+	require.Nil(t, event.Before)
+	require.JSONEq(t, `{"balance": 100}`, string(event.After))
+}
+
+func TestAuditEventsAreAppendOnly(t *testing.T) {
+	event := recordRandomAuditEvent(t, nil, nil)
+
+	_, err := testDB.Exec(context.Background(), "UPDATE audit_events SET actor = 'someone' WHERE id = $1", event.ID)
+	require.ErrorContains(t, err, "append-only")
+
+	_, err = testDB.Exec(context.Background(), "DELETE FROM audit_events WHERE id = $1", event.ID)
+	require.ErrorContains(t, err, "append-only")
+
+	events, err := testQueries.ListAuditEvents(context.Background(), ListAuditEventsParams{
+		Actor:    pgtype.Text{String: event.Actor, Valid: true},
+		LimitArg: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, event.Actor, events[0].Actor)
+}
+
+func TestListAuditEvents(t *testing.T) {
+	first := recordRandomAuditEvent(t, nil, nil)
+
+	second, err := testQueries.RecordAuditEvent(context.Background(), AuditEntry{
+		Actor:      first.Actor,
+		Action:     AuditAccountDeleted,
+		TargetType: first.TargetType,
+		TargetID:   first.TargetID,
+	})
+	require.NoError(t, err)
+
+	events, err := testQueries.ListAuditEvents(context.Background(), ListAuditEventsParams{
+		Actor:    pgtype.Text{String: first.Actor, Valid: true},
+		LimitArg: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, second.ID, events[0].ID)
+	require.Equal(t, first.ID, events[1].ID)
+
+	events, err = testQueries.ListAuditEvents(context.Background(), ListAuditEventsParams{
+		Action:     pgtype.Text{String: AuditAccountDeleted, Valid: true},
+		TargetType: pgtype.Text{String: first.TargetType, Valid: true},
+		TargetID:   pgtype.Text{String: first.TargetID, Valid: true},
+		LimitArg:   10,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, events)
+	for _, event := range events {
+		require.Equal(t, AuditAccountDeleted, event.Action)
+		require.Equal(t, first.TargetID, event.TargetID)
+	}
+
+	events, err = testQueries.ListAuditEvents(context.Background(), ListAuditEventsParams{
+		Actor:        pgtype.Text{String: first.Actor, Valid: true},
+		CreatedAfter: pgtype.Timestamptz{Time: second.CreatedAt.Time.Add(time.Hour), Valid: true},
+		LimitArg:     10,
+	})
+	require.NoError(t, err)
+	require.Empty(t, events)
+}
+
+func TestTransferTxRecordsAuditEvent(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	result, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+		Audit: &AuditEntry{
+			Actor:  account1.Owner,
+			Action: AuditTransferCreated,
+		},
+	})
+	require.NoError(t, err)
+
+	events, err := store.ListAuditEvents(context.Background(), ListAuditEventsParams{
+		TargetType: pgtype.Text{String: AuditTargetTransfer, Valid: true},
+		TargetID:   pgtype.Text{String: strconv.FormatInt(result.Transfer.ID, 10), Valid: true},
+		LimitArg:   10,
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, account1.Owner, events[0].Actor)
+	require.Equal(t, AuditTransferCreated, events[0].Action)
+	require.Nil(t, events[0].Before)
+	require.NotEmpty(t, events[0].After)
+}
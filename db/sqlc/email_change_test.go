@@ -0,0 +1,125 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/a7medalyapany/GoBank.git/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomEmailChange(t *testing.T, user User) EmailChange {
+	arg := CreateEmailChangeParams{
+		Username:         user.Username,
+		OldEmail:         user.Email,
+		OldEmailVerified: user.IsEmailVerified,
+		NewEmail:         util.RandomEmail(),
+		SecretCode:       util.RandomString(32),
+		CancelCode:       util.RandomString(32),
+	}
+
+	change, err := testQueries.CreateEmailChange(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.NewEmail, change.NewEmail)
+	require.Equal(t, EmailChangePending, change.Status)
+	require.True(t, change.ExpiresAt.Time.After(change.CreatedAt.Time))
+	require.False(t, change.CompletedAt.Valid)
+	return change
+}
+
+func TestConfirmEmailChangeTx(t *testing.T) {
+	store := NewStore(testDB)
+	user := createRandomUser(t)
+	change := createRandomEmailChange(t, user)
+
+	_, err := store.ConfirmEmailChangeTx(context.Background(), EmailChangeTxParams{ID: change.ID, Code: change.CancelCode})
+	require.ErrorIs(t, err, ErrEmailChangeInvalid)
+
+	result, err := store.ConfirmEmailChangeTx(context.Background(), EmailChangeTxParams{ID: change.ID, Code: change.SecretCode})
+	require.NoError(t, err)
+	require.Equal(t, change.NewEmail, result.User.Email)
+	require.True(t, result.User.IsEmailVerified)
+	require.Equal(t, EmailChangeConfirmed, result.EmailChange.Status)
+	require.True(t, result.EmailChange.CompletedAt.Valid)
+
+	// The link only works once.
+	_, err = store.ConfirmEmailChangeTx(context.Background(), EmailChangeTxParams{ID: change.ID, Code: change.SecretCode})
+	require.ErrorIs(t, err, ErrEmailChangeInvalid)
+}
+
+func TestCancelEmailChangeTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	t.Run("Pending", func(t *testing.T) {
+		user := createRandomUser(t)
+		change := createRandomEmailChange(t, user)
+
+		result, err := store.CancelEmailChangeTx(context.Background(), EmailChangeTxParams{ID: change.ID, Code: change.CancelCode})
+		require.NoError(t, err)
+		require.Equal(t, user.Email, result.User.Email)
+		require.Equal(t, EmailChangeCancelled, result.EmailChange.Status)
+
+		_, err = store.ConfirmEmailChangeTx(context.Background(), EmailChangeTxParams{ID: change.ID, Code: change.SecretCode})
+		require.ErrorIs(t, err, ErrEmailChangeInvalid)
+	})
+
+	t.Run("RevertConfirmed", func(t *testing.T) {
+		user := createRandomUser(t)
+		change := createRandomEmailChange(t, user)
+
+		_, err := store.ConfirmEmailChangeTx(context.Background(), EmailChangeTxParams{ID: change.ID, Code: change.SecretCode})
+		require.NoError(t, err)
+
+		result, err := store.CancelEmailChangeTx(context.Background(), EmailChangeTxParams{ID: change.ID, Code: change.CancelCode})
+		require.NoError(t, err)
+		require.Equal(t, user.Email, result.User.Email)
+		require.Equal(t, user.IsEmailVerified, result.User.IsEmailVerified)
+		require.Equal(t, EmailChangeCancelled, result.EmailChange.Status)
+	})
+
+	t.Run("WrongCode", func(t *testing.T) {
+		user := createRandomUser(t)
+		change := createRandomEmailChange(t, user)
+
+		_, err := store.CancelEmailChangeTx(context.Background(), EmailChangeTxParams{ID: change.ID, Code: change.SecretCode})
+		require.ErrorIs(t, err, ErrEmailChangeInvalid)
+	})
+}
+
+func TestCancelPendingEmailChanges(t *testing.T) {
+	user := createRandomUser(t)
+	first := createRandomEmailChange(t, user)
+	second := createRandomEmailChange(t, user)
+
+	pending, err := testQueries.GetPendingEmailChange(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Equal(t, second.ID, pending.ID)
+
+	n, err := testQueries.CancelPendingEmailChanges(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), n)
+
+	change, err := testQueries.GetEmailChange(context.Background(), first.ID)
+	require.NoError(t, err)
+	require.Equal(t, EmailChangeCancelled, change.Status)
+}
+
+func TestVerifyEmailTxStaleAddress(t *testing.T) {
+	store := NewStore(testDB)
+	user := createRandomUser(t)
+	verifyEmail := createRandomVerifyEmail(t, user)
+
+	// The address moved on after the code was sent.
+	change := createRandomEmailChange(t, user)
+	_, err := store.ConfirmEmailChangeTx(context.Background(), EmailChangeTxParams{ID: change.ID, Code: change.SecretCode})
+	require.NoError(t, err)
+
+	_, err = testDB.Exec(context.Background(), "UPDATE verify_emails SET is_used = false, expires_at = now() + interval '15 minutes' WHERE id = $1", verifyEmail.ID)
+	require.NoError(t, err)
+
+	_, err = store.VerifyEmailTx(context.Background(), VerifyEmailTxParams{
+		EmailId:    verifyEmail.ID,
+		SecretCode: verifyEmail.SecretCode,
+	})
+	require.ErrorIs(t, err, ErrVerifyEmailStale)
+}
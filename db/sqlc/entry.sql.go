@@ -97,13 +97,18 @@ LEFT JOIN accounts ca ON ca.id = CASE
   WHEN t.to_account_id = e.account_id THEN t.from_account_id
   ELSE NULL
 END
-WHERE a.owner = $1
+WHERE EXISTS (
+  SELECT 1 FROM account_members m
+  WHERE m.account_id = a.id
+    AND m.username = $1
+    AND m.status = 'active'
+)
 ORDER BY e.created_at DESC, e.id DESC
 LIMIT $3 OFFSET $2
 `
 
 type ListActivityEntriesParams struct {
-	Owner     string `json:"owner"`
+	Username  string `json:"username"`
 	OffsetArg int32  `json:"offset_arg"`
 	LimitArg  int32  `json:"limit_arg"`
 }
@@ -120,8 +125,9 @@ type ListActivityEntriesRow struct {
 	CounterpartCurrency  pgtype.Text        `json:"counterpart_currency"`
 }
 
+// Entries of every account username is an active member of.
 func (q *Queries) ListActivityEntries(ctx context.Context, arg ListActivityEntriesParams) ([]ListActivityEntriesRow, error) {
-	rows, err := q.db.Query(ctx, listActivityEntries, arg.Owner, arg.OffsetArg, arg.LimitArg)
+	rows, err := q.db.Query(ctx, listActivityEntries, arg.Username, arg.OffsetArg, arg.LimitArg)
 	if err != nil {
 		return nil, err
 	}
@@ -188,3 +194,35 @@ func (q *Queries) ListEntries(ctx context.Context, arg ListEntriesParams) ([]Ent
 	}
 	return items, nil
 }
+
+const listTransferEntries = `-- name: ListTransferEntries :many
+SELECT id, amount, account_id, created_at, transfer_id FROM entries
+WHERE transfer_id = ANY($1::bigint[])
+ORDER BY id
+`
+
+func (q *Queries) ListTransferEntries(ctx context.Context, transferIds []int64) ([]Entry, error) {
+	rows, err := q.db.Query(ctx, listTransferEntries, transferIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Entry{}
+	for rows.Next() {
+		var i Entry
+		if err := rows.Scan(
+			&i.ID,
+			&i.Amount,
+			&i.AccountID,
+			&i.CreatedAt,
+			&i.TransferID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
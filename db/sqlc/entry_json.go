@@ -1,20 +1,19 @@
 package db
 
 import (
-	"encoding/json"
-
 	"github.com/a7medalyapany/GoBank.git/util"
 )
 
-// MarshalJSON customizes JSON serialization for Entry
-func (e Entry) MarshalJSON() ([]byte, error) {
+// entryJSON returns e for JSON serialization, with the amount in major units
+// of currency, the currency of the entry's account.
+func entryJSON(e Entry, currency string) any {
 	type Alias Entry
-	
-	return json.Marshal(&struct {
+
+	return &struct {
 		Amount float64 `json:"amount"`
 		*Alias
 	}{
-		Amount: util.CentsToFloat(e.Amount),
+		Amount: util.FromMinorUnits(e.Amount, currency),
 		Alias:  (*Alias)(&e),
-	})
-}
\ No newline at end of file
+	}
+}
@@ -105,6 +105,7 @@ func TestListActivityEntries(t *testing.T) {
 		Owner:    user.Username,
 		Balance:  500_000,
 		Currency: "USD",
+		Type:     AccountTypeChecking,
 	})
 	require.NoError(t, err)
 
@@ -112,6 +113,7 @@ func TestListActivityEntries(t *testing.T) {
 		Owner:    user.Username,
 		Balance:  75_000,
 		Currency: "EUR",
+		Type:     AccountTypeChecking,
 	})
 	require.NoError(t, err)
 
@@ -119,6 +121,7 @@ func TestListActivityEntries(t *testing.T) {
 		Owner:    counterparty.Username,
 		Balance:  500_000,
 		Currency: "USD",
+		Type:     AccountTypeChecking,
 	})
 	require.NoError(t, err)
 
@@ -126,6 +129,7 @@ func TestListActivityEntries(t *testing.T) {
 		Owner:    outsider.Username,
 		Balance:  500_000,
 		Currency: "USD",
+		Type:     AccountTypeChecking,
 	})
 	require.NoError(t, err)
 
@@ -169,7 +173,7 @@ func TestListActivityEntries(t *testing.T) {
 	setEntryCreatedAt(t, outsiderEntry.ID, base)
 
 	entries, err := testQueries.ListActivityEntries(ctx, ListActivityEntriesParams{
-		Owner:  user.Username,
+		Username:  user.Username,
 		LimitArg:  10,
 		OffsetArg: 0,
 	})
@@ -201,7 +205,7 @@ func TestListActivityEntries(t *testing.T) {
 	require.False(t, entries[3].TransferID.Valid)
 
 	page1, err := testQueries.ListActivityEntries(ctx, ListActivityEntriesParams{
-		Owner:  user.Username,
+		Username:  user.Username,
 		LimitArg:  2,
 		OffsetArg: 0,
 	})
@@ -211,7 +215,7 @@ func TestListActivityEntries(t *testing.T) {
 	require.Equal(t, debitResult.FromEntry.ID, page1[1].ID)
 
 	page2, err := testQueries.ListActivityEntries(ctx, ListActivityEntriesParams{
-		Owner:  user.Username,
+		Username:  user.Username,
 		LimitArg:  2,
 		OffsetArg: 2,
 	})
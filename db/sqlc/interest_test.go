@@ -0,0 +1,120 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func createSavingsAccount(t *testing.T, balance int64) Account {
+	t.Helper()
+
+	user := createRandomUser(t)
+	account, err := testQueries.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    user.Username,
+		Balance:  balance,
+		Currency: "USD",
+		Type:     AccountTypeSavings,
+	})
+	require.NoError(t, err)
+	return account
+}
+
+func interestDate(s string) pgtype.Date {
+	day, _ := time.Parse(time.DateOnly, s)
+	return pgtype.Date{Time: day, Valid: true}
+}
+
+func accrueInterest(t *testing.T, account Account, day string, amountMicros int64) {
+	t.Helper()
+
+	_, err := testQueries.CreateInterestAccrual(context.Background(), CreateInterestAccrualParams{
+		AccountID:     account.ID,
+		AccrualDate:   interestDate(day),
+		Balance:       account.Balance,
+		AnnualRateBps: 250,
+		AmountMicros:  amountMicros,
+	})
+	require.NoError(t, err)
+}
+
+func TestCreateInterestAccrualOncePerDay(t *testing.T) {
+	account := createSavingsAccount(t, 100_000)
+	accrueInterest(t, account, "2026-09-01", 6_849_315)
+
+	_, err := testQueries.CreateInterestAccrual(context.Background(), CreateInterestAccrualParams{
+		AccountID:     account.ID,
+		AccrualDate:   interestDate("2026-09-01"),
+		Balance:       account.Balance,
+		AnnualRateBps: 250,
+		AmountMicros:  6_849_315,
+	})
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+}
+
+func TestPostInterestTx(t *testing.T) {
+	store := NewStore(testDB)
+	account := createSavingsAccount(t, 100_000)
+
+	accrueInterest(t, account, "2026-09-01", 700_000)
+	accrueInterest(t, account, "2026-09-02", 700_000)
+	accrueInterest(t, account, "2026-09-30", 700_000)
+	// Next month's accrual waits for the next posting.
+	accrueInterest(t, account, "2026-10-01", 700_000)
+
+	result, err := store.PostInterestTx(context.Background(), PostInterestTxParams{
+		AccountID: account.ID,
+		Before:    interestDate("2026-10-01"),
+	})
+	require.NoError(t, err)
+
+	posting := result.Posting
+	require.Equal(t, int64(2), posting.Amount)
+	require.Equal(t, int64(2_100_000), posting.AccruedMicros)
+	require.Equal(t, int64(100_000), posting.CarryMicros)
+	require.Equal(t, interestDate("2026-09-01").Time, posting.PeriodStart.Time)
+	require.Equal(t, interestDate("2026-09-30").Time, posting.PeriodEnd.Time)
+
+	require.NotNil(t, result.Transfer)
+	require.Equal(t, result.Transfer.Transfer.ID, posting.TransferID.Int64)
+	require.Equal(t, account.ID, result.Transfer.ToAccount.ID)
+	require.Equal(t, account.Balance+2, result.Transfer.ToAccount.Balance)
+
+	expense := result.Transfer.FromAccount
+	require.Equal(t, AccountTypeInterestExpense, expense.Type)
+	require.Equal(t, SystemUsername, expense.Owner)
+	require.Equal(t, "USD", expense.Currency)
+	require.Negative(t, expense.Balance)
+
+	// The same month cannot be posted twice.
+	_, err = store.PostInterestTx(context.Background(), PostInterestTxParams{
+		AccountID: account.ID,
+		Before:    interestDate("2026-10-01"),
+	})
+	require.ErrorIs(t, err, ErrNoUnpostedInterest)
+
+	// The carry is added to the next posting.
+	result, err = store.PostInterestTx(context.Background(), PostInterestTxParams{
+		AccountID: account.ID,
+		Before:    interestDate("2026-11-01"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(800_000), result.Posting.AccruedMicros)
+	require.Zero(t, result.Posting.Amount)
+	require.Equal(t, int64(800_000), result.Posting.CarryMicros)
+	require.Nil(t, result.Transfer)
+	require.False(t, result.Posting.TransferID.Valid)
+
+	postings, err := testQueries.ListInterestPostings(context.Background(), ListInterestPostingsParams{
+		AccountID: account.ID,
+		LimitArg:  10,
+		OffsetArg: 0,
+	})
+	require.NoError(t, err)
+	require.Len(t, postings, 2)
+	require.Equal(t, result.Posting.ID, postings[0].ID)
+}
@@ -0,0 +1,199 @@
+package db
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// LedgerGenesisHash is the prev_hash of the first link in ledger_chain.
+var LedgerGenesisHash = make([]byte, sha256.Size)
+
+// ledgerVerifyBatchSize is how many links VerifyLedgerChain reads at a time.
+const ledgerVerifyBatchSize = 1000
+
+// LedgerHashVersion is the encoding LedgerHash uses for new links.
+// Version 1 covers every field of the transfer but initiated_by, which was
+// added later; version 2 covers it too. Links keep the version they were
+// written with, so links and anchors from before still verify.
+const LedgerHashVersion int16 = 2
+
+// LedgerHash returns the hash of the link for transfer and its entries on top
+// of prev, in the given encoding version. From version 2 it covers every
+// stored field of the transfer and entries, so editing any of them, or moving
+// an entry to another transfer, changes it. Entries that belong to no transfer
+// are not chained.
+func LedgerHash(version int16, prev []byte, transfer Transfer, entries []Entry) []byte {
+	h := sha256.New()
+	h.Write(prev)
+	fmt.Fprintf(h, "transfer|%d|%d|%d|%d|%s\n",
+		transfer.ID,
+		transfer.FromAccountID,
+		transfer.ToAccountID,
+		transfer.Amount,
+		ledgerTime(transfer.CreatedAt),
+	)
+	if version >= 2 {
+		fmt.Fprintf(h, "initiated_by|%t|%s\n", transfer.InitiatedBy.Valid, transfer.InitiatedBy.String)
+	}
+
+	entries = slices.SortedFunc(slices.Values(entries), func(a, b Entry) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	for _, entry := range entries {
+		fmt.Fprintf(h, "entry|%d|%d|%d|%s\n",
+			entry.ID,
+			entry.AccountID,
+			entry.Amount,
+			ledgerTime(entry.CreatedAt),
+		)
+	}
+	return h.Sum(nil)
+}
+
+func ledgerTime(t pgtype.Timestamptz) string {
+	return t.Time.UTC().Format(time.RFC3339Nano)
+}
+
+// appendLedgerChain links transfer and its entries to the head of the chain.
+// The chain lock it takes is held until the transaction ends, so call it as
+// late in the transaction as possible.
+func appendLedgerChain(ctx context.Context, q *Queries, transfer Transfer, entries ...Entry) (LedgerChain, error) {
+	if err := q.LockLedgerChain(ctx); err != nil {
+		return LedgerChain{}, err
+	}
+
+	prev := LedgerGenesisHash
+	head, err := q.GetLedgerChainHead(ctx)
+	switch {
+	case err == nil:
+		prev = head.Hash
+	case !errors.Is(err, pgx.ErrNoRows):
+		return LedgerChain{}, err
+	}
+
+	return q.CreateLedgerLink(ctx, CreateLedgerLinkParams{
+		TransferID: transfer.ID,
+		PrevHash:   prev,
+		Hash:       LedgerHash(LedgerHashVersion, prev, transfer, entries),
+		Version:    LedgerHashVersion,
+	})
+}
+
+// LedgerAnchor is a chain head previously written to the logs.
+type LedgerAnchor struct {
+	Seq  int64
+	Hash []byte
+}
+
+// LedgerChainBreak is the first link that failed verification.
+type LedgerChainBreak struct {
+	Seq        int64  `json:"seq"`
+	TransferID int64  `json:"transfer_id"`
+	Reason     string `json:"reason"`
+}
+
+// LedgerChainReport is the result of VerifyLedgerChain. Head is the last
+// link that verified; Break is nil when the whole chain verified.
+type LedgerChainReport struct {
+	Links int64             `json:"links"`
+	Head  LedgerChain       `json:"head"`
+	Break *LedgerChainBreak `json:"break,omitempty"`
+}
+
+// VerifyLedgerChain walks ledger_chain from the first link and recomputes
+// every hash from the transfers and entries as they are stored now, each in
+// the version its link was written with. It stops
+// at the first link that does not follow the previous one, whose hash does
+// not match its transfer, or whose hash differs from one of anchors. An
+// anchor past the end of the chain means links were removed from the end.
+func (q *Queries) VerifyLedgerChain(ctx context.Context, anchors ...LedgerAnchor) (LedgerChainReport, error) {
+	var report LedgerChainReport
+
+	anchored := make(map[int64][]byte, len(anchors))
+	for _, anchor := range anchors {
+		anchored[anchor.Seq] = anchor.Hash
+	}
+
+	prev := LedgerGenesisHash
+	var afterSeq int64
+	for {
+		links, err := q.ListLedgerChain(ctx, ListLedgerChainParams{
+			AfterSeq: afterSeq,
+			LimitArg: ledgerVerifyBatchSize,
+		})
+		if err != nil {
+			return report, fmt.Errorf("failed to list ledger chain: %w", err)
+		}
+		if len(links) == 0 {
+			break
+		}
+
+		transferIDs := make([]int64, len(links))
+		for i, link := range links {
+			transferIDs[i] = link.TransferID
+		}
+		transfers, err := q.ListTransfersByID(ctx, transferIDs)
+		if err != nil {
+			return report, fmt.Errorf("failed to list transfers: %w", err)
+		}
+		entries, err := q.ListTransferEntries(ctx, transferIDs)
+		if err != nil {
+			return report, fmt.Errorf("failed to list entries: %w", err)
+		}
+
+		transferByID := make(map[int64]Transfer, len(transfers))
+		for _, transfer := range transfers {
+			transferByID[transfer.ID] = transfer
+		}
+		entriesByTransfer := make(map[int64][]Entry, len(transfers))
+		for _, entry := range entries {
+			entriesByTransfer[entry.TransferID.Int64] = append(entriesByTransfer[entry.TransferID.Int64], entry)
+		}
+
+		for _, link := range links {
+			transfer, ok := transferByID[link.TransferID]
+
+			var reason string
+			switch {
+			case !bytes.Equal(link.PrevHash, prev):
+				reason = "prev_hash does not match the previous link"
+			case !ok:
+				reason = "transfer is missing"
+			case link.Version < 1 || link.Version > LedgerHashVersion:
+				reason = fmt.Sprintf("unknown hash version %d", link.Version)
+			case !bytes.Equal(link.Hash, LedgerHash(link.Version, prev, transfer, entriesByTransfer[transfer.ID])):
+				reason = "hash does not match the transfer and its entries"
+			case anchored[link.Seq] != nil && !bytes.Equal(link.Hash, anchored[link.Seq]):
+				reason = "hash does not match the anchor"
+			}
+			if reason != "" {
+				report.Break = &LedgerChainBreak{Seq: link.Seq, TransferID: link.TransferID, Reason: reason}
+				return report, nil
+			}
+
+			delete(anchored, link.Seq)
+			prev = link.Hash
+			report.Links++
+			report.Head = link
+		}
+		afterSeq = links[len(links)-1].Seq
+	}
+
+	if len(anchored) > 0 {
+		report.Break = &LedgerChainBreak{
+			Seq:    slices.Min(slices.Collect(maps.Keys(anchored))),
+			Reason: "anchored link is missing",
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,126 @@
+package db
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func createChainedTransfer(t *testing.T) (TransferTxResult, LedgerChain) {
+	t.Helper()
+
+	store := NewStore(testDB)
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	result, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+
+	var link LedgerChain
+	err = testDB.QueryRow(context.Background(),
+		"SELECT seq, transfer_id, prev_hash, hash, created_at, version FROM ledger_chain WHERE transfer_id = $1",
+		result.Transfer.ID,
+	).Scan(&link.Seq, &link.TransferID, &link.PrevHash, &link.Hash, &link.CreatedAt, &link.Version)
+	require.NoError(t, err)
+
+	return result, link
+}
+
+func TestTransferTxAppendsLedgerLink(t *testing.T) {
+	result, link := createChainedTransfer(t)
+
+	require.Len(t, link.PrevHash, len(LedgerGenesisHash))
+	require.Equal(t, LedgerHashVersion, link.Version)
+	require.Equal(t, LedgerHash(link.Version, link.PrevHash, result.Transfer, []Entry{result.FromEntry, result.ToEntry}), link.Hash)
+
+	// Entry order does not change the hash.
+	require.Equal(t, link.Hash, LedgerHash(link.Version, link.PrevHash, result.Transfer, []Entry{result.ToEntry, result.FromEntry}))
+
+	_, err := testDB.Exec(context.Background(), "DELETE FROM ledger_chain WHERE seq = $1", link.Seq)
+	require.ErrorContains(t, err, "append-only")
+}
+
+func TestVerifyLedgerChain(t *testing.T) {
+	_, link := createChainedTransfer(t)
+
+	report, err := testQueries.VerifyLedgerChain(context.Background(), LedgerAnchor{Seq: link.Seq, Hash: link.Hash})
+	require.NoError(t, err)
+	require.Nil(t, report.Break)
+	require.GreaterOrEqual(t, report.Head.Seq, link.Seq)
+	require.Positive(t, report.Links)
+}
+
+func TestVerifyLedgerChainDetectsEdit(t *testing.T) {
+	result, link := createChainedTransfer(t)
+
+	// Edit the entry inside a transaction that is rolled back, so the shared
+	// test chain stays valid.
+	tx, err := testDB.Begin(context.Background())
+	require.NoError(t, err)
+	defer tx.Rollback(context.Background()) // nolint: errcheck
+
+	_, err = tx.Exec(context.Background(), "UPDATE entries SET amount = amount + 1 WHERE id = $1", result.ToEntry.ID)
+	require.NoError(t, err)
+
+	report, err := New(tx).VerifyLedgerChain(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, report.Break)
+	require.Equal(t, link.Seq, report.Break.Seq)
+	require.Equal(t, result.Transfer.ID, report.Break.TransferID)
+	require.Equal(t, "hash does not match the transfer and its entries", report.Break.Reason)
+}
+
+func TestLedgerHashVersions(t *testing.T) {
+	transfer := Transfer{ID: 1, FromAccountID: 2, ToAccountID: 3, Amount: 10}
+	initiated := transfer
+	initiated.InitiatedBy = pgtype.Text{String: "alice", Valid: true}
+	empty := transfer
+	empty.InitiatedBy = pgtype.Text{Valid: true}
+
+	// Version 1 predates initiated_by and leaves it out.
+	require.Equal(t, LedgerHash(1, LedgerGenesisHash, transfer, nil), LedgerHash(1, LedgerGenesisHash, initiated, nil))
+
+	require.NotEqual(t, LedgerHash(2, LedgerGenesisHash, transfer, nil), LedgerHash(2, LedgerGenesisHash, initiated, nil))
+	require.NotEqual(t, LedgerHash(2, LedgerGenesisHash, transfer, nil), LedgerHash(2, LedgerGenesisHash, empty, nil))
+	require.NotEqual(t, LedgerHash(1, LedgerGenesisHash, transfer, nil), LedgerHash(2, LedgerGenesisHash, transfer, nil))
+}
+
+func TestVerifyLedgerChainDetectsInitiatorEdit(t *testing.T) {
+	result, link := createChainedTransfer(t)
+
+	tx, err := testDB.Begin(context.Background())
+	require.NoError(t, err)
+	defer tx.Rollback(context.Background()) // nolint: errcheck
+
+	_, err = tx.Exec(context.Background(), "UPDATE transfers SET initiated_by = 'mallory' WHERE id = $1", result.Transfer.ID)
+	require.NoError(t, err)
+
+	report, err := New(tx).VerifyLedgerChain(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, report.Break)
+	require.Equal(t, link.Seq, report.Break.Seq)
+	require.Equal(t, "hash does not match the transfer and its entries", report.Break.Reason)
+}
+
+func TestVerifyLedgerChainAnchors(t *testing.T) {
+	_, link := createChainedTransfer(t)
+
+	report, err := testQueries.VerifyLedgerChain(context.Background(), LedgerAnchor{Seq: link.Seq, Hash: LedgerGenesisHash})
+	require.NoError(t, err)
+	require.NotNil(t, report.Break)
+	require.Equal(t, link.Seq, report.Break.Seq)
+	require.Equal(t, "hash does not match the anchor", report.Break.Reason)
+
+	report, err = testQueries.VerifyLedgerChain(context.Background(), LedgerAnchor{Seq: math.MaxInt64, Hash: link.Hash})
+	require.NoError(t, err)
+	require.NotNil(t, report.Break)
+	require.Equal(t, int64(math.MaxInt64), report.Break.Seq)
+	require.Equal(t, "anchored link is missing", report.Break.Reason)
+}
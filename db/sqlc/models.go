@@ -15,6 +15,70 @@ type Account struct {
 	Balance   int64              `json:"balance"`
 	Currency  string             `json:"currency"`
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	// checking | savings | interest_expense (system accounts that pay interest)
+	Type string `json:"type"`
+	// name the owner or a co-owner gave the account, empty when unnamed
+	Nickname string `json:"nickname"`
+	// public IBAN-style number: GO, two mod-97 check digits and 12 random digits
+	AccountNumber string `json:"account_number"`
+}
+
+type AccountMember struct {
+	AccountID int64  `json:"account_id"`
+	Username  string `json:"username"`
+	// owner | co_owner | viewer | spender
+	Role string `json:"role"`
+	// most a spender may transfer from the account in any 24 hours, in minor units of the account currency
+	SpendLimit pgtype.Int8 `json:"spend_limit"`
+	// invited | active
+	Status     string             `json:"status"`
+	InvitedBy  string             `json:"invited_by"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	AcceptedAt pgtype.Timestamptz `json:"accepted_at"`
+}
+
+type ApiKey struct {
+	ID         int64              `json:"id"`
+	Username   string             `json:"username"`
+	Name       string             `json:"name"`
+	Prefix     string             `json:"prefix"`
+	HashedKey  string             `json:"hashed_key"`
+	Scopes     []string           `json:"scopes"`
+	ExpiresAt  pgtype.Timestamptz `json:"expires_at"`
+	LastUsedAt pgtype.Timestamptz `json:"last_used_at"`
+	RevokedAt  pgtype.Timestamptz `json:"revoked_at"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+type AuditEvent struct {
+	ID int64 `json:"id"`
+	// username that performed the action; not a foreign key so events outlive the user
+	Actor string `json:"actor"`
+	// e.g. user.login, transfer.created, account.updated
+	Action     string             `json:"action"`
+	TargetType string             `json:"target_type"`
+	TargetID   string             `json:"target_id"`
+	Before     []byte             `json:"before"`
+	After      []byte             `json:"after"`
+	ClientIp   string             `json:"client_ip"`
+	UserAgent  string             `json:"user_agent"`
+	RequestID  string             `json:"request_id"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+type EmailChange struct {
+	ID               int64  `json:"id"`
+	Username         string `json:"username"`
+	OldEmail         string `json:"old_email"`
+	OldEmailVerified bool   `json:"old_email_verified"`
+	NewEmail         string `json:"new_email"`
+	SecretCode       string `json:"secret_code"`
+	CancelCode       string `json:"cancel_code"`
+	// pending | confirmed | cancelled
+	Status      string             `json:"status"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	ExpiresAt   pgtype.Timestamptz `json:"expires_at"`
+	CompletedAt pgtype.Timestamptz `json:"completed_at"`
 }
 
 type Entry struct {
@@ -26,6 +90,87 @@ type Entry struct {
 	TransferID pgtype.Int8        `json:"transfer_id"`
 }
 
+type InterestAccrual struct {
+	ID          int64       `json:"id"`
+	AccountID   int64       `json:"account_id"`
+	AccrualDate pgtype.Date `json:"accrual_date"`
+	// balance the day's interest was computed on, in minor units
+	Balance int64 `json:"balance"`
+	// annual rate in basis points, 250 = 2.50%
+	AnnualRateBps int64 `json:"annual_rate_bps"`
+	// interest for the day in millionths of a minor unit
+	AmountMicros int64 `json:"amount_micros"`
+	// posting that paid this accrual; NULL until posted
+	PostingID pgtype.Int8        `json:"posting_id"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type InterestPosting struct {
+	ID        int64 `json:"id"`
+	AccountID int64 `json:"account_id"`
+	// transfer from the interest expense account; NULL when amount is 0
+	TransferID pgtype.Int8 `json:"transfer_id"`
+	// interest credited, in minor units
+	Amount int64 `json:"amount"`
+	// accruals posted plus the previous carry, in millionths of a minor unit
+	AccruedMicros int64 `json:"accrued_micros"`
+	// fraction of a minor unit left over, added to the next posting
+	CarryMicros int64              `json:"carry_micros"`
+	PeriodStart pgtype.Date        `json:"period_start"`
+	PeriodEnd   pgtype.Date        `json:"period_end"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type LedgerChain struct {
+	Seq        int64 `json:"seq"`
+	TransferID int64 `json:"transfer_id"`
+	// hash of the previous link; 32 zero bytes for the first
+	PrevHash []byte `json:"prev_hash"`
+	// SHA-256 of prev_hash and the transfer with its entries
+	Hash      []byte             `json:"hash"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	// encoding of the hash: 1 leaves out transfers.initiated_by, 2 covers it
+	Version int16 `json:"version"`
+}
+
+type Notification struct {
+	ID         int64              `json:"id"`
+	Username   string             `json:"username"`
+	Type       string             `json:"type"`
+	Title      string             `json:"title"`
+	Body       string             `json:"body"`
+	TransferID pgtype.Int8        `json:"transfer_id"`
+	EmailedAt  pgtype.Timestamptz `json:"emailed_at"`
+	ReadAt     pgtype.Timestamptz `json:"read_at"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+type NotificationPreference struct {
+	Username      string             `json:"username"`
+	TransferEmail bool               `json:"transfer_email"`
+	TransferInApp bool               `json:"transfer_in_app"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+}
+
+type Outbox struct {
+	ID          int64              `json:"id"`
+	TaskType    string             `json:"task_type"`
+	Payload     []byte             `json:"payload"`
+	Queue       string             `json:"queue"`
+	MaxRetry    int32              `json:"max_retry"`
+	ProcessAt   pgtype.Timestamptz `json:"process_at"`
+	Attempts    int32              `json:"attempts"`
+	LastError   pgtype.Text        `json:"last_error"`
+	PublishedAt pgtype.Timestamptz `json:"published_at"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	// task headers, e.g. W3C trace context
+	Headers []byte `json:"headers"`
+	// the relay skips the message until then; pushed back exponentially after each failure
+	NextAttemptAt pgtype.Timestamptz `json:"next_attempt_at"`
+	// set once publishing failed max attempts times; dead messages are no longer retried
+	DeadAt pgtype.Timestamptz `json:"dead_at"`
+}
+
 type Session struct {
 	ID           pgtype.UUID        `json:"id"`
 	Username     string             `json:"username"`
@@ -44,6 +189,8 @@ type Transfer struct {
 	// Amount in cents (must be +ve)
 	Amount    int64              `json:"amount"`
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	// username that made the transfer; null for transfers the bank makes, such as interest
+	InitiatedBy pgtype.Text `json:"initiated_by"`
 }
 
 type User struct {
@@ -54,6 +201,8 @@ type User struct {
 	PasswordChangedAt pgtype.Timestamptz `json:"password_changed_at"`
 	CreatedAt         pgtype.Timestamptz `json:"created_at"`
 	IsEmailVerified   bool               `json:"is_email_verified"`
+	Locale            string             `json:"locale"`
+	Role              string             `json:"role"`
 }
 
 type VerifyEmail struct {
@@ -65,3 +214,27 @@ type VerifyEmail struct {
 	CreatedAt  pgtype.Timestamptz `json:"created_at"`
 	ExpiresAt  pgtype.Timestamptz `json:"expires_at"`
 }
+
+type WebhookDelivery struct {
+	ID         int64  `json:"id"`
+	EndpointID int64  `json:"endpoint_id"`
+	EventID    string `json:"event_id"`
+	EventType  string `json:"event_type"`
+	Payload    []byte `json:"payload"`
+	// pending | succeeded | dead
+	Status         string             `json:"status"`
+	Attempts       int32              `json:"attempts"`
+	ResponseStatus pgtype.Int4        `json:"response_status"`
+	LastError      pgtype.Text        `json:"last_error"`
+	DeliveredAt    pgtype.Timestamptz `json:"delivered_at"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+}
+
+type WebhookEndpoint struct {
+	ID         int64              `json:"id"`
+	Username   string             `json:"username"`
+	Url        string             `json:"url"`
+	Secret     string             `json:"secret"`
+	EventTypes []string           `json:"event_types"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
@@ -0,0 +1,23 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// GetNotificationPreferencesOrDefault returns the user's notification
+// preferences. Users who never changed them have no row and get the column
+// defaults: every notification enabled.
+func (store *Store) GetNotificationPreferencesOrDefault(ctx context.Context, username string) (NotificationPreference, error) {
+	prefs, err := store.GetNotificationPreferences(ctx, username)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return NotificationPreference{
+			Username:      username,
+			TransferEmail: true,
+			TransferInApp: true,
+		}, nil
+	}
+	return prefs, err
+}
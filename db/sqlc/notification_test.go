@@ -0,0 +1,149 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/a7medalyapany/GoBank.git/util"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomNotification(t *testing.T, user User) Notification {
+	transfer := createRandomTransfer(t)
+
+	arg := CreateNotificationParams{
+		Username:   user.Username,
+		Type:       "transfer_received",
+		Title:      util.RandomString(10),
+		Body:       util.RandomString(30),
+		TransferID: pgtype.Int8{Int64: transfer.ID, Valid: true},
+	}
+
+	notification, err := testQueries.CreateNotification(context.Background(), arg)
+	require.NoError(t, err)
+
+	require.NotZero(t, notification.ID)
+	require.Equal(t, arg.Username, notification.Username)
+	require.Equal(t, arg.Type, notification.Type)
+	require.Equal(t, arg.Title, notification.Title)
+	require.Equal(t, arg.Body, notification.Body)
+	require.Equal(t, arg.TransferID, notification.TransferID)
+	require.False(t, notification.EmailedAt.Valid)
+	require.False(t, notification.ReadAt.Valid)
+	return notification
+}
+
+func TestCreateNotification(t *testing.T) {
+	createRandomNotification(t, createRandomUser(t))
+}
+
+func TestCreateNotificationIsIdempotent(t *testing.T) {
+	notification := createRandomNotification(t, createRandomUser(t))
+
+	again, err := testQueries.CreateNotification(context.Background(), CreateNotificationParams{
+		Username:   notification.Username,
+		Type:       notification.Type,
+		Title:      "other title",
+		Body:       "other body",
+		TransferID: notification.TransferID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, notification.ID, again.ID)
+	require.Equal(t, notification.Title, again.Title)
+}
+
+func TestListNotifications(t *testing.T) {
+	user := createRandomUser(t)
+	for range 3 {
+		createRandomNotification(t, user)
+	}
+	createRandomNotification(t, createRandomUser(t))
+
+	notifications, err := testQueries.ListNotifications(context.Background(), ListNotificationsParams{
+		Username: user.Username,
+		LimitArg: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, notifications, 3)
+	for i, n := range notifications {
+		require.Equal(t, user.Username, n.Username)
+		if i > 0 {
+			require.Less(t, n.ID, notifications[i-1].ID)
+		}
+	}
+
+	_, err = testQueries.MarkNotificationRead(context.Background(), MarkNotificationReadParams{
+		ID:       notifications[0].ID,
+		Username: user.Username,
+	})
+	require.NoError(t, err)
+
+	unread, err := testQueries.ListNotifications(context.Background(), ListNotificationsParams{
+		Username:   user.Username,
+		UnreadOnly: true,
+		LimitArg:   10,
+	})
+	require.NoError(t, err)
+	require.Len(t, unread, 2)
+}
+
+func TestMarkNotificationRead(t *testing.T) {
+	user := createRandomUser(t)
+	notification := createRandomNotification(t, user)
+
+	read, err := testQueries.MarkNotificationRead(context.Background(), MarkNotificationReadParams{
+		ID:       notification.ID,
+		Username: user.Username,
+	})
+	require.NoError(t, err)
+	require.True(t, read.ReadAt.Valid)
+
+	// Marking again keeps the first read time.
+	again, err := testQueries.MarkNotificationRead(context.Background(), MarkNotificationReadParams{
+		ID:       notification.ID,
+		Username: user.Username,
+	})
+	require.NoError(t, err)
+	require.Equal(t, read.ReadAt, again.ReadAt)
+
+	// Another user cannot mark it.
+	_, err = testQueries.MarkNotificationRead(context.Background(), MarkNotificationReadParams{
+		ID:       notification.ID,
+		Username: createRandomUser(t).Username,
+	})
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+}
+
+func TestNotificationPreferences(t *testing.T) {
+	store := NewStore(testDB)
+	user := createRandomUser(t)
+
+	prefs, err := store.GetNotificationPreferencesOrDefault(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.True(t, prefs.TransferEmail)
+	require.True(t, prefs.TransferInApp)
+
+	prefs, err = testQueries.UpsertNotificationPreferences(context.Background(), UpsertNotificationPreferencesParams{
+		Username:      user.Username,
+		TransferEmail: pgtype.Bool{Bool: false, Valid: true},
+	})
+	require.NoError(t, err)
+	require.False(t, prefs.TransferEmail)
+	require.True(t, prefs.TransferInApp)
+
+	// Fields left out keep their current value.
+	prefs, err = testQueries.UpsertNotificationPreferences(context.Background(), UpsertNotificationPreferencesParams{
+		Username:      user.Username,
+		TransferInApp: pgtype.Bool{Bool: false, Valid: true},
+	})
+	require.NoError(t, err)
+	require.False(t, prefs.TransferEmail)
+	require.False(t, prefs.TransferInApp)
+
+	prefs, err = store.GetNotificationPreferencesOrDefault(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.False(t, prefs.TransferEmail)
+	require.False(t, prefs.TransferInApp)
+}
@@ -0,0 +1,170 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/a7medalyapany/GoBank.git/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomOutboxMessage(t *testing.T) Outbox {
+	arg := CreateOutboxMessageParams{
+		TaskType: "task:" + util.RandomString(8),
+		Payload:  []byte(fmt.Sprintf(`{"username": %q}`, util.RandomOwner())),
+		Queue:    "default",
+		MaxRetry: 5,
+	}
+
+	message, err := testQueries.CreateOutboxMessage(context.Background(), arg)
+	require.NoError(t, err)
+
+	require.NotZero(t, message.ID)
+	require.Equal(t, arg.TaskType, message.TaskType)
+	require.JSONEq(t, string(arg.Payload), string(message.Payload))
+	require.Equal(t, arg.Queue, message.Queue)
+	require.Equal(t, arg.MaxRetry, message.MaxRetry)
+	require.WithinDuration(t, time.Now(), message.ProcessAt.Time, 5*time.Second)
+	require.Zero(t, message.Attempts)
+	require.False(t, message.LastError.Valid)
+	require.False(t, message.PublishedAt.Valid)
+	return message
+}
+
+func TestCreateOutboxMessage(t *testing.T) {
+	createRandomOutboxMessage(t)
+}
+
+func TestCreateOutboxMessageProcessAt(t *testing.T) {
+	processAt := time.Now().Add(time.Hour)
+
+	message, err := testQueries.CreateOutboxMessage(context.Background(), CreateOutboxMessageParams{
+		TaskType:  "task:" + util.RandomString(8),
+		Payload:   []byte(`{}`),
+		Queue:     "critical",
+		MaxRetry:  10,
+		ProcessAt: pgtype.Timestamptz{Time: processAt, Valid: true},
+	})
+	require.NoError(t, err)
+	require.WithinDuration(t, processAt, message.ProcessAt.Time, time.Second)
+}
+
+func TestPublishOutboxTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	published := createRandomOutboxMessage(t)
+	failed := createRandomOutboxMessage(t)
+
+	publish := func(message Outbox) error {
+		if message.ID == failed.ID {
+			return errors.New("redis unavailable")
+		}
+		return nil
+	}
+	backoff := func(attempts int32) time.Duration {
+		return time.Duration(attempts) * time.Hour
+	}
+
+	result, err := store.PublishOutboxTx(context.Background(), PublishOutboxTxParams{
+		BatchSize:   1000,
+		Publish:     publish,
+		MaxAttempts: 3,
+		Backoff:     backoff,
+	})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, result.Published, 1)
+	require.GreaterOrEqual(t, result.Failed, 1)
+	require.Empty(t, result.Dead)
+
+	message, err := testQueries.GetOutboxMessage(context.Background(), published.ID)
+	require.NoError(t, err)
+	require.True(t, message.PublishedAt.Valid)
+	require.Equal(t, int32(1), message.Attempts)
+
+	message, err = testQueries.GetOutboxMessage(context.Background(), failed.ID)
+	require.NoError(t, err)
+	require.False(t, message.PublishedAt.Valid)
+	require.False(t, message.DeadAt.Valid)
+	require.Equal(t, int32(1), message.Attempts)
+	require.Equal(t, "redis unavailable", message.LastError.String)
+	require.WithinDuration(t, time.Now().Add(time.Hour), message.NextAttemptAt.Time, 5*time.Second)
+
+	// The failed message stays pending but is skipped until its backoff ends.
+	pending, err := testQueries.ListPendingOutboxMessages(context.Background(), 1000)
+	require.NoError(t, err)
+	require.NotContains(t, pending, message)
+
+	_, err = testDB.Exec(context.Background(), "UPDATE outbox SET next_attempt_at = now() WHERE id = $1", failed.ID)
+	require.NoError(t, err)
+	message, err = testQueries.GetOutboxMessage(context.Background(), failed.ID)
+	require.NoError(t, err)
+	pending, err = testQueries.ListPendingOutboxMessages(context.Background(), 1000)
+	require.NoError(t, err)
+	require.Contains(t, pending, message)
+}
+
+func TestPublishOutboxTxDead(t *testing.T) {
+	store := NewStore(testDB)
+
+	failed := createRandomOutboxMessage(t)
+
+	for attempt := int32(1); attempt <= 2; attempt++ {
+		// Make the message due again, as if its backoff had passed.
+		_, err := testDB.Exec(context.Background(), "UPDATE outbox SET next_attempt_at = now() WHERE id = $1", failed.ID)
+		require.NoError(t, err)
+
+		result, err := store.PublishOutboxTx(context.Background(), PublishOutboxTxParams{
+			BatchSize: 1000,
+			Publish: func(message Outbox) error {
+				if message.ID == failed.ID {
+					return errors.New("redis unavailable")
+				}
+				return nil
+			},
+			MaxAttempts: 2,
+			Backoff:     func(attempts int32) time.Duration { return 0 },
+		})
+		require.NoError(t, err)
+
+		var dead []int64
+		for _, message := range result.Dead {
+			dead = append(dead, message.ID)
+		}
+		if attempt < 2 {
+			require.NotContains(t, dead, failed.ID)
+		} else {
+			require.Contains(t, dead, failed.ID)
+		}
+	}
+
+	message, err := testQueries.GetOutboxMessage(context.Background(), failed.ID)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), message.Attempts)
+	require.True(t, message.DeadAt.Valid)
+	require.False(t, message.PublishedAt.Valid)
+
+	// Dead messages are no longer retried, nor deleted as published.
+	pending, err := testQueries.ListPendingOutboxMessages(context.Background(), 1000)
+	require.NoError(t, err)
+	require.NotContains(t, pending, message)
+
+	_, err = testQueries.DeletePublishedOutboxMessages(context.Background(), pgtype.Timestamptz{Time: time.Now().Add(time.Minute), Valid: true})
+	require.NoError(t, err)
+	_, err = testQueries.GetOutboxMessage(context.Background(), failed.ID)
+	require.NoError(t, err)
+}
+
+func TestDeletePublishedOutboxMessages(t *testing.T) {
+	message := createRandomOutboxMessage(t)
+	require.NoError(t, testQueries.MarkOutboxMessagePublished(context.Background(), message.ID))
+
+	_, err := testQueries.DeletePublishedOutboxMessages(context.Background(), pgtype.Timestamptz{Time: time.Now().Add(time.Minute), Valid: true})
+	require.NoError(t, err)
+
+	_, err = testQueries.GetOutboxMessage(context.Background(), message.ID)
+	require.Error(t, err)
+}
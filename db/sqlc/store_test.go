@@ -2,9 +2,11 @@ package db
 
 import (
 	"context"
+	"slices"
 	"testing"
 
 	"github.com/a7medalyapany/GoBank.git/util"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
 )
 
@@ -282,4 +284,72 @@ func TestTransferTxDeadlock(t *testing.T) {
 		util.FormatMoney(updatedAccount1.Balance, updatedAccount1.Currency),
 		util.FormatMoney(updatedAccount2.Balance, updatedAccount2.Currency),
 	)
-}
\ No newline at end of file
+}
+func TestTransferTxWritesOutbox(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	taskType := "task:" + util.RandomString(8)
+
+	result, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+		AfterTransfer: func(result TransferTxResult) ([]CreateOutboxMessageParams, error) {
+			require.NotZero(t, result.Transfer.ID)
+			return []CreateOutboxMessageParams{{
+				TaskType: taskType,
+				Payload:  []byte(`{}`),
+				Queue:    "default",
+				MaxRetry: 1,
+			}}, nil
+		},
+	})
+	require.NoError(t, err)
+	require.NotZero(t, result.Transfer.ID)
+
+	pending, err := store.ListPendingOutboxMessages(context.Background(), 1000)
+	require.NoError(t, err)
+	require.True(t, slices.ContainsFunc(pending, func(message Outbox) bool {
+		return message.TaskType == taskType
+	}))
+}
+
+func TestTransferTxSpendLimit(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	spender := createRandomUser(t)
+
+	arg := TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        6,
+		InitiatedBy:   spender.Username,
+		SpendLimit:    pgtype.Int8{Int64: 10, Valid: true},
+	}
+	result, err := store.TransferTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, spender.Username, result.Transfer.InitiatedBy.String)
+
+	// 6 + 6 is over the limit, and nothing is written.
+	_, err = store.TransferTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrSpendLimitExceeded)
+	account, err := store.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance-6, account.Balance)
+
+	// Transfers by others do not count.
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        6,
+	})
+	require.NoError(t, err)
+
+	arg.Amount = 4
+	_, err = store.TransferTx(context.Background(), arg)
+	require.NoError(t, err)
+}
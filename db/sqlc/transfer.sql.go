@@ -7,22 +7,30 @@ package db
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createTransfer = `-- name: CreateTransfer :one
-INSERT INTO transfers (from_account_id, to_account_id, amount) 
-VALUES ($1, $2, $3)
-RETURNING id, from_account_id, to_account_id, amount, created_at
+INSERT INTO transfers (from_account_id, to_account_id, amount, initiated_by) 
+VALUES ($1, $2, $3, $4)
+RETURNING id, from_account_id, to_account_id, amount, created_at, initiated_by
 `
 
 type CreateTransferParams struct {
-	FromAccountID int64 `json:"from_account_id"`
-	ToAccountID   int64 `json:"to_account_id"`
-	Amount        int64 `json:"amount"`
+	FromAccountID int64       `json:"from_account_id"`
+	ToAccountID   int64       `json:"to_account_id"`
+	Amount        int64       `json:"amount"`
+	InitiatedBy   pgtype.Text `json:"initiated_by"`
 }
 
 func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error) {
-	row := q.db.QueryRow(ctx, createTransfer, arg.FromAccountID, arg.ToAccountID, arg.Amount)
+	row := q.db.QueryRow(ctx, createTransfer,
+		arg.FromAccountID,
+		arg.ToAccountID,
+		arg.Amount,
+		arg.InitiatedBy,
+	)
 	var i Transfer
 	err := row.Scan(
 		&i.ID,
@@ -30,12 +38,13 @@ func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams)
 		&i.ToAccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.InitiatedBy,
 	)
 	return i, err
 }
 
 const getTransfer = `-- name: GetTransfer :one
-SELECT id, from_account_id, to_account_id, amount, created_at FROM transfers 
+SELECT id, from_account_id, to_account_id, amount, created_at, initiated_by FROM transfers 
 WHERE id = $1
 `
 
@@ -48,12 +57,13 @@ func (q *Queries) GetTransfer(ctx context.Context, id int64) (Transfer, error) {
 		&i.ToAccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.InitiatedBy,
 	)
 	return i, err
 }
 
 const listTransfers = `-- name: ListTransfers :many
-SELECT id, from_account_id, to_account_id, amount, created_at FROM transfers
+SELECT id, from_account_id, to_account_id, amount, created_at, initiated_by FROM transfers
 ORDER BY id
 LIMIT $1 OFFSET $2
 `
@@ -78,6 +88,7 @@ func (q *Queries) ListTransfers(ctx context.Context, arg ListTransfersParams) ([
 			&i.ToAccountID,
 			&i.Amount,
 			&i.CreatedAt,
+			&i.InitiatedBy,
 		); err != nil {
 			return nil, err
 		}
@@ -88,3 +99,58 @@ func (q *Queries) ListTransfers(ctx context.Context, arg ListTransfersParams) ([
 	}
 	return items, nil
 }
+
+const listTransfersByID = `-- name: ListTransfersByID :many
+SELECT id, from_account_id, to_account_id, amount, created_at, initiated_by FROM transfers
+WHERE id = ANY($1::bigint[])
+ORDER BY id
+`
+
+func (q *Queries) ListTransfersByID(ctx context.Context, ids []int64) ([]Transfer, error) {
+	rows, err := q.db.Query(ctx, listTransfersByID, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Transfer{}
+	for rows.Next() {
+		var i Transfer
+		if err := rows.Scan(
+			&i.ID,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.InitiatedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const sumTransfersByInitiator = `-- name: SumTransfersByInitiator :one
+SELECT COALESCE(SUM(amount), 0)::bigint AS total
+FROM transfers
+WHERE from_account_id = $1
+  AND initiated_by = $2
+  AND created_at > $3
+`
+
+type SumTransfersByInitiatorParams struct {
+	FromAccountID int64              `json:"from_account_id"`
+	InitiatedBy   pgtype.Text        `json:"initiated_by"`
+	Since         pgtype.Timestamptz `json:"since"`
+}
+
+// Totals what initiated_by has transferred from an account since a given time.
+func (q *Queries) SumTransfersByInitiator(ctx context.Context, arg SumTransfersByInitiatorParams) (int64, error) {
+	row := q.db.QueryRow(ctx, sumTransfersByInitiator, arg.FromAccountID, arg.InitiatedBy, arg.Since)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
@@ -6,15 +6,37 @@ import (
 	"github.com/a7medalyapany/GoBank.git/util"
 )
 
-// MarshalJSON customizes JSON serialization for Transfer
-func (t Transfer) MarshalJSON() ([]byte, error) {
+// transferJSON returns t for JSON serialization, with the amount in major
+// units of currency. Transfers do not store their currency, so callers pass
+// the currency of the accounts involved.
+func transferJSON(t Transfer, currency string) any {
 	type Alias Transfer
-	
-	return json.Marshal(&struct {
+
+	return &struct {
 		Amount float64 `json:"amount"`
 		*Alias
 	}{
-		Amount: util.CentsToFloat(t.Amount),
+		Amount: util.FromMinorUnits(t.Amount, currency),
 		Alias:  (*Alias)(&t),
+	}
+}
+
+// MarshalJSON customizes JSON serialization for TransferTxResult: the amounts
+// of the transfer and its entries are written in major units of its currency.
+func (r TransferTxResult) MarshalJSON() ([]byte, error) {
+	currency := r.FromAccount.Currency
+
+	return json.Marshal(&struct {
+		Transfer    any     `json:"transfer"`
+		FromAccount Account `json:"from_account"`
+		ToAccount   Account `json:"to_account"`
+		FromEntry   any     `json:"from_entry"`
+		ToEntry     any     `json:"to_entry"`
+	}{
+		Transfer:    transferJSON(r.Transfer, currency),
+		FromAccount: r.FromAccount,
+		ToAccount:   r.ToAccount,
+		FromEntry:   entryJSON(r.FromEntry, currency),
+		ToEntry:     entryJSON(r.ToEntry, currency),
 	})
-}
\ No newline at end of file
+}
@@ -5,7 +5,10 @@ import "context"
 // CreateUserTxParams is input for creating a user
 type CreateUserTxParams struct {
 	CreateUserParams
-	AfterCreate func(user User) error
+	// AfterCreate returns background tasks to run for the new user. They are
+	// written to the outbox in the same transaction, so they are published
+	// only if the user is committed.
+	AfterCreate func(user User) ([]CreateOutboxMessageParams, error)
 }
 
 type CreateUserTxResult struct {
@@ -13,7 +16,7 @@ type CreateUserTxResult struct {
 }
 
 
-// CreateUserTx performs a database transaction for creating a user and executing the AfterCreate function
+// CreateUserTx performs a database transaction for creating a user and queueing the tasks returned by AfterCreate
 func (store *Store) CreateUserTx(ctx context.Context, arg CreateUserTxParams) (CreateUserTxResult, error) {
     var result CreateUserTxResult
 
@@ -25,8 +28,17 @@ func (store *Store) CreateUserTx(ctx context.Context, arg CreateUserTxParams) (C
 			return err
 		}
 
-		return arg.AfterCreate(result.User)
+		if arg.AfterCreate == nil {
+			return nil
+		}
+
+		messages, err := arg.AfterCreate(result.User)
+		if err != nil {
+			return err
+		}
+
+		return createOutboxMessages(ctx, q, messages)
     })
 
     return result, err
-}
\ No newline at end of file
+}
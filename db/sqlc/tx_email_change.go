@@ -0,0 +1,170 @@
+package db
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Email change statuses stored in email_changes.status.
+const (
+	EmailChangePending   = "pending"
+	EmailChangeConfirmed = "confirmed"
+	EmailChangeCancelled = "cancelled"
+)
+
+// ErrEmailChangeInvalid is returned when an email change link does not match
+// a change that can still be confirmed or cancelled.
+var ErrEmailChangeInvalid = errors.New("email change link is invalid, already used or expired")
+
+// EmailChangeTxParams identifies an email change by its id and one of its codes
+type EmailChangeTxParams struct {
+	ID   int64
+	Code string
+	// Audit, when set, is recorded with the change's user as actor and target
+	// and the user before and after the change as its states. The caller sets
+	// the action and client details.
+	Audit *AuditEntry
+}
+
+type EmailChangeTxResult struct {
+	User        User
+	EmailChange EmailChange
+}
+
+// ConfirmEmailChangeTx switches the user to the new address and marks it
+// verified. Code is the secret_code sent to the new address.
+func (store *Store) ConfirmEmailChangeTx(ctx context.Context, arg EmailChangeTxParams) (EmailChangeTxResult, error) {
+	var result EmailChangeTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		change, err := getEmailChangeForCode(ctx, q, arg.ID)
+		if err != nil {
+			return err
+		}
+		if change.Status != EmailChangePending || !codesMatch(change.SecretCode, arg.Code) || isExpired(change.ExpiresAt) {
+			return ErrEmailChangeInvalid
+		}
+
+		before, err := q.GetUserForUpdate(ctx, change.Username)
+		if err != nil {
+			return err
+		}
+
+		result.User, err = q.UpdateUser(ctx, UpdateUserParams{
+			Username:        change.Username,
+			Email:           pgtype.Text{String: change.NewEmail, Valid: true},
+			IsEmailVerified: pgtype.Bool{Bool: true, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+
+		// Links sent to the old address must not verify the new one.
+		if _, err := q.ExpireVerifyEmails(ctx, change.Username); err != nil {
+			return err
+		}
+
+		result.EmailChange, err = q.UpdateEmailChangeStatus(ctx, UpdateEmailChangeStatusParams{
+			ID:     change.ID,
+			Status: EmailChangeConfirmed,
+		})
+		if err != nil {
+			return err
+		}
+
+		return recordEmailChangeAudit(ctx, q, arg.Audit, before, result.User)
+	})
+
+	return result, err
+}
+
+// CancelEmailChangeTx cancels a pending change or, until the change expires,
+// reverts a confirmed one. Code is the cancel_code sent to the old address, so
+// the owner of that address can undo a change made by someone else.
+func (store *Store) CancelEmailChangeTx(ctx context.Context, arg EmailChangeTxParams) (EmailChangeTxResult, error) {
+	var result EmailChangeTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		change, err := getEmailChangeForCode(ctx, q, arg.ID)
+		if err != nil {
+			return err
+		}
+		if change.Status == EmailChangeCancelled || !codesMatch(change.CancelCode, arg.Code) || isExpired(change.ExpiresAt) {
+			return ErrEmailChangeInvalid
+		}
+
+		result.User, err = q.GetUserForUpdate(ctx, change.Username)
+		if err != nil {
+			return err
+		}
+		before := result.User
+
+		if change.Status == EmailChangeConfirmed {
+			if result.User.Email != change.NewEmail {
+				// The address was changed again since; reverting would undo that change.
+				return ErrEmailChangeInvalid
+			}
+
+			result.User, err = q.UpdateUser(ctx, UpdateUserParams{
+				Username:        change.Username,
+				Email:           pgtype.Text{String: change.OldEmail, Valid: true},
+				IsEmailVerified: pgtype.Bool{Bool: change.OldEmailVerified, Valid: true},
+			})
+			if err != nil {
+				return err
+			}
+
+			if _, err := q.ExpireVerifyEmails(ctx, change.Username); err != nil {
+				return err
+			}
+		}
+
+		result.EmailChange, err = q.UpdateEmailChangeStatus(ctx, UpdateEmailChangeStatusParams{
+			ID:     change.ID,
+			Status: EmailChangeCancelled,
+		})
+		if err != nil {
+			return err
+		}
+
+		return recordEmailChangeAudit(ctx, q, arg.Audit, before, result.User)
+	})
+
+	return result, err
+}
+
+func recordEmailChangeAudit(ctx context.Context, q *Queries, audit *AuditEntry, before, after User) error {
+	if audit == nil {
+		return nil
+	}
+
+	entry := *audit
+	entry.Actor = after.Username
+	entry.TargetType = AuditTargetUser
+	entry.TargetID = after.Username
+	entry.Before = NewAuditUser(before)
+	entry.After = NewAuditUser(after)
+	_, err := q.RecordAuditEvent(ctx, entry)
+	return err
+}
+
+func getEmailChangeForCode(ctx context.Context, q *Queries, id int64) (EmailChange, error) {
+	change, err := q.GetEmailChangeForUpdate(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return EmailChange{}, ErrEmailChangeInvalid
+	}
+	return change, err
+}
+
+func codesMatch(want, got string) bool {
+	return subtle.ConstantTimeCompare([]byte(want), []byte(got)) == 1
+}
+
+func isExpired(expiresAt pgtype.Timestamptz) bool {
+	return !expiresAt.Time.After(time.Now())
+}
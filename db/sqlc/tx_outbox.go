@@ -0,0 +1,43 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/a7medalyapany/GoBank.git/tracing"
+)
+
+// OutboxTx runs fn in a database transaction and writes the outbox messages it
+// returns in that same transaction, so the tasks are published only if fn's
+// changes are committed.
+func (store *Store) OutboxTx(ctx context.Context, fn func(q *Queries) ([]CreateOutboxMessageParams, error)) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		messages, err := fn(q)
+		if err != nil {
+			return err
+		}
+		return createOutboxMessages(ctx, q, messages)
+	})
+}
+
+// createOutboxMessages writes messages, stamping each with the trace context of
+// ctx so the task is processed as part of the request that produced it.
+func createOutboxMessages(ctx context.Context, q *Queries, messages []CreateOutboxMessageParams) error {
+	var headers []byte
+	if carrier := tracing.Inject(ctx); carrier != nil {
+		var err error
+		if headers, err = json.Marshal(carrier); err != nil {
+			return err
+		}
+	}
+
+	for _, message := range messages {
+		if message.Headers == nil {
+			message.Headers = headers
+		}
+		if _, err := q.CreateOutboxMessage(ctx, message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
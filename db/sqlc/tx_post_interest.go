@@ -0,0 +1,115 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/a7medalyapany/GoBank.git/util"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ErrNoUnpostedInterest is returned when the account has no accruals to post.
+var ErrNoUnpostedInterest = errors.New("no unposted interest")
+
+// PostInterestTxParams is input for posting the interest of an account
+type PostInterestTxParams struct {
+	AccountID int64
+	// Before is the first day not posted: accruals for earlier days are
+	// posted, later ones wait for the next posting.
+	Before pgtype.Date
+}
+
+type PostInterestTxResult struct {
+	Posting InterestPosting
+	// Transfer is nil when the interest rounded down to zero minor units.
+	Transfer *TransferTxResult
+}
+
+// PostInterestTx credits the unposted interest accrued by an account before
+// arg.Before with a transfer from the interest expense account of its
+// currency. Interest accrues in millionths of a minor unit: the total is
+// rounded down to whole minor units and the remainder is carried into the
+// next posting, so no fraction is ever lost or paid twice.
+func (store *Store) PostInterestTx(ctx context.Context, arg PostInterestTxParams) (PostInterestTxResult, error) {
+	var result PostInterestTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// Locking the account serializes postings for it.
+		account, err := q.GetAccountForUpdate(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		unposted, err := q.GetUnpostedInterest(ctx, GetUnpostedInterestParams{
+			AccountID: account.ID,
+			Before:    arg.Before,
+		})
+		if err != nil {
+			return err
+		}
+		if unposted.Accruals == 0 {
+			return ErrNoUnpostedInterest
+		}
+
+		accrued := unposted.AmountMicros
+		last, err := q.GetLastInterestPosting(ctx, account.ID)
+		switch {
+		case err == nil:
+			accrued += last.CarryMicros
+		case !errors.Is(err, pgx.ErrNoRows):
+			return err
+		}
+
+		amount := accrued / util.MicrosPerMinorUnit
+		posting := CreateInterestPostingParams{
+			AccountID:     account.ID,
+			Amount:        amount,
+			AccruedMicros: accrued,
+			CarryMicros:   accrued % util.MicrosPerMinorUnit,
+			PeriodStart:   unposted.PeriodStart,
+			PeriodEnd:     unposted.PeriodEnd,
+		}
+
+		if amount > 0 {
+			expense, err := interestExpenseAccount(ctx, q, account.Currency)
+			if err != nil {
+				return err
+			}
+
+			paid, err := transfer(ctx, q, expense.ID, account.ID, amount, "")
+			if err != nil {
+				return err
+			}
+			result.Transfer = &paid
+			posting.TransferID = pgtype.Int8{Int64: paid.Transfer.ID, Valid: true}
+		}
+
+		result.Posting, err = q.CreateInterestPosting(ctx, posting)
+		if err != nil {
+			return err
+		}
+
+		_, err = q.MarkInterestAccrualsPosted(ctx, MarkInterestAccrualsPostedParams{
+			PostingID: pgtype.Int8{Int64: result.Posting.ID, Valid: true},
+			AccountID: account.ID,
+			Before:    arg.Before,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+// interestExpenseAccount returns the interest expense account of currency,
+// opening it the first time interest is paid in that currency.
+func interestExpenseAccount(ctx context.Context, q *Queries, currency string) (Account, error) {
+	err := q.CreateInterestExpenseAccount(ctx, CreateInterestExpenseAccountParams{
+		Owner:    SystemUsername,
+		Currency: currency,
+	})
+	if err != nil {
+		return Account{}, err
+	}
+	return q.GetInterestExpenseAccount(ctx, currency)
+}
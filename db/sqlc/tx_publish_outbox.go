@@ -0,0 +1,70 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// PublishOutboxTxParams is input for publishing a batch of outbox messages
+type PublishOutboxTxParams struct {
+	BatchSize int32
+	// Publish hands a message to the task queue. Returning an error leaves the
+	// message pending so a later batch retries it.
+	Publish func(message Outbox) error
+	// MaxAttempts is how many failed publishes make a message dead.
+	MaxAttempts int32
+	// Backoff returns how long to wait before retrying a message that has
+	// failed attempts times.
+	Backoff func(attempts int32) time.Duration
+}
+
+type PublishOutboxTxResult struct {
+	Published int
+	Failed    int
+	// Dead holds the messages that failed for the last time in this batch.
+	Dead []Outbox
+}
+
+// PublishOutboxTx locks a batch of pending outbox messages, publishes each one
+// and records the outcome. Rows stay locked until commit, so several relays
+// can run side by side without publishing the same message twice.
+func (store *Store) PublishOutboxTx(ctx context.Context, arg PublishOutboxTxParams) (PublishOutboxTxResult, error) {
+	var result PublishOutboxTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		messages, err := q.ListPendingOutboxMessages(ctx, arg.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, message := range messages {
+			if publishErr := arg.Publish(message); publishErr != nil {
+				failed, err := q.MarkOutboxMessageFailed(ctx, MarkOutboxMessageFailedParams{
+					LastError:     pgtype.Text{String: publishErr.Error(), Valid: true},
+					NextAttemptAt: pgtype.Timestamptz{Time: time.Now().Add(arg.Backoff(message.Attempts + 1)), Valid: true},
+					MaxAttempts:   arg.MaxAttempts,
+					ID:            message.ID,
+				})
+				if err != nil {
+					return err
+				}
+				result.Failed++
+				if failed.DeadAt.Valid {
+					result.Dead = append(result.Dead, failed)
+				}
+				continue
+			}
+
+			if err := q.MarkOutboxMessagePublished(ctx, message.ID); err != nil {
+				return err
+			}
+			result.Published++
+		}
+
+		return nil
+	})
+
+	return result, err
+}
@@ -0,0 +1,92 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ErrEmailAlreadyVerified is returned by ResendVerifyEmailTx when there is
+// nothing left to verify.
+var ErrEmailAlreadyVerified = errors.New("email is already verified")
+
+// ResendCooldownError is returned by ResendVerifyEmailTx when the previous
+// code was created less than the cooldown ago.
+type ResendCooldownError struct {
+	RetryAfter time.Duration
+}
+
+func (e *ResendCooldownError) Error() string {
+	return fmt.Sprintf("verification email was sent recently, retry in %s", e.RetryAfter.Round(time.Second))
+}
+
+// ResendVerifyEmailTxParams is input for sending a new verification code
+type ResendVerifyEmailTxParams struct {
+	Username   string
+	SecretCode string
+	Cooldown   time.Duration
+	// AfterCreate returns the outbox messages that deliver the new code.
+	AfterCreate func(verifyEmail VerifyEmail) ([]CreateOutboxMessageParams, error)
+}
+
+type ResendVerifyEmailTxResult struct {
+	User        User
+	VerifyEmail VerifyEmail
+}
+
+// ResendVerifyEmailTx expires the user's unused codes and creates a new one.
+// The user row is locked for the duration, so concurrent requests cannot both
+// pass the cooldown check.
+func (store *Store) ResendVerifyEmailTx(ctx context.Context, arg ResendVerifyEmailTxParams) (ResendVerifyEmailTxResult, error) {
+	var result ResendVerifyEmailTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.User, err = q.GetUserForUpdate(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+		if result.User.IsEmailVerified {
+			return ErrEmailAlreadyVerified
+		}
+
+		latest, err := q.GetLatestVerifyEmail(ctx, arg.Username)
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+		case err != nil:
+			return err
+		default:
+			if wait := arg.Cooldown - time.Since(latest.CreatedAt.Time); wait > 0 {
+				return &ResendCooldownError{RetryAfter: wait}
+			}
+		}
+
+		if _, err := q.ExpireVerifyEmails(ctx, arg.Username); err != nil {
+			return err
+		}
+
+		result.VerifyEmail, err = q.CreateVerifyEmail(ctx, CreateVerifyEmailParams{
+			Username:   result.User.Username,
+			Email:      result.User.Email,
+			SecretCode: arg.SecretCode,
+		})
+		if err != nil {
+			return err
+		}
+
+		if arg.AfterCreate == nil {
+			return nil
+		}
+		messages, err := arg.AfterCreate(result.VerifyEmail)
+		if err != nil {
+			return err
+		}
+		return createOutboxMessages(ctx, q, messages)
+	})
+
+	return result, err
+}
@@ -2,15 +2,38 @@ package db
 
 import (
 	"context"
+	"errors"
+	"strconv"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+// SpendLimitWindow is the period a member's spend limit covers.
+const SpendLimitWindow = 24 * time.Hour
+
+// ErrSpendLimitExceeded is returned by TransferTx when a transfer would take
+// what its initiator has transferred from the account within SpendLimitWindow
+// over their spend limit.
+var ErrSpendLimitExceeded = errors.New("spend limit exceeded")
+
 // TransferTxParams is input for transfer
 type TransferTxParams struct {
 	FromAccountID int64 `json:"from_account_id"`
 	ToAccountID   int64 `json:"to_account_id"`
 	Amount        int64 `json:"amount"`
+	// InitiatedBy is the user making the transfer. It is empty for transfers
+	// the bank makes.
+	InitiatedBy string `json:"initiated_by"`
+	// SpendLimit, when valid, caps what InitiatedBy may transfer from the
+	// source account within SpendLimitWindow, this transfer included.
+	SpendLimit pgtype.Int8 `json:"-"`
+	// AfterTransfer returns background tasks to run for the completed transfer,
+	// such as notifications. They are written to the outbox in the same transaction.
+	AfterTransfer func(result TransferTxResult) ([]CreateOutboxMessageParams, error) `json:"-"`
+	// Audit, when set, is recorded in the same transaction with the new
+	// transfer as its target and the transfer result as its after state.
+	Audit *AuditEntry `json:"-"`
 }
 
 type TransferTxResult struct {
@@ -19,6 +42,11 @@ type TransferTxResult struct {
 	ToAccount   Account  `json:"to_account"`
 	FromEntry   Entry    `json:"from_entry"`
 	ToEntry     Entry    `json:"to_entry"`
+	// FromMembers and ToMembers are the active members of each account, the
+	// owners included, for AfterTransfer to tell about the transfer. They
+	// are only loaded when AfterTransfer is set.
+	FromMembers []string `json:"-"`
+	ToMembers   []string `json:"-"`
 }
 
 // TransferTx performs a money transfer from one account to another
@@ -27,56 +55,121 @@ func (store *Store) TransferTx(ctx context.Context, arg TransferTxParams) (Trans
 
 	err := store.execTx(ctx, func(q *Queries) error {
 		var err error
-
-		result.Transfer, err = q.CreateTransfer(ctx, CreateTransferParams{
-			FromAccountID: arg.FromAccountID,
-			ToAccountID:   arg.ToAccountID,
-			Amount:        arg.Amount,
-		})
+		result, err = transfer(ctx, q, arg.FromAccountID, arg.ToAccountID, arg.Amount, arg.InitiatedBy)
 		if err != nil {
 			return err
 		}
 
-		// Entries
-		result.FromEntry, err = q.CreateTransferEntry(ctx, CreateTransferEntryParams{
-			AccountID:  arg.FromAccountID,
-			Amount:     -arg.Amount,
-			TransferID: pgtype.Int8{Int64: result.Transfer.ID, Valid: true},
-		})
+		// Transfers are serialized by the ledger chain by now, so the sum
+		// sees every earlier transfer and none can slip in beside this one.
+		if arg.SpendLimit.Valid {
+			spent, err := q.SumTransfersByInitiator(ctx, SumTransfersByInitiatorParams{
+				FromAccountID: arg.FromAccountID,
+				InitiatedBy:   pgtype.Text{String: arg.InitiatedBy, Valid: true},
+				Since:         pgtype.Timestamptz{Time: time.Now().Add(-SpendLimitWindow), Valid: true},
+			})
+			if err != nil {
+				return err
+			}
+			if spent > arg.SpendLimit.Int64 {
+				return ErrSpendLimitExceeded
+			}
+		}
+
+		if arg.Audit != nil {
+			entry := *arg.Audit
+			entry.TargetType = AuditTargetTransfer
+			entry.TargetID = strconv.FormatInt(result.Transfer.ID, 10)
+			entry.After = result
+			if _, err := q.RecordAuditEvent(ctx, entry); err != nil {
+				return err
+			}
+		}
+
+		if arg.AfterTransfer == nil {
+			return nil
+		}
+
+		result.FromMembers, err = q.ListActiveMemberUsernames(ctx, arg.FromAccountID)
 		if err != nil {
 			return err
 		}
-
-		result.ToEntry, err = q.CreateTransferEntry(ctx, CreateTransferEntryParams{
-			AccountID:  arg.ToAccountID,
-			Amount:     arg.Amount,
-			TransferID: pgtype.Int8{Int64: result.Transfer.ID, Valid: true},
-		})
+		result.ToMembers, err = q.ListActiveMemberUsernames(ctx, arg.ToAccountID)
 		if err != nil {
 			return err
 		}
 
-		// Update balances (deadlock-safe order)
-		if arg.FromAccountID < arg.ToAccountID {
-			result.FromAccount, result.ToAccount, err = addMoney(
-				ctx, q,
-				arg.FromAccountID, -arg.Amount,
-				arg.ToAccountID, arg.Amount,
-			)
-		} else {
-			result.ToAccount, result.FromAccount, err = addMoney(
-				ctx, q,
-				arg.ToAccountID, arg.Amount,
-				arg.FromAccountID, -arg.Amount,
-			)
+		messages, err := arg.AfterTransfer(result)
+		if err != nil {
+			return err
 		}
 
-		return err
+		return createOutboxMessages(ctx, q, messages)
 	})
 
 	return result, err
 }
 
+// transfer moves amount from one account to another inside a transaction on
+// behalf of initiatedBy, if set: it records the transfer and both entries, updates the balances and chains
+// the transfer into the ledger. Chaining serializes transfers until commit,
+// so callers should only write their own bookkeeping rows after it.
+func transfer(ctx context.Context, q *Queries, fromAccountID, toAccountID, amount int64, initiatedBy string) (TransferTxResult, error) {
+	var result TransferTxResult
+	var err error
+
+	result.Transfer, err = q.CreateTransfer(ctx, CreateTransferParams{
+		FromAccountID: fromAccountID,
+		ToAccountID:   toAccountID,
+		Amount:        amount,
+		InitiatedBy:   pgtype.Text{String: initiatedBy, Valid: initiatedBy != ""},
+	})
+	if err != nil {
+		return result, err
+	}
+
+	// Entries
+	result.FromEntry, err = q.CreateTransferEntry(ctx, CreateTransferEntryParams{
+		AccountID:  fromAccountID,
+		Amount:     -amount,
+		TransferID: pgtype.Int8{Int64: result.Transfer.ID, Valid: true},
+	})
+	if err != nil {
+		return result, err
+	}
+
+	result.ToEntry, err = q.CreateTransferEntry(ctx, CreateTransferEntryParams{
+		AccountID:  toAccountID,
+		Amount:     amount,
+		TransferID: pgtype.Int8{Int64: result.Transfer.ID, Valid: true},
+	})
+	if err != nil {
+		return result, err
+	}
+
+	// Update balances (deadlock-safe order)
+	if fromAccountID < toAccountID {
+		result.FromAccount, result.ToAccount, err = addMoney(
+			ctx, q,
+			fromAccountID, -amount,
+			toAccountID, amount,
+		)
+	} else {
+		result.ToAccount, result.FromAccount, err = addMoney(
+			ctx, q,
+			toAccountID, amount,
+			fromAccountID, -amount,
+		)
+	}
+	if err != nil {
+		return result, err
+	}
+
+	// Chain the transfer once it is complete.
+	_, err = appendLedgerChain(ctx, q, result.Transfer, result.FromEntry, result.ToEntry)
+	return result, err
+}
+
 func addMoney(
 	ctx context.Context,
 	q *Queries,
@@ -2,10 +2,15 @@ package db
 
 import (
 	"context"
+	"errors"
 
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+// ErrVerifyEmailStale is returned when the code was sent to an email address
+// the user has since changed.
+var ErrVerifyEmailStale = errors.New("verification code was sent to a previous email address")
+
 // VerifyEmailTxParams is input for verifying an email
 type VerifyEmailTxParams struct {
 	EmailId     int64
@@ -31,6 +36,15 @@ func (store *Store) VerifyEmailTx(ctx context.Context, arg VerifyEmailTxParams)
 			return err
 		}
 
+		user, err := q.GetUserForUpdate(ctx, result.VerifyEmail.Username)
+		if err != nil {
+			return err
+		}
+		if user.Email != result.VerifyEmail.Email {
+			// The code was sent to an address the user no longer has.
+			return ErrVerifyEmailStale
+		}
+
 		result.User, err = q.UpdateUser(ctx, UpdateUserParams{
 			Username:        result.VerifyEmail.Username,
 			IsEmailVerified: pgtype.Bool{Bool: true, Valid: true},
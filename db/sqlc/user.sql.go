@@ -12,9 +12,9 @@ import (
 )
 
 const createUser = `-- name: CreateUser :one
-INSERT INTO users (username, hashed_password, full_name, email) 
-VALUES ($1, $2, $3, $4)
-RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified
+INSERT INTO users (username, hashed_password, full_name, email, locale) 
+VALUES ($1, $2, $3, $4, $5)
+RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified, locale, role
 `
 
 type CreateUserParams struct {
@@ -22,6 +22,7 @@ type CreateUserParams struct {
 	HashedPassword string `json:"hashed_password"`
 	FullName       string `json:"full_name"`
 	Email          string `json:"email"`
+	Locale         string `json:"locale"`
 }
 
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
@@ -30,6 +31,7 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		arg.HashedPassword,
 		arg.FullName,
 		arg.Email,
+		arg.Locale,
 	)
 	var i User
 	err := row.Scan(
@@ -40,12 +42,14 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.PasswordChangedAt,
 		&i.CreatedAt,
 		&i.IsEmailVerified,
+		&i.Locale,
+		&i.Role,
 	)
 	return i, err
 }
 
 const getUser = `-- name: GetUser :one
-SELECT username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified FROM users 
+SELECT username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified, locale, role FROM users 
 WHERE username = $1 LIMIT 1
 `
 
@@ -60,6 +64,33 @@ func (q *Queries) GetUser(ctx context.Context, username string) (User, error) {
 		&i.PasswordChangedAt,
 		&i.CreatedAt,
 		&i.IsEmailVerified,
+		&i.Locale,
+		&i.Role,
+	)
+	return i, err
+}
+
+const getUserForUpdate = `-- name: GetUserForUpdate :one
+SELECT username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified, locale, role FROM users
+WHERE username = $1 LIMIT 1
+FOR NO KEY UPDATE
+`
+
+// Locks the user row until the end of the transaction, so per-user checks
+// such as resend cooldowns cannot race.
+func (q *Queries) GetUserForUpdate(ctx context.Context, username string) (User, error) {
+	row := q.db.QueryRow(ctx, getUserForUpdate, username)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.PasswordChangedAt,
+		&i.CreatedAt,
+		&i.IsEmailVerified,
+		&i.Locale,
+		&i.Role,
 	)
 	return i, err
 }
@@ -71,9 +102,10 @@ SET
 	password_changed_at = COALESCE($2, password_changed_at),
 	full_name = COALESCE($3, full_name),
 	email = COALESCE($4, email),
-	is_email_verified = COALESCE($5, is_email_verified)
-WHERE username = $6
-RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified
+	is_email_verified = COALESCE($5, is_email_verified),
+	locale = COALESCE($6, locale)
+WHERE username = $7
+RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, is_email_verified, locale, role
 `
 
 type UpdateUserParams struct {
@@ -82,6 +114,7 @@ type UpdateUserParams struct {
 	FullName          pgtype.Text        `json:"full_name"`
 	Email             pgtype.Text        `json:"email"`
 	IsEmailVerified   pgtype.Bool        `json:"is_email_verified"`
+	Locale            pgtype.Text        `json:"locale"`
 	Username          string             `json:"username"`
 }
 
@@ -92,6 +125,7 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		arg.FullName,
 		arg.Email,
 		arg.IsEmailVerified,
+		arg.Locale,
 		arg.Username,
 	)
 	var i User
@@ -103,6 +137,8 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		&i.PasswordChangedAt,
 		&i.CreatedAt,
 		&i.IsEmailVerified,
+		&i.Locale,
+		&i.Role,
 	)
 	return i, err
 }
@@ -19,6 +19,7 @@ func createRandomUser(t *testing.T) User {
 		HashedPassword: hashedPassword,
 		FullName: util.RandomOwner(),
 		Email:    util.RandomEmail(),
+		Locale:   util.LocaleEnglish,
 	}
 
 	user, err := testQueries.CreateUser(context.Background(), arg)
@@ -30,6 +31,7 @@ func createRandomUser(t *testing.T) User {
 	require.Equal(t, arg.FullName, user.FullName)
 	require.Equal(t, arg.Email, user.Email)
 	require.Equal(t, arg.HashedPassword, user.HashedPassword)
+	require.Equal(t, arg.Locale, user.Locale)
 
 	require.True(t, user.PasswordChangedAt.Time.IsZero())
 	require.NotZero(t, user.CreatedAt)
@@ -91,6 +93,20 @@ func TestUpdateUserEmail(t *testing.T) {
 	require.Equal(t, oldUser.HashedPassword, updatedUser.HashedPassword)
 }
 
+func TestUpdateUserLocale(t *testing.T) {
+	oldUser := createRandomUser(t)
+
+	updatedUser, err := testQueries.UpdateUser(context.Background(), UpdateUserParams{
+		Username: oldUser.Username,
+		Locale:   pgtype.Text{String: util.LocaleArabic, Valid: true},
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, util.LocaleArabic, updatedUser.Locale)
+	require.Equal(t, oldUser.Email, updatedUser.Email)
+	require.Equal(t, oldUser.FullName, updatedUser.FullName)
+}
+
 func TestUpdateUserPassword(t *testing.T) {
 	oldUser := createRandomUser(t)
 
@@ -7,6 +7,8 @@ package db
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createVerifyEmail = `-- name: CreateVerifyEmail :one
@@ -36,6 +38,80 @@ func (q *Queries) CreateVerifyEmail(ctx context.Context, arg CreateVerifyEmailPa
 	return i, err
 }
 
+const deleteExpiredVerifyEmails = `-- name: DeleteExpiredVerifyEmails :execrows
+DELETE FROM verify_emails
+WHERE is_used = false
+  AND expires_at < $1
+`
+
+// Purges unused codes that expired before the given time. Used codes are
+// kept as a record of when the address was verified.
+func (q *Queries) DeleteExpiredVerifyEmails(ctx context.Context, expiresAt pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteExpiredVerifyEmails, expiresAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const expireVerifyEmails = `-- name: ExpireVerifyEmails :execrows
+UPDATE verify_emails SET expires_at = now()
+WHERE username = $1
+  AND is_used = false
+  AND expires_at > now()
+`
+
+// Invalidates every unused code of a user, so only the newest link works.
+func (q *Queries) ExpireVerifyEmails(ctx context.Context, username string) (int64, error) {
+	result, err := q.db.Exec(ctx, expireVerifyEmails, username)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getLatestVerifyEmail = `-- name: GetLatestVerifyEmail :one
+SELECT id, username, email, secret_code, is_used, created_at, expires_at FROM verify_emails
+WHERE username = $1
+ORDER BY id DESC
+LIMIT 1
+`
+
+func (q *Queries) GetLatestVerifyEmail(ctx context.Context, username string) (VerifyEmail, error) {
+	row := q.db.QueryRow(ctx, getLatestVerifyEmail, username)
+	var i VerifyEmail
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Email,
+		&i.SecretCode,
+		&i.IsUsed,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const getVerifyEmail = `-- name: GetVerifyEmail :one
+SELECT id, username, email, secret_code, is_used, created_at, expires_at FROM verify_emails
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetVerifyEmail(ctx context.Context, id int64) (VerifyEmail, error) {
+	row := q.db.QueryRow(ctx, getVerifyEmail, id)
+	var i VerifyEmail
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Email,
+		&i.SecretCode,
+		&i.IsUsed,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
 const updateVerifyEmail = `-- name: UpdateVerifyEmail :one
 UPDATE verify_emails SET is_used = true
 WHERE id = $1
@@ -0,0 +1,98 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/a7medalyapany/GoBank.git/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomVerifyEmail(t *testing.T, user User) VerifyEmail {
+	verifyEmail, err := testQueries.CreateVerifyEmail(context.Background(), CreateVerifyEmailParams{
+		Username:   user.Username,
+		Email:      user.Email,
+		SecretCode: util.RandomString(32),
+	})
+	require.NoError(t, err)
+	require.False(t, verifyEmail.IsUsed)
+	require.True(t, verifyEmail.ExpiresAt.Time.After(time.Now()))
+	return verifyEmail
+}
+
+func TestResendVerifyEmailTx(t *testing.T) {
+	store := NewStore(testDB)
+	user := createRandomUser(t)
+	old := createRandomVerifyEmail(t, user)
+
+	var queued []VerifyEmail
+	arg := ResendVerifyEmailTxParams{
+		Username:   user.Username,
+		SecretCode: util.RandomString(32),
+		AfterCreate: func(verifyEmail VerifyEmail) ([]CreateOutboxMessageParams, error) {
+			queued = append(queued, verifyEmail)
+			return nil, nil
+		},
+	}
+
+	result, err := store.ResendVerifyEmailTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEqual(t, old.ID, result.VerifyEmail.ID)
+	require.Equal(t, arg.SecretCode, result.VerifyEmail.SecretCode)
+	require.Len(t, queued, 1)
+
+	// The earlier code no longer works.
+	_, err = testQueries.UpdateVerifyEmail(context.Background(), UpdateVerifyEmailParams{
+		ID:         old.ID,
+		SecretCode: old.SecretCode,
+	})
+	require.Error(t, err)
+
+	verified, err := store.VerifyEmailTx(context.Background(), VerifyEmailTxParams{
+		EmailId:    result.VerifyEmail.ID,
+		SecretCode: result.VerifyEmail.SecretCode,
+	})
+	require.NoError(t, err)
+	require.True(t, verified.User.IsEmailVerified)
+
+	_, err = store.ResendVerifyEmailTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrEmailAlreadyVerified)
+}
+
+func TestResendVerifyEmailTxCooldown(t *testing.T) {
+	store := NewStore(testDB)
+	user := createRandomUser(t)
+	createRandomVerifyEmail(t, user)
+
+	_, err := store.ResendVerifyEmailTx(context.Background(), ResendVerifyEmailTxParams{
+		Username:   user.Username,
+		SecretCode: util.RandomString(32),
+		Cooldown:   time.Minute,
+	})
+
+	var cooldownErr *ResendCooldownError
+	require.ErrorAs(t, err, &cooldownErr)
+	require.Greater(t, cooldownErr.RetryAfter, time.Duration(0))
+	require.LessOrEqual(t, cooldownErr.RetryAfter, time.Minute)
+}
+
+func TestDeleteExpiredVerifyEmails(t *testing.T) {
+	user := createRandomUser(t)
+	expired := createRandomVerifyEmail(t, user)
+	_, err := testQueries.ExpireVerifyEmails(context.Background(), user.Username)
+	require.NoError(t, err)
+	current := createRandomVerifyEmail(t, user)
+
+	deleted, err := testQueries.DeleteExpiredVerifyEmails(context.Background(), pgtype.Timestamptz{Time: time.Now().Add(time.Second), Valid: true})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, deleted, int64(1))
+
+	_, err = testQueries.GetVerifyEmail(context.Background(), expired.ID)
+	require.Error(t, err)
+
+	latest, err := testQueries.GetLatestVerifyEmail(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Equal(t, current.ID, latest.ID)
+}
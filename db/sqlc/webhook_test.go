@@ -0,0 +1,161 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/a7medalyapany/GoBank.git/util"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomWebhookEndpoint(t *testing.T, user User, eventTypes ...string) WebhookEndpoint {
+	if len(eventTypes) == 0 {
+		eventTypes = []string{"transfer.sent", "transfer.received"}
+	}
+
+	arg := CreateWebhookEndpointParams{
+		Username:   user.Username,
+		Url:        "https://example.com/" + util.RandomString(8),
+		Secret:     "whsec_" + util.RandomString(32),
+		EventTypes: eventTypes,
+	}
+
+	endpoint, err := testQueries.CreateWebhookEndpoint(context.Background(), arg)
+	require.NoError(t, err)
+
+	require.NotZero(t, endpoint.ID)
+	require.Equal(t, arg.Username, endpoint.Username)
+	require.Equal(t, arg.Url, endpoint.Url)
+	require.Equal(t, arg.Secret, endpoint.Secret)
+	require.Equal(t, arg.EventTypes, endpoint.EventTypes)
+	require.NotZero(t, endpoint.CreatedAt)
+	return endpoint
+}
+
+func createRandomWebhookDelivery(t *testing.T, endpoint WebhookEndpoint) WebhookDelivery {
+	arg := CreateWebhookDeliveryParams{
+		EndpointID: endpoint.ID,
+		EventID:    util.RandomString(16),
+		EventType:  "transfer.sent",
+		Payload:    []byte(`{"type":"transfer.sent"}`),
+	}
+
+	delivery, err := testQueries.CreateWebhookDelivery(context.Background(), arg)
+	require.NoError(t, err)
+
+	require.NotZero(t, delivery.ID)
+	require.Equal(t, arg.EndpointID, delivery.EndpointID)
+	require.Equal(t, arg.EventID, delivery.EventID)
+	require.Equal(t, "pending", delivery.Status)
+	require.Zero(t, delivery.Attempts)
+	require.False(t, delivery.DeliveredAt.Valid)
+	return delivery
+}
+
+func TestCreateWebhookEndpoint(t *testing.T) {
+	createRandomWebhookEndpoint(t, createRandomUser(t))
+}
+
+func TestListWebhookEndpointsForEvent(t *testing.T) {
+	user := createRandomUser(t)
+	sent := createRandomWebhookEndpoint(t, user, "transfer.sent")
+	createRandomWebhookEndpoint(t, user, "account.created")
+	createRandomWebhookEndpoint(t, createRandomUser(t), "transfer.sent")
+
+	endpoints, err := testQueries.ListWebhookEndpointsForEvent(context.Background(), ListWebhookEndpointsForEventParams{
+		Username:  user.Username,
+		EventType: "transfer.sent",
+	})
+	require.NoError(t, err)
+	require.Len(t, endpoints, 1)
+	require.Equal(t, sent.ID, endpoints[0].ID)
+}
+
+func TestDeleteWebhookEndpoint(t *testing.T) {
+	endpoint := createRandomWebhookEndpoint(t, createRandomUser(t))
+	delivery := createRandomWebhookDelivery(t, endpoint)
+
+	deleted, err := testQueries.DeleteWebhookEndpoint(context.Background(), DeleteWebhookEndpointParams{
+		ID:       endpoint.ID,
+		Username: createRandomUser(t).Username,
+	})
+	require.NoError(t, err)
+	require.Zero(t, deleted)
+
+	deleted, err = testQueries.DeleteWebhookEndpoint(context.Background(), DeleteWebhookEndpointParams{
+		ID:       endpoint.ID,
+		Username: endpoint.Username,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, deleted)
+
+	_, err = testQueries.GetWebhookDelivery(context.Background(), delivery.ID)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+}
+
+func TestCreateWebhookDeliveryIsIdempotent(t *testing.T) {
+	endpoint := createRandomWebhookEndpoint(t, createRandomUser(t))
+	delivery := createRandomWebhookDelivery(t, endpoint)
+
+	again, err := testQueries.CreateWebhookDelivery(context.Background(), CreateWebhookDeliveryParams{
+		EndpointID: endpoint.ID,
+		EventID:    delivery.EventID,
+		EventType:  delivery.EventType,
+		Payload:    []byte(`{}`),
+	})
+	require.NoError(t, err)
+	require.Equal(t, delivery.ID, again.ID)
+	require.JSONEq(t, string(delivery.Payload), string(again.Payload))
+}
+
+func TestWebhookDeliveryLifecycle(t *testing.T) {
+	endpoint := createRandomWebhookEndpoint(t, createRandomUser(t))
+	delivery := createRandomWebhookDelivery(t, endpoint)
+
+	err := testQueries.MarkWebhookDeliveryFailed(context.Background(), MarkWebhookDeliveryFailedParams{
+		ID:             delivery.ID,
+		Status:         "dead",
+		ResponseStatus: pgtype.Int4{Int32: 500, Valid: true},
+		LastError:      pgtype.Text{String: "receiver returned 500", Valid: true},
+	})
+	require.NoError(t, err)
+
+	dead, err := testQueries.ListWebhookDeliveries(context.Background(), ListWebhookDeliveriesParams{
+		EndpointID: endpoint.ID,
+		Status:     pgtype.Text{String: "dead", Valid: true},
+		LimitArg:   10,
+		OffsetArg:  0,
+	})
+	require.NoError(t, err)
+	require.Len(t, dead, 1)
+	require.Equal(t, delivery.ID, dead[0].ID)
+	require.EqualValues(t, 1, dead[0].Attempts)
+	require.EqualValues(t, 500, dead[0].ResponseStatus.Int32)
+
+	reset, err := testQueries.ResetWebhookDelivery(context.Background(), delivery.ID)
+	require.NoError(t, err)
+	require.Equal(t, "pending", reset.Status)
+
+	err = testQueries.MarkWebhookDeliverySucceeded(context.Background(), MarkWebhookDeliverySucceededParams{
+		ID:             delivery.ID,
+		ResponseStatus: pgtype.Int4{Int32: 204, Valid: true},
+	})
+	require.NoError(t, err)
+
+	succeeded, err := testQueries.GetWebhookDelivery(context.Background(), delivery.ID)
+	require.NoError(t, err)
+	require.Equal(t, "succeeded", succeeded.Status)
+	require.EqualValues(t, 2, succeeded.Attempts)
+	require.True(t, succeeded.DeliveredAt.Valid)
+	require.False(t, succeeded.LastError.Valid)
+
+	all, err := testQueries.ListWebhookDeliveries(context.Background(), ListWebhookDeliveriesParams{
+		EndpointID: endpoint.ID,
+		LimitArg:   10,
+		OffsetArg:  0,
+	})
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+}
@@ -8,6 +8,8 @@ CREATE TABLE "users" (
   "full_name" varchar NOT NULL,
   "email" varchar UNIQUE NOT NULL,
   "is_email_verified" boolean NOT NULL DEFAULT false,
+  "locale" varchar NOT NULL DEFAULT 'en',
+  "role" varchar NOT NULL DEFAULT 'customer',
   "password_changed_at" timestamptz NOT NULL DEFAULT (0001-01-01 00:00:00Z),
   "created_at" timestamptz NOT NULL DEFAULT (now())
 );
@@ -27,7 +29,10 @@ CREATE TABLE "accounts" (
   "owner" varchar NOT NULL,
   "balance" decimal NOT NULL,
   "currency" varchar NOT NULL,
-  "created_at" timestamptz NOT NULL DEFAULT (now())
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "type" varchar NOT NULL DEFAULT 'checking',
+  "nickname" varchar NOT NULL DEFAULT '',
+  "account_number" varchar UNIQUE NOT NULL DEFAULT (generate_account_number())
 );
 
 CREATE TABLE "entries" (
@@ -42,7 +47,8 @@ CREATE TABLE "transfers" (
   "from_account_id" bigint NOT NULL,
   "to_account_id" bigint NOT NULL,
   "amount" decimal NOT NULL,
-  "created_at" timestamptz NOT NULL DEFAULT (now())
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "initiated_by" varchar
 );
 
 CREATE TABLE "sessions" (
@@ -56,9 +62,150 @@ CREATE TABLE "sessions" (
   "created_at" timestamptz NOT NULL DEFAULT (now())
 );
 
-CREATE INDEX ON "accounts" ("owner");
+CREATE TABLE "api_keys" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "name" varchar NOT NULL,
+  "prefix" varchar UNIQUE NOT NULL,
+  "hashed_key" varchar NOT NULL,
+  "scopes" varchar[] NOT NULL,
+  "expires_at" timestamptz,
+  "last_used_at" timestamptz,
+  "revoked_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "outbox" (
+  "id" bigserial PRIMARY KEY,
+  "task_type" varchar NOT NULL,
+  "payload" jsonb NOT NULL,
+  "queue" varchar NOT NULL,
+  "max_retry" int NOT NULL,
+  "process_at" timestamptz NOT NULL DEFAULT (now()),
+  "attempts" int NOT NULL DEFAULT 0,
+  "last_error" varchar,
+  "published_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "headers" jsonb NOT NULL DEFAULT '{}',
+  "next_attempt_at" timestamptz NOT NULL DEFAULT (now()),
+  "dead_at" timestamptz
+);
+
+CREATE TABLE "notifications" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "type" varchar NOT NULL,
+  "title" varchar NOT NULL,
+  "body" varchar NOT NULL,
+  "transfer_id" bigint,
+  "emailed_at" timestamptz,
+  "read_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "notification_preferences" (
+  "username" varchar PRIMARY KEY,
+  "transfer_email" boolean NOT NULL DEFAULT true,
+  "transfer_in_app" boolean NOT NULL DEFAULT true,
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "webhook_endpoints" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "url" varchar NOT NULL,
+  "secret" varchar NOT NULL,
+  "event_types" varchar[] NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "webhook_deliveries" (
+  "id" bigserial PRIMARY KEY,
+  "endpoint_id" bigint NOT NULL,
+  "event_id" varchar NOT NULL,
+  "event_type" varchar NOT NULL,
+  "payload" jsonb NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "attempts" int NOT NULL DEFAULT 0,
+  "response_status" int,
+  "last_error" varchar,
+  "delivered_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
 
-CREATE UNIQUE INDEX ON "accounts" ("owner", "currency");
+CREATE TABLE "email_changes" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "old_email" varchar NOT NULL,
+  "old_email_verified" boolean NOT NULL,
+  "new_email" varchar NOT NULL,
+  "secret_code" varchar NOT NULL,
+  "cancel_code" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "expires_at" timestamptz NOT NULL DEFAULT (now() + interval '24 hours'),
+  "completed_at" timestamptz
+);
+
+CREATE TABLE "audit_events" (
+  "id" bigserial PRIMARY KEY,
+  "actor" varchar NOT NULL,
+  "action" varchar NOT NULL,
+  "target_type" varchar NOT NULL,
+  "target_id" varchar NOT NULL,
+  "before" jsonb,
+  "after" jsonb,
+  "client_ip" varchar NOT NULL DEFAULT '',
+  "user_agent" varchar NOT NULL DEFAULT '',
+  "request_id" varchar NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "ledger_chain" (
+  "seq" bigserial PRIMARY KEY,
+  "transfer_id" bigint UNIQUE NOT NULL,
+  "prev_hash" bytea NOT NULL,
+  "hash" bytea NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "version" smallint NOT NULL DEFAULT 1
+);
+
+CREATE TABLE "account_members" (
+  "account_id" bigint NOT NULL,
+  "username" varchar NOT NULL,
+  "role" varchar NOT NULL,
+  "spend_limit" bigint,
+  "status" varchar NOT NULL DEFAULT 'invited',
+  "invited_by" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "accepted_at" timestamptz,
+  PRIMARY KEY ("account_id", "username")
+);
+
+CREATE TABLE "interest_accruals" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "accrual_date" date NOT NULL,
+  "balance" bigint NOT NULL,
+  "annual_rate_bps" bigint NOT NULL,
+  "amount_micros" bigint NOT NULL,
+  "posting_id" bigint,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "interest_postings" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "transfer_id" bigint,
+  "amount" bigint NOT NULL,
+  "accrued_micros" bigint NOT NULL,
+  "carry_micros" bigint NOT NULL,
+  "period_start" date NOT NULL,
+  "period_end" date NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "accounts" ("owner");
 
 CREATE INDEX ON "entries" ("account_id");
 
@@ -68,12 +215,98 @@ CREATE INDEX ON "transfers" ("to_account_id");
 
 CREATE INDEX ON "transfers" ("from_account_id", "to_account_id");
 
+CREATE INDEX ON "transfers" ("from_account_id", "initiated_by", "created_at");
+
 CREATE INDEX ON "sessions" ("id");
 
+CREATE INDEX ON "api_keys" ("username");
+
+CREATE INDEX ON "outbox" ("published_at");
+
+CREATE INDEX ON "outbox" ("next_attempt_at") WHERE "published_at" IS NULL AND "dead_at" IS NULL;
+
+CREATE INDEX ON "notifications" ("username", "id");
+
+CREATE UNIQUE INDEX ON "notifications" ("username", "type", "transfer_id");
+
+CREATE INDEX ON "webhook_endpoints" ("username");
+
+CREATE UNIQUE INDEX ON "webhook_deliveries" ("endpoint_id", "event_id");
+
+CREATE INDEX ON "webhook_deliveries" ("endpoint_id", "status");
+
+CREATE INDEX ON "email_changes" ("username", "status");
+
+CREATE INDEX ON "audit_events" ("actor", "id");
+
+CREATE INDEX ON "audit_events" ("target_type", "target_id", "id");
+
+CREATE INDEX ON "audit_events" ("action", "id");
+
+CREATE INDEX ON "audit_events" ("created_at");
+
+CREATE INDEX ON "account_members" ("username", "status");
+
+CREATE UNIQUE INDEX ON "accounts" ("currency") WHERE "type" = 'interest_expense';
+
+CREATE UNIQUE INDEX ON "interest_accruals" ("account_id", "accrual_date");
+
+CREATE INDEX ON "interest_postings" ("account_id", "id");
+
+COMMENT ON COLUMN "accounts"."type" IS 'checking | savings | interest_expense (system accounts that pay interest)';
+
+COMMENT ON COLUMN "accounts"."nickname" IS 'name the owner or a co-owner gave the account, empty when unnamed';
+
+COMMENT ON COLUMN "accounts"."account_number" IS 'public IBAN-style number: GO, two mod-97 check digits and 12 random digits';
+
 COMMENT ON COLUMN "entries"."amount" IS 'can be +ve, or -ve';
 
 COMMENT ON COLUMN "transfers"."amount" IS 'Must be +ve';
 
+COMMENT ON COLUMN "transfers"."initiated_by" IS 'username that made the transfer; null for transfers the bank makes, such as interest';
+
+COMMENT ON COLUMN "outbox"."headers" IS 'task headers, e.g. W3C trace context';
+
+COMMENT ON COLUMN "outbox"."next_attempt_at" IS 'the relay skips the message until then; pushed back exponentially after each failure';
+
+COMMENT ON COLUMN "outbox"."dead_at" IS 'set once publishing failed max attempts times; dead messages are no longer retried';
+
+COMMENT ON COLUMN "webhook_deliveries"."status" IS 'pending | succeeded | dead';
+
+COMMENT ON COLUMN "email_changes"."status" IS 'pending | confirmed | cancelled';
+
+COMMENT ON COLUMN "audit_events"."actor" IS 'username that performed the action; not a foreign key so events outlive the user';
+
+COMMENT ON COLUMN "audit_events"."action" IS 'e.g. user.login, transfer.created, account.updated';
+
+COMMENT ON COLUMN "ledger_chain"."prev_hash" IS 'hash of the previous link; 32 zero bytes for the first';
+
+COMMENT ON COLUMN "ledger_chain"."hash" IS 'SHA-256 of prev_hash and the transfer with its entries';
+
+COMMENT ON COLUMN "ledger_chain"."version" IS 'encoding of the hash: 1 leaves out transfers.initiated_by, 2 covers it';
+
+COMMENT ON COLUMN "account_members"."role" IS 'owner | co_owner | viewer | spender';
+
+COMMENT ON COLUMN "account_members"."spend_limit" IS 'most a spender may transfer from the account in any 24 hours, in minor units of the account currency';
+
+COMMENT ON COLUMN "account_members"."status" IS 'invited | active';
+
+COMMENT ON COLUMN "interest_accruals"."balance" IS 'balance the day''s interest was computed on, in minor units';
+
+COMMENT ON COLUMN "interest_accruals"."annual_rate_bps" IS 'annual rate in basis points, 250 = 2.50%';
+
+COMMENT ON COLUMN "interest_accruals"."amount_micros" IS 'interest for the day in millionths of a minor unit';
+
+COMMENT ON COLUMN "interest_accruals"."posting_id" IS 'posting that paid this accrual; NULL until posted';
+
+COMMENT ON COLUMN "interest_postings"."transfer_id" IS 'transfer from the interest expense account; NULL when amount is 0';
+
+COMMENT ON COLUMN "interest_postings"."amount" IS 'interest credited, in minor units';
+
+COMMENT ON COLUMN "interest_postings"."accrued_micros" IS 'accruals posted plus the previous carry, in millionths of a minor unit';
+
+COMMENT ON COLUMN "interest_postings"."carry_micros" IS 'fraction of a minor unit left over, added to the next posting';
+
 ALTER TABLE "verify_emails" ADD FOREIGN KEY ("username") REFERENCES "users" ("username") DEFERRABLE INITIALLY IMMEDIATE;
 
 ALTER TABLE "accounts" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username") DEFERRABLE INITIALLY IMMEDIATE;
@@ -85,3 +318,33 @@ ALTER TABLE "transfers" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts
 ALTER TABLE "transfers" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id") DEFERRABLE INITIALLY IMMEDIATE;
 
 ALTER TABLE "sessions" ADD FOREIGN KEY ("username") REFERENCES "users" ("username") DEFERRABLE INITIALLY IMMEDIATE;
+
+ALTER TABLE "api_keys" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "notifications" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "notifications" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "notification_preferences" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "webhook_endpoints" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "webhook_deliveries" ADD FOREIGN KEY ("endpoint_id") REFERENCES "webhook_endpoints" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "email_changes" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "ledger_chain" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "account_members" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "account_members" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "account_members" ADD FOREIGN KEY ("invited_by") REFERENCES "users" ("username");
+
+ALTER TABLE "interest_accruals" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "interest_accruals" ADD FOREIGN KEY ("posting_id") REFERENCES "interest_postings" ("id");
+
+ALTER TABLE "interest_postings" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "interest_postings" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
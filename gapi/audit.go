@@ -0,0 +1,22 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
+	"github.com/a7medalyapany/GoBank.git/logger"
+)
+
+// newAuditEntry returns an audit entry for action by actor, carrying the
+// client IP, user agent and request ID of ctx. Callers fill in the target and
+// the before/after states.
+func (server *Server) newAuditEntry(ctx context.Context, actor string, action string) db.AuditEntry {
+	mt := server.extractMetadata(ctx)
+	return db.AuditEntry{
+		Actor:     actor,
+		Action:    action,
+		ClientIP:  mt.ClientIp,
+		UserAgent: mt.UserAgent,
+		RequestID: logger.RequestIDFromContext(ctx),
+	}
+}
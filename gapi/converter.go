@@ -14,5 +14,6 @@ func convertUser(user db.User) *pb.User {
 		IsEmailVerified: user.IsEmailVerified,
 		PasswordChangedAt: timestamppb.New(user.PasswordChangedAt.Time),
 		CreatedAt: timestamppb.New(user.CreatedAt.Time),
+		Locale: user.Locale,
 	}
 }
\ No newline at end of file
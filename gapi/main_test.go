@@ -37,7 +37,7 @@ func newTestServer(t *testing.T) *Server {
 	server, err := NewServer(testStore, util.Config{
 		TOKEN_SYMMETRIC_KEY:   util.RandomString(32),
 		ACCESS_TOKEN_DURATION: time.Minute,
-	}, nil)
+	}, nil, nil)
 	if err != nil {
 		t.Fatalf("cannot create test server: %v", err)
 	}
@@ -2,8 +2,14 @@ package gapi
 
 import (
 	"context"
+	"errors"
 	"strings"
+	"time"
 
+	"github.com/a7medalyapany/GoBank.git/logger"
+	"github.com/a7medalyapany/GoBank.git/token"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -16,6 +22,11 @@ type contextKey string
 
 const authPayloadKey contextKey = "authorization_payload"
 
+const (
+	authorizationTypeBearer = "bearer"
+	authorizationTypeAPIKey = "apikey"
+)
+
 // publicRoutes lists gRPC full method names that do NOT require authentication.
 // All other methods are protected by the auth interceptor.
 var publicRoutes = map[string]bool{
@@ -23,10 +34,65 @@ var publicRoutes = map[string]bool{
 	"/pb.GoBank/LoginUser":         true,
 	"/pb.GoBank/RenewAccessToken":  true,
 	"/pb.GoBank/VerifyEmail":      true,
+	"/pb.GoBank/ConfirmEmailChange": true,
+	"/pb.GoBank/CancelEmailChange":  true,
+	"/grpc.health.v1.Health/Check":  true,
+	"/grpc.health.v1.Health/List":   true,
+}
+
+// methodScopes maps each protected gRPC full method to the scope a token
+// must carry to call it. Protected methods missing from this map are denied,
+// so adding an RPC without choosing a scope fails closed.
+var methodScopes = map[string]string{
+	"/pb.GoBank/UpdateUser":                    token.ScopeUsersWrite,
+	"/pb.GoBank/ResendVerifyEmail":             token.ScopeUsersWrite,
+	"/pb.GoBank/CreateAccount":                 token.ScopeAccountsWrite,
+	"/pb.GoBank/GetAccount":                    token.ScopeAccountsRead,
+	"/pb.GoBank/ListAccounts":                  token.ScopeAccountsRead,
+	"/pb.GoBank/ListEntries":                   token.ScopeEntriesRead,
+	"/pb.GoBank/UpdateAccount":                 token.ScopeAccountsWrite,
+	"/pb.GoBank/UpdateAccountNickname":         token.ScopeAccountsWrite,
+	"/pb.GoBank/DeleteAccount":                 token.ScopeAccountsWrite,
+	"/pb.GoBank/LookUpAccount":                 token.ScopeAccountsRead,
+	"/pb.GoBank/InviteAccountMember":           token.ScopeAccountsWrite,
+	"/pb.GoBank/ListAccountMembers":            token.ScopeAccountsRead,
+	"/pb.GoBank/RemoveAccountMember":           token.ScopeAccountsWrite,
+	"/pb.GoBank/ListAccountInvitations":        token.ScopeAccountsRead,
+	"/pb.GoBank/AcceptAccountInvitation":       token.ScopeAccountsWrite,
+	"/pb.GoBank/GetAccountInterest":            token.ScopeAccountsRead,
+	"/pb.GoBank/CreateTransfer":                token.ScopeTransfersWrite,
+	"/pb.GoBank/CreateApiKey":                  token.ScopeAPIKeysManage,
+	"/pb.GoBank/ListApiKeys":                   token.ScopeAPIKeysManage,
+	"/pb.GoBank/RevokeApiKey":                  token.ScopeAPIKeysManage,
+	"/pb.GoBank/ListNotifications":             token.ScopeNotificationsRead,
+	"/pb.GoBank/MarkNotificationRead":          token.ScopeNotificationsWrite,
+	"/pb.GoBank/GetNotificationPreferences":    token.ScopeNotificationsRead,
+	"/pb.GoBank/UpdateNotificationPreferences": token.ScopeNotificationsWrite,
+	"/pb.GoBank/CreateWebhookEndpoint":         token.ScopeWebhooksManage,
+	"/pb.GoBank/ListWebhookEndpoints":          token.ScopeWebhooksManage,
+	"/pb.GoBank/DeleteWebhookEndpoint":         token.ScopeWebhooksManage,
+	"/pb.GoBank/ListWebhookDeliveries":         token.ScopeWebhooksManage,
+	"/pb.GoBank/ReplayWebhookDelivery":         token.ScopeWebhooksManage,
+	"/pb.GoBank/ListQueues":                    token.ScopeQueuesAdmin,
+	"/pb.GoBank/ListQueueTasks":                token.ScopeQueuesAdmin,
+	"/pb.GoBank/RetryQueueTask":                token.ScopeQueuesAdmin,
+	"/pb.GoBank/DeleteQueueTask":               token.ScopeQueuesAdmin,
+	"/pb.GoBank/GetLogLevel":                   token.ScopeLogsAdmin,
+	"/pb.GoBank/SetLogLevel":                   token.ScopeLogsAdmin,
+	"/pb.GoBank/CreateDebugLogToken":           token.ScopeLogsAdmin,
+	"/pb.GoBank/QueryAuditLog":                 token.ScopeAuditRead,
+	"/pb.v2.GoBank/CreateAccount":              token.ScopeAccountsWrite,
+	"/pb.v2.GoBank/GetAccount":                 token.ScopeAccountsRead,
+	"/pb.v2.GoBank/ListAccounts":               token.ScopeAccountsRead,
+	"/pb.v2.GoBank/ListEntries":                token.ScopeEntriesRead,
+	"/pb.v2.GoBank/UpdateAccount":              token.ScopeAccountsWrite,
+	"/pb.v2.GoBank/GetAccountInterest":         token.ScopeAccountsRead,
+	"/pb.v2.GoBank/CreateTransfer":             token.ScopeTransfersWrite,
 }
 
-// authInterceptor is a gRPC UnaryServerInterceptor that validates Bearer tokens.
-// It skips validation for routes listed in publicRoutes.
+// authInterceptor is a gRPC UnaryServerInterceptor that validates Bearer tokens and API keys.
+// It skips validation for routes listed in publicRoutes and enforces methodScopes
+// and the verified-email policy for everything else.
 // On success it injects the *token.Payload into the request context.
 func (server *Server) authInterceptor(
     ctx context.Context,
@@ -60,18 +126,81 @@ func (server *Server) authInterceptor(
 
 	authType := strings.ToLower(fields[0])
 
-	if authType != "bearer" {
+	var payload *token.Payload
+	var err error
+	switch authType {
+	case authorizationTypeBearer:
+		payload, err = server.tokenMaker.VerifyToken(fields[1], token.TokenTypeAccess)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid access token: %v", err)
+		}
+	case authorizationTypeAPIKey:
+		payload, err = server.verifyAPIKey(ctx, fields[1])
+		if err != nil {
+			return nil, err
+		}
+	default:
 		return nil, status.Errorf(codes.Unauthenticated, "unsupported authorization type: %s", fields[0])
 	}
 
-	accessToken := fields[1]
+	if payload.Audience != token.AudienceGoBank {
+		return nil, status.Errorf(codes.Unauthenticated, "access token is not intended for this service")
+	}
 
-	payload, err := server.tokenMaker.VerifyToken(accessToken)
-	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "invalid access token: %v", err)
+	scope, ok := methodScopes[info.FullMethod]
+	if !ok || !payload.HasScope(scope) {
+		return nil, status.Errorf(codes.PermissionDenied, "access token lacks the required scope: %s", scope)
+	}
+
+	if err := server.requireVerifiedEmail(ctx, info.FullMethod, payload); err != nil {
+		return nil, err
 	}
 
 	// Inject payload into context for downstream handlers
 	ctx = context.WithValue(ctx, authPayloadKey, payload)
 	return handler(ctx, req)
 }
+
+// verifyAPIKey authenticates an "ApiKey" authorization header and turns the
+// key into a Payload so handlers don't need to know how the caller logged in.
+func (server *Server) verifyAPIKey(ctx context.Context, key string) (*token.Payload, error) {
+	prefix, err := token.ParseAPIKeyPrefix(key)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid api key")
+	}
+
+	apiKey, err := server.store.GetApiKeyByPrefix(ctx, prefix)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid api key")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get api key: %v", err)
+	}
+
+	if err := token.CheckAPIKey(key, apiKey.HashedKey); err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid api key")
+	}
+	if apiKey.RevokedAt.Valid {
+		return nil, status.Errorf(codes.Unauthenticated, "api key has been revoked")
+	}
+	if apiKey.ExpiresAt.Valid && time.Now().After(apiKey.ExpiresAt.Time) {
+		return nil, status.Errorf(codes.Unauthenticated, "api key has expired")
+	}
+
+	// Only record usage once per minute to keep hot keys from turning every
+	// request into a write.
+	if !apiKey.LastUsedAt.Valid || time.Since(apiKey.LastUsedAt.Time) > time.Minute {
+		if err := server.store.UpdateApiKeyLastUsed(ctx, apiKey.ID); err != nil {
+			logger.FromContext(ctx).Warn("failed to update api key last used", zap.Int64("api_key_id", apiKey.ID), zap.Error(err))
+		}
+	}
+
+	return &token.Payload{
+		Type:      token.TokenTypeAccess,
+		Username:  apiKey.Username,
+		Audience:  token.AudienceGoBank,
+		Scopes:    apiKey.Scopes,
+		IssuedAt:  apiKey.CreatedAt.Time,
+		ExpiredAt: apiKey.ExpiresAt.Time,
+	}, nil
+}
@@ -0,0 +1,70 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/a7medalyapany/GoBank.git/token"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseVerifiedEmailMethods(t *testing.T) {
+	methods, err := parseVerifiedEmailMethods("CreateAccount, CreateTransfer")
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{
+		"/pb.GoBank/CreateAccount":  true,
+		"/pb.GoBank/CreateTransfer": true,
+	}, methods)
+
+	methods, err = parseVerifiedEmailMethods("none")
+	require.NoError(t, err)
+	require.Empty(t, methods)
+
+	_, err = parseVerifiedEmailMethods("LoginUser")
+	require.Error(t, err)
+
+	_, err = parseVerifiedEmailMethods("CreateTransfers")
+	require.Error(t, err)
+}
+
+func TestRequireVerifiedEmail(t *testing.T) {
+	server := newTestServer(t)
+	server.verifiedEmailMethods = map[string]bool{"/pb.GoBank/CreateTransfer": true}
+	user := createTestUser(t)
+	payload := &token.Payload{Username: user.Username}
+
+	t.Run("NotListed", func(t *testing.T) {
+		err := server.requireVerifiedEmail(context.Background(), "/pb.GoBank/ListAccounts", payload)
+		require.NoError(t, err)
+	})
+
+	t.Run("NotVerified", func(t *testing.T) {
+		err := server.requireVerifiedEmail(context.Background(), "/pb.GoBank/CreateTransfer", payload)
+
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		require.Equal(t, codes.FailedPrecondition, st.Code())
+		require.Len(t, st.Details(), 1)
+		failure, ok := st.Details()[0].(*errdetails.PreconditionFailure)
+		require.True(t, ok)
+		require.Len(t, failure.Violations, 1)
+		require.Equal(t, PreconditionEmailNotVerified, failure.Violations[0].Type)
+		require.Equal(t, "user.email", failure.Violations[0].Subject)
+	})
+
+	t.Run("V2FollowsV1", func(t *testing.T) {
+		err := server.requireVerifiedEmail(context.Background(), "/pb.v2.GoBank/CreateTransfer", payload)
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("Verified", func(t *testing.T) {
+		_, err := testDB.Exec(context.Background(), "UPDATE users SET is_email_verified = true WHERE username = $1", user.Username)
+		require.NoError(t, err)
+
+		err = server.requireVerifiedEmail(context.Background(), "/pb.GoBank/CreateTransfer", payload)
+		require.NoError(t, err)
+	})
+}
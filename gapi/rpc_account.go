@@ -3,14 +3,19 @@ package gapi
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strconv"
 
 	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
 	"github.com/a7medalyapany/GoBank.git/pb"
 	"github.com/a7medalyapany/GoBank.git/token"
 	"github.com/a7medalyapany/GoBank.git/util"
 	"github.com/a7medalyapany/GoBank.git/val"
+	"github.com/a7medalyapany/GoBank.git/webhook"
+	"github.com/a7medalyapany/GoBank.git/worker"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -19,15 +24,35 @@ import (
 
 func convertAccount(a db.Account) *pb.Account {
 	return &pb.Account{
-		Id:        a.ID,
-		Owner:     a.Owner,
-		Balance:   util.CentsToFloat(a.Balance),
-		Currency:  a.Currency,
-		CreatedAt: timestamppb.New(a.CreatedAt.Time),
+		Id:            a.ID,
+		Owner:         a.Owner,
+		Balance:       util.FromMinorUnits(a.Balance, a.Currency),
+		Currency:      a.Currency,
+		CreatedAt:     timestamppb.New(a.CreatedAt.Time),
+		Type:          a.Type,
+		Nickname:      a.Nickname,
+		AccountNumber: a.AccountNumber,
 	}
 }
 
-func (server *Server) authorizeAccount(ctx context.Context, accountID int64) (db.Account, error) {
+// newAccountEventMessage builds the outbox message for an account.* webhook
+// event sent to the account owner's endpoints.
+func newAccountEventMessage(eventType string, a db.Account) (db.CreateOutboxMessageParams, error) {
+	return worker.NewWebhookEventMessage(a.Owner, eventType, webhook.AccountData{
+		ID:            a.ID,
+		Owner:         a.Owner,
+		Balance:       a.Balance,
+		Currency:      a.Currency,
+		Type:          a.Type,
+		Nickname:      a.Nickname,
+		AccountNumber: a.AccountNumber,
+		CreatedAt:     a.CreatedAt.Time,
+	})
+}
+
+// authorizeAccount loads the account and checks that the caller is an active
+// member whose role allows permission.
+func (server *Server) authorizeAccount(ctx context.Context, accountID int64, permission accountPermission) (db.Account, error) {
 	account, err := server.store.GetAccount(ctx, accountID)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -41,8 +66,8 @@ func (server *Server) authorizeAccount(ctx context.Context, accountID int64) (db
 		return db.Account{}, status.Errorf(codes.Unauthenticated, "unauthenticated")
 	}
 
-	if account.Owner != authPayload.Username {
-		return db.Account{}, status.Errorf(codes.PermissionDenied, "account doesn't belong to the authenticated user")
+	if _, err := server.authorizeMember(ctx, account.ID, authPayload.Username, permission); err != nil {
+		return db.Account{}, err
 	}
 
 	return account, nil
@@ -59,39 +84,95 @@ func (server *Server) CreateAccount(ctx context.Context, req *pb.CreateAccountRe
 		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
 	}
 
-	account, err := server.store.CreateAccount(ctx, db.CreateAccountParams{
+	account, err := server.createAccount(ctx, db.CreateAccountParams{
 		Owner:    authPayload.Username,
 		Currency: req.GetCurrency(),
-		Balance:  0,
+		Type:     req.GetType(),
+		Nickname: req.GetNickname(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateAccountResponse{Account: convertAccount(account)}, nil
+}
+
+// createAccount opens the account described by arg, a checking account
+// unless arg.Type is set. It is shared by the v1 and v2 CreateAccount RPCs.
+func (server *Server) createAccount(ctx context.Context, arg db.CreateAccountParams) (db.Account, error) {
+	if arg.Type == "" {
+		arg.Type = db.AccountTypeChecking
+	}
+
+	var account db.Account
+	err := server.store.OutboxTx(ctx, func(q *db.Queries) ([]db.CreateOutboxMessageParams, error) {
+		var err error
+		account, err = q.CreateAccount(ctx, arg)
+		if err != nil {
+			return nil, err
+		}
+
+		audit := server.newAuditEntry(ctx, arg.Owner, db.AuditAccountCreated)
+		audit.TargetType = db.AuditTargetAccount
+		audit.TargetID = strconv.FormatInt(account.ID, 10)
+		audit.After = account
+		if _, err := q.RecordAuditEvent(ctx, audit); err != nil {
+			return nil, err
+		}
+
+		message, err := newAccountEventMessage(webhook.EventAccountCreated, account)
+		if err != nil {
+			return nil, err
+		}
+		return []db.CreateOutboxMessageParams{message}, nil
 	})
 	if err != nil {
 		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) {
-			switch pgErr.Code {
-			case "23503", "23505":
-				return nil, status.Errorf(codes.AlreadyExists, "account already exists for this currency")
-			}
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" { // foreign_key_violation
+			return db.Account{}, status.Errorf(codes.NotFound, "user %s not found", arg.Owner)
 		}
-		return nil, status.Errorf(codes.Internal, "failed to create account: %v", err)
+		return db.Account{}, status.Errorf(codes.Internal, "failed to create account: %v", err)
 	}
 
-	return &pb.CreateAccountResponse{Account: convertAccount(account)}, nil
+	return account, nil
 }
 
 func validateCreateAccountRequest(req *pb.CreateAccountRequest) (violations []*errdetails.BadRequest_FieldViolation) {
-	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+	return validateNewAccount(req.GetCurrency(), req.GetType(), req.GetNickname())
+}
+
+// validateNewAccount validates the fields shared by the v1 and v2
+// CreateAccount requests. An empty accountType means checking.
+func validateNewAccount(currency, accountType, nickname string) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateCurrency(currency); err != nil {
 		violations = append(violations, fieldViolation("currency", err))
 	}
+	if accountType != "" {
+		if err := validateAccountType(accountType); err != nil {
+			violations = append(violations, fieldViolation("type", err))
+		}
+	}
+	if err := val.ValidateAccountNickname(nickname); err != nil {
+		violations = append(violations, fieldViolation("nickname", err))
+	}
 	return
 }
 
+func validateAccountType(accountType string) error {
+	switch accountType {
+	case db.AccountTypeChecking, db.AccountTypeSavings:
+		return nil
+	}
+	return fmt.Errorf("must be %s or %s", db.AccountTypeChecking, db.AccountTypeSavings)
+}
+
 // GetAccount
 func (server *Server) GetAccount(ctx context.Context, req *pb.GetAccountRequest) (*pb.GetAccountResponse, error) {
 	if violations := validateGetAccountRequest(req); violations != nil {
 		return nil, invalidArgumentError(violations)
 	}
 
-	account, err := server.authorizeAccount(ctx, req.GetId())
+	account, err := server.authorizeAccount(ctx, req.GetId(), accountView)
 	if err != nil {
 		return nil, err
 	}
@@ -117,13 +198,15 @@ func (server *Server) ListAccounts(ctx context.Context, req *pb.ListAccountsRequ
 		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
 	}
 
-	accounts, err := server.store.ListAccounts(ctx, db.ListAccountsParams{
-		Owner:  authPayload.Username,
-		Limit:  req.GetPageSize(),
-		Offset: (req.GetPageId() - 1) * req.GetPageSize(),
+	accounts, err := server.listAccounts(ctx, db.ListMemberAccountsParams{
+		Username:  authPayload.Username,
+		Currency:  pgtype.Text{String: req.GetCurrency(), Valid: req.Currency != nil},
+		Type:      pgtype.Text{String: req.GetType(), Valid: req.Type != nil},
+		LimitArg:  req.GetPageSize(),
+		OffsetArg: (req.GetPageId() - 1) * req.GetPageSize(),
 	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list accounts: %v", err)
+		return nil, err
 	}
 
 	pbAccounts := make([]*pb.Account, len(accounts))
@@ -134,6 +217,17 @@ func (server *Server) ListAccounts(ctx context.Context, req *pb.ListAccountsRequ
 	return &pb.ListAccountsResponse{Accounts: pbAccounts}, nil
 }
 
+// listAccounts returns one page of the accounts arg.Username is an active
+// member of, including accounts shared with them. It is shared by the v1 and
+// v2 ListAccounts RPCs.
+func (server *Server) listAccounts(ctx context.Context, arg db.ListMemberAccountsParams) ([]db.Account, error) {
+	accounts, err := server.store.ListMemberAccounts(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list accounts: %v", err)
+	}
+	return accounts, nil
+}
+
 func validateListAccountsRequest(req *pb.ListAccountsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
 	if err := val.ValidatePageID(req.GetPageId()); err != nil {
 		violations = append(violations, fieldViolation("page_id", err))
@@ -141,6 +235,24 @@ func validateListAccountsRequest(req *pb.ListAccountsRequest) (violations []*err
 	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
 		violations = append(violations, fieldViolation("page_size", err))
 	}
+	violations = append(violations, validateAccountFilters(req.Currency, req.Type)...)
+	return
+}
+
+// validateAccountFilters validates the optional ListAccounts filters. A
+// currency that is no longer enabled is still accepted, since accounts opened
+// in it keep working.
+func validateAccountFilters(currency, accountType *string) (violations []*errdetails.BadRequest_FieldViolation) {
+	if currency != nil {
+		if _, ok := util.LookupCurrency(*currency); !ok {
+			violations = append(violations, fieldViolation("currency", fmt.Errorf("unknown currency %q", *currency)))
+		}
+	}
+	if accountType != nil {
+		if err := validateAccountType(*accountType); err != nil {
+			violations = append(violations, fieldViolation("type", err))
+		}
+	}
 	return
 }
 
@@ -150,22 +262,65 @@ func (server *Server) UpdateAccount(ctx context.Context, req *pb.UpdateAccountRe
 		return nil, invalidArgumentError(violations)
 	}
 
-	if _, err := server.authorizeAccount(ctx, req.GetId()); err != nil {
+	account, err := server.authorizeAccount(ctx, req.GetId(), accountSetBalance)
+	if err != nil {
 		return nil, err
 	}
 
-	updated, err := server.store.UpdateAccount(ctx, db.UpdateAccountParams{
-		ID:      req.GetId(),
-		Balance: util.FloatToCents(req.GetBalance()),
+	if err := val.ValidateMinorUnits(req.GetBalance(), account.Currency); err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("balance", err)})
+	}
+
+	updated, err := server.updateAccountBalance(ctx, account, util.ToMinorUnits(req.GetBalance(), account.Currency))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateAccountResponse{Account: convertAccount(updated)}, nil
+}
+
+// updateAccountBalance sets the balance of account, in minor units. It is
+// shared by the v1 and v2 UpdateAccount RPCs.
+func (server *Server) updateAccountBalance(ctx context.Context, account db.Account, balance int64) (db.Account, error) {
+	authPayload, ok := ctx.Value(authPayloadKey).(*token.Payload)
+	if !ok || authPayload == nil {
+		return db.Account{}, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+
+	var updated db.Account
+	err := server.store.OutboxTx(ctx, func(q *db.Queries) ([]db.CreateOutboxMessageParams, error) {
+		var err error
+		updated, err = q.UpdateAccount(ctx, db.UpdateAccountParams{
+			ID:      account.ID,
+			Balance: balance,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		audit := server.newAuditEntry(ctx, authPayload.Username, db.AuditAccountUpdated)
+		audit.TargetType = db.AuditTargetAccount
+		audit.TargetID = strconv.FormatInt(account.ID, 10)
+		audit.Before = account
+		audit.After = updated
+		if _, err := q.RecordAuditEvent(ctx, audit); err != nil {
+			return nil, err
+		}
+
+		message, err := newAccountEventMessage(webhook.EventAccountUpdated, updated)
+		if err != nil {
+			return nil, err
+		}
+		return []db.CreateOutboxMessageParams{message}, nil
 	})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, status.Errorf(codes.NotFound, "account not found")
+			return db.Account{}, status.Errorf(codes.NotFound, "account not found")
 		}
-		return nil, status.Errorf(codes.Internal, "failed to update account: %v", err)
+		return db.Account{}, status.Errorf(codes.Internal, "failed to update account: %v", err)
 	}
 
-	return &pb.UpdateAccountResponse{Account: convertAccount(updated)}, nil
+	return updated, nil
 }
 
 func validateUpdateAccountRequest(req *pb.UpdateAccountRequest) (violations []*errdetails.BadRequest_FieldViolation) {
@@ -178,17 +333,104 @@ func validateUpdateAccountRequest(req *pb.UpdateAccountRequest) (violations []*e
 	return
 }
 
+// UpdateAccountNickname
+func (server *Server) UpdateAccountNickname(ctx context.Context, req *pb.UpdateAccountNicknameRequest) (*pb.UpdateAccountNicknameResponse, error) {
+	if violations := validateUpdateAccountNicknameRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	authPayload, ok := ctx.Value(authPayloadKey).(*token.Payload)
+	if !ok || authPayload == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+
+	account, err := server.authorizeAccount(ctx, req.GetId(), accountManage)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated db.Account
+	err = server.store.OutboxTx(ctx, func(q *db.Queries) ([]db.CreateOutboxMessageParams, error) {
+		var err error
+		updated, err = q.UpdateAccountNickname(ctx, db.UpdateAccountNicknameParams{
+			ID:       account.ID,
+			Nickname: req.GetNickname(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		audit := server.newAuditEntry(ctx, authPayload.Username, db.AuditAccountUpdated)
+		audit.TargetType = db.AuditTargetAccount
+		audit.TargetID = strconv.FormatInt(account.ID, 10)
+		audit.Before = account
+		audit.After = updated
+		if _, err := q.RecordAuditEvent(ctx, audit); err != nil {
+			return nil, err
+		}
+
+		message, err := newAccountEventMessage(webhook.EventAccountUpdated, updated)
+		if err != nil {
+			return nil, err
+		}
+		return []db.CreateOutboxMessageParams{message}, nil
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "account not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to rename account: %v", err)
+	}
+
+	return &pb.UpdateAccountNicknameResponse{Account: convertAccount(updated)}, nil
+}
+
+func validateUpdateAccountNicknameRequest(req *pb.UpdateAccountNicknameRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+	if err := val.ValidateAccountNickname(req.GetNickname()); err != nil {
+		violations = append(violations, fieldViolation("nickname", err))
+	}
+	return
+}
+
 // DeleteAccount
 func (server *Server) DeleteAccount(ctx context.Context, req *pb.DeleteAccountRequest) (*pb.DeleteAccountResponse, error) {
 	if violations := validateDeleteAccountRequest(req); violations != nil {
 		return nil, invalidArgumentError(violations)
 	}
 
-	if _, err := server.authorizeAccount(ctx, req.GetId()); err != nil {
+	authPayload, ok := ctx.Value(authPayloadKey).(*token.Payload)
+	if !ok || authPayload == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+
+	account, err := server.authorizeAccount(ctx, req.GetId(), accountDelete)
+	if err != nil {
 		return nil, err
 	}
 
-	if err := server.store.DeleteAccount(ctx, req.GetId()); err != nil {
+	err = server.store.OutboxTx(ctx, func(q *db.Queries) ([]db.CreateOutboxMessageParams, error) {
+		if err := q.DeleteAccount(ctx, account.ID); err != nil {
+			return nil, err
+		}
+
+		audit := server.newAuditEntry(ctx, authPayload.Username, db.AuditAccountDeleted)
+		audit.TargetType = db.AuditTargetAccount
+		audit.TargetID = strconv.FormatInt(account.ID, 10)
+		audit.Before = account
+		if _, err := q.RecordAuditEvent(ctx, audit); err != nil {
+			return nil, err
+		}
+
+		message, err := newAccountEventMessage(webhook.EventAccountDeleted, account)
+		if err != nil {
+			return nil, err
+		}
+		return []db.CreateOutboxMessageParams{message}, nil
+	})
+	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, status.Errorf(codes.NotFound, "account not found")
 		}
@@ -217,7 +459,7 @@ func (server *Server) LookUpAccount(ctx context.Context, req *pb.LookUpAccountRe
 		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
 	}
 
-	account, err := server.store.GetAccount(ctx, req.GetId())
+	account, err := server.store.GetAccountByNumber(ctx, util.NormalizeAccountNumber(req.GetAccountNumber()))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, status.Errorf(codes.NotFound, "account not found")
@@ -226,17 +468,38 @@ func (server *Server) LookUpAccount(ctx context.Context, req *pb.LookUpAccountRe
 	}
 
 	accountPb := &pb.AccountLookUp{
-		Id:       account.ID,
-		Owner:    account.Owner,
-		Currency: account.Currency,
+		Owner:         account.Owner,
+		Currency:      account.Currency,
+		Type:          account.Type,
+		Nickname:      account.Nickname,
+		AccountNumber: account.AccountNumber,
 	}
 
 	return &pb.LookUpAccountResponse{Account: accountPb}, nil
 }
 
 func validateLookUpAccountRequest(req *pb.LookUpAccountRequest) (violations []*errdetails.BadRequest_FieldViolation) {
-	if err := val.ValidateID(req.GetId()); err != nil {
-		violations = append(violations, fieldViolation("id", err))
+	if err := val.ValidateAccountNumber(util.NormalizeAccountNumber(req.GetAccountNumber())); err != nil {
+		violations = append(violations, fieldViolation("account_number", err))
 	}
 	return
 }
+
+// validateAccountIDOrNumber checks that a request names an account by exactly
+// one of its ID and its account number, and that the one given is valid.
+func validateAccountIDOrNumber(idField string, id int64, numberField string, number string) *errdetails.BadRequest_FieldViolation {
+	number = util.NormalizeAccountNumber(number)
+	switch {
+	case number != "" && id != 0:
+		return fieldViolation(numberField, fmt.Errorf("set either %s or %s, not both", idField, numberField))
+	case number != "":
+		if err := val.ValidateAccountNumber(number); err != nil {
+			return fieldViolation(numberField, err)
+		}
+	default:
+		if err := val.ValidateID(id); err != nil {
+			return fieldViolation(idField, err)
+		}
+	}
+	return nil
+}
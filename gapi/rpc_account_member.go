@@ -0,0 +1,337 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
+	"github.com/a7medalyapany/GoBank.git/pb"
+	"github.com/a7medalyapany/GoBank.git/token"
+	"github.com/a7medalyapany/GoBank.git/util"
+	"github.com/a7medalyapany/GoBank.git/val"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// accountPermission is an action on an account that depends on the caller's
+// role in account_members.
+type accountPermission string
+
+const (
+	accountView   accountPermission = "view"
+	accountSpend  accountPermission = "spend from"
+	accountManage accountPermission = "manage"
+	// accountSetBalance overwrites the balance, creating or destroying money,
+	// so it is kept apart from accountManage.
+	accountSetBalance accountPermission = "set the balance of"
+	accountDelete     accountPermission = "delete"
+)
+
+// accountRolePermissions lists what each member role may do. Co-owners can
+// rename the account and manage its members but not set its balance.
+// Spenders are also held to their spend limit by createTransfer.
+var accountRolePermissions = map[string][]accountPermission{
+	db.AccountRoleOwner:   {accountView, accountSpend, accountManage, accountSetBalance, accountDelete},
+	db.AccountRoleCoOwner: {accountView, accountSpend, accountManage},
+	db.AccountRoleSpender: {accountView, accountSpend},
+	db.AccountRoleViewer:  {accountView},
+}
+
+// authorizeMember checks that username is an active member of accountID
+// whose role allows permission, and returns the membership.
+func (server *Server) authorizeMember(ctx context.Context, accountID int64, username string, permission accountPermission) (db.AccountMember, error) {
+	member, err := server.store.GetAccountMember(ctx, db.GetAccountMemberParams{
+		AccountID: accountID,
+		Username:  username,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return db.AccountMember{}, status.Errorf(codes.PermissionDenied, "account doesn't belong to the authenticated user")
+		}
+		return db.AccountMember{}, status.Errorf(codes.Internal, "failed to get account member: %v", err)
+	}
+
+	if member.Status != db.AccountMemberActive {
+		return db.AccountMember{}, status.Errorf(codes.PermissionDenied, "accept the invitation to account %d first", accountID)
+	}
+	if !slices.Contains(accountRolePermissions[member.Role], permission) {
+		return db.AccountMember{}, status.Errorf(codes.PermissionDenied, "a %s of account %d cannot %s it", member.Role, accountID, permission)
+	}
+
+	return member, nil
+}
+
+// convertAccountMember converts m, a member of an account holding currency.
+func convertAccountMember(m db.AccountMember, currency string) *pb.AccountMember {
+	member := &pb.AccountMember{
+		AccountId:  m.AccountID,
+		Username:   m.Username,
+		Role:       m.Role,
+		SpendLimit: util.FromMinorUnits(m.SpendLimit.Int64, currency),
+		Status:     m.Status,
+		InvitedBy:  m.InvitedBy,
+		CreatedAt:  timestamppb.New(m.CreatedAt.Time),
+	}
+	if m.AcceptedAt.Valid {
+		member.AcceptedAt = timestamppb.New(m.AcceptedAt.Time)
+	}
+	return member
+}
+
+func convertAccountMembers(members []db.AccountMember, currency string) []*pb.AccountMember {
+	pbMembers := make([]*pb.AccountMember, len(members))
+	for i, m := range members {
+		pbMembers[i] = convertAccountMember(m, currency)
+	}
+	return pbMembers
+}
+
+// newAccountMemberAudit returns the audit entry for action on the membership
+// of username in accountID.
+func (server *Server) newAccountMemberAudit(ctx context.Context, actor string, action string, accountID int64) db.AuditEntry {
+	audit := server.newAuditEntry(ctx, actor, action)
+	audit.TargetType = db.AuditTargetAccount
+	audit.TargetID = strconv.FormatInt(accountID, 10)
+	return audit
+}
+
+// InviteAccountMember
+func (server *Server) InviteAccountMember(ctx context.Context, req *pb.InviteAccountMemberRequest) (*pb.InviteAccountMemberResponse, error) {
+	if violations := validateInviteAccountMemberRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	authPayload, ok := ctx.Value(authPayloadKey).(*token.Payload)
+	if !ok || authPayload == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+
+	account, err := server.authorizeAccount(ctx, req.GetAccountId(), accountManage)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := val.ValidateMinorUnits(req.GetSpendLimit(), account.Currency); err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("spend_limit", err)})
+	}
+
+	var member db.AccountMember
+	err = server.store.OutboxTx(ctx, func(q *db.Queries) ([]db.CreateOutboxMessageParams, error) {
+		var err error
+		member, err = q.CreateAccountMember(ctx, db.CreateAccountMemberParams{
+			AccountID:  account.ID,
+			Username:   req.GetUsername(),
+			Role:       req.GetRole(),
+			SpendLimit: pgtype.Int8{Int64: util.ToMinorUnits(req.GetSpendLimit(), account.Currency), Valid: req.GetRole() == db.AccountRoleSpender},
+			InvitedBy:  authPayload.Username,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		audit := server.newAccountMemberAudit(ctx, authPayload.Username, db.AuditAccountMemberInvited, account.ID)
+		audit.After = member
+		_, err = q.RecordAuditEvent(ctx, audit)
+		return nil, err
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case "23503":
+				return nil, status.Errorf(codes.NotFound, "user %s not found", req.GetUsername())
+			case "23505":
+				return nil, status.Errorf(codes.AlreadyExists, "%s is already a member of account %d or invited to it", req.GetUsername(), account.ID)
+			}
+		}
+		return nil, status.Errorf(codes.Internal, "failed to invite account member: %v", err)
+	}
+
+	return &pb.InviteAccountMemberResponse{Member: convertAccountMember(member, account.Currency)}, nil
+}
+
+func validateInviteAccountMemberRequest(req *pb.InviteAccountMemberRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, fieldViolation("username", err))
+	}
+
+	switch req.GetRole() {
+	case db.AccountRoleSpender:
+		if req.GetSpendLimit() <= 0 {
+			violations = append(violations, fieldViolation("spend_limit", errors.New("must be greater than zero for a spender")))
+		}
+	case db.AccountRoleCoOwner, db.AccountRoleViewer:
+		if req.GetSpendLimit() != 0 {
+			violations = append(violations, fieldViolation("spend_limit", errors.New("can only be set for a spender")))
+		}
+	default:
+		violations = append(violations, fieldViolation("role", fmt.Errorf("must be one of %s, %s, %s", db.AccountRoleCoOwner, db.AccountRoleViewer, db.AccountRoleSpender)))
+	}
+	return
+}
+
+// ListAccountMembers
+func (server *Server) ListAccountMembers(ctx context.Context, req *pb.ListAccountMembersRequest) (*pb.ListAccountMembersResponse, error) {
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("account_id", err)})
+	}
+
+	account, err := server.authorizeAccount(ctx, req.GetAccountId(), accountView)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := server.store.ListAccountMembers(ctx, account.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list account members: %v", err)
+	}
+
+	return &pb.ListAccountMembersResponse{Members: convertAccountMembers(members, account.Currency)}, nil
+}
+
+// RemoveAccountMember
+func (server *Server) RemoveAccountMember(ctx context.Context, req *pb.RemoveAccountMemberRequest) (*pb.RemoveAccountMemberResponse, error) {
+	if violations := validateRemoveAccountMemberRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	authPayload, ok := ctx.Value(authPayloadKey).(*token.Payload)
+	if !ok || authPayload == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+
+	// Anyone may leave an account or decline an invitation; removing someone
+	// else takes the manage permission.
+	if req.GetUsername() != authPayload.Username {
+		if _, err := server.authorizeAccount(ctx, req.GetAccountId(), accountManage); err != nil {
+			return nil, err
+		}
+	}
+
+	err := server.store.OutboxTx(ctx, func(q *db.Queries) ([]db.CreateOutboxMessageParams, error) {
+		member, err := q.GetAccountMember(ctx, db.GetAccountMemberParams{
+			AccountID: req.GetAccountId(),
+			Username:  req.GetUsername(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if member.Role == db.AccountRoleOwner {
+			return nil, status.Errorf(codes.FailedPrecondition, "the owner cannot be removed from account %d; delete the account instead", member.AccountID)
+		}
+
+		if _, err := q.DeleteAccountMember(ctx, db.DeleteAccountMemberParams{
+			AccountID: member.AccountID,
+			Username:  member.Username,
+		}); err != nil {
+			return nil, err
+		}
+
+		audit := server.newAccountMemberAudit(ctx, authPayload.Username, db.AuditAccountMemberRemoved, member.AccountID)
+		audit.Before = member
+		_, err = q.RecordAuditEvent(ctx, audit)
+		return nil, err
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "%s is not a member of account %d", req.GetUsername(), req.GetAccountId())
+		}
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "failed to remove account member: %v", err)
+	}
+
+	return &pb.RemoveAccountMemberResponse{Status: "removed"}, nil
+}
+
+func validateRemoveAccountMemberRequest(req *pb.RemoveAccountMemberRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, fieldViolation("username", err))
+	}
+	return
+}
+
+// ListAccountInvitations
+func (server *Server) ListAccountInvitations(ctx context.Context, req *pb.ListAccountInvitationsRequest) (*pb.ListAccountInvitationsResponse, error) {
+	authPayload, ok := ctx.Value(authPayloadKey).(*token.Payload)
+	if !ok || authPayload == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+
+	invitations, err := server.store.ListAccountInvitations(ctx, authPayload.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list account invitations: %v", err)
+	}
+
+	// Invitations are to different accounts, each with its own currency.
+	pbInvitations := make([]*pb.AccountMember, len(invitations))
+	for i, invitation := range invitations {
+		account, err := server.store.GetAccount(ctx, invitation.AccountID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get account %d: %v", invitation.AccountID, err)
+		}
+		pbInvitations[i] = convertAccountMember(invitation, account.Currency)
+	}
+
+	return &pb.ListAccountInvitationsResponse{Invitations: pbInvitations}, nil
+}
+
+// AcceptAccountInvitation
+func (server *Server) AcceptAccountInvitation(ctx context.Context, req *pb.AcceptAccountInvitationRequest) (*pb.AcceptAccountInvitationResponse, error) {
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("account_id", err)})
+	}
+
+	authPayload, ok := ctx.Value(authPayloadKey).(*token.Payload)
+	if !ok || authPayload == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+
+	var (
+		member  db.AccountMember
+		account db.Account
+	)
+	err := server.store.OutboxTx(ctx, func(q *db.Queries) ([]db.CreateOutboxMessageParams, error) {
+		var err error
+		member, err = q.AcceptAccountInvitation(ctx, db.AcceptAccountInvitationParams{
+			AccountID: req.GetAccountId(),
+			Username:  authPayload.Username,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		account, err = q.GetAccount(ctx, member.AccountID)
+		if err != nil {
+			return nil, err
+		}
+
+		audit := server.newAccountMemberAudit(ctx, authPayload.Username, db.AuditAccountMemberJoined, member.AccountID)
+		audit.After = member
+		_, err = q.RecordAuditEvent(ctx, audit)
+		return nil, err
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "no pending invitation to account %d", req.GetAccountId())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to accept account invitation: %v", err)
+	}
+
+	return &pb.AcceptAccountInvitationResponse{Member: convertAccountMember(member, account.Currency)}, nil
+}
@@ -0,0 +1,314 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+
+	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
+	"github.com/a7medalyapany/GoBank.git/pb"
+	pbv2 "github.com/a7medalyapany/GoBank.git/pb/v2"
+	"github.com/a7medalyapany/GoBank.git/webhook"
+	"github.com/a7medalyapany/GoBank.git/worker"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// createTestAccountMember invites username to account and accepts on their
+// behalf.
+func createTestAccountMember(t *testing.T, server *Server, account db.Account, username string, role string, spendLimit float64) {
+	t.Helper()
+
+	_, err := server.InviteAccountMember(authContext(t, account.Owner), &pb.InviteAccountMemberRequest{
+		AccountId:  account.ID,
+		Username:   username,
+		Role:       role,
+		SpendLimit: spendLimit,
+	})
+	require.NoError(t, err)
+
+	_, err = server.AcceptAccountInvitation(authContext(t, username), &pb.AcceptAccountInvitationRequest{AccountId: account.ID})
+	require.NoError(t, err)
+}
+
+func TestInviteAccountMember(t *testing.T) {
+	server := newTestServer(t)
+
+	owner := createTestUser(t)
+	invitee := createTestUser(t)
+	account := createTestAccount(t, owner.Username, "USD", 10_000)
+
+	t.Run("InvalidRole", func(t *testing.T) {
+		for _, req := range []*pb.InviteAccountMemberRequest{
+			{AccountId: account.ID, Username: invitee.Username, Role: db.AccountRoleOwner},
+			{AccountId: account.ID, Username: invitee.Username, Role: db.AccountRoleSpender},
+			{AccountId: account.ID, Username: invitee.Username, Role: db.AccountRoleViewer, SpendLimit: 100},
+			{AccountId: account.ID, Username: invitee.Username, Role: db.AccountRoleSpender, SpendLimit: 12.505},
+		} {
+			_, err := server.InviteAccountMember(authContext(t, owner.Username), req)
+			require.Equal(t, codes.InvalidArgument, status.Code(err), req.String())
+		}
+	})
+
+	t.Run("SpendLimitInMajorUnits", func(t *testing.T) {
+		spender := createTestUser(t)
+		resp, err := server.InviteAccountMember(authContext(t, owner.Username), &pb.InviteAccountMemberRequest{
+			AccountId:  account.ID,
+			Username:   spender.Username,
+			Role:       db.AccountRoleSpender,
+			SpendLimit: 12.5,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 12.5, resp.Member.SpendLimit)
+
+		member, err := testStore.GetAccountMember(context.Background(), db.GetAccountMemberParams{AccountID: account.ID, Username: spender.Username})
+		require.NoError(t, err)
+		require.Equal(t, int64(1250), member.SpendLimit.Int64)
+
+		invitations, err := server.ListAccountInvitations(authContext(t, spender.Username), &pb.ListAccountInvitationsRequest{})
+		require.NoError(t, err)
+		require.Len(t, invitations.Invitations, 1)
+		require.Equal(t, 12.5, invitations.Invitations[0].SpendLimit)
+	})
+
+	t.Run("NotAManager", func(t *testing.T) {
+		_, err := server.InviteAccountMember(authContext(t, invitee.Username), &pb.InviteAccountMemberRequest{
+			AccountId: account.ID,
+			Username:  invitee.Username,
+			Role:      db.AccountRoleCoOwner,
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("InviteAndAccept", func(t *testing.T) {
+		resp, err := server.InviteAccountMember(authContext(t, owner.Username), &pb.InviteAccountMemberRequest{
+			AccountId: account.ID,
+			Username:  invitee.Username,
+			Role:      db.AccountRoleViewer,
+		})
+		require.NoError(t, err)
+		require.Equal(t, db.AccountMemberInvited, resp.Member.Status)
+		require.Nil(t, resp.Member.AcceptedAt)
+
+		_, err = server.InviteAccountMember(authContext(t, owner.Username), &pb.InviteAccountMemberRequest{
+			AccountId: account.ID,
+			Username:  invitee.Username,
+			Role:      db.AccountRoleViewer,
+		})
+		require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+		// No access until the invitation is accepted.
+		_, err = server.GetAccount(authContext(t, invitee.Username), &pb.GetAccountRequest{Id: account.ID})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		invitations, err := server.ListAccountInvitations(authContext(t, invitee.Username), &pb.ListAccountInvitationsRequest{})
+		require.NoError(t, err)
+		require.Len(t, invitations.Invitations, 1)
+
+		accepted, err := server.AcceptAccountInvitation(authContext(t, invitee.Username), &pb.AcceptAccountInvitationRequest{AccountId: account.ID})
+		require.NoError(t, err)
+		require.Equal(t, db.AccountMemberActive, accepted.Member.Status)
+
+		got, err := server.GetAccount(authContext(t, invitee.Username), &pb.GetAccountRequest{Id: account.ID})
+		require.NoError(t, err)
+		require.Equal(t, owner.Username, got.Account.Owner)
+
+		// Viewers cannot change the balance.
+		_, err = server.UpdateAccount(authContext(t, invitee.Username), &pb.UpdateAccountRequest{Id: account.ID, Balance: 1})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestCoOwnerCannotSetBalance(t *testing.T) {
+	server := newTestServer(t)
+
+	owner := createTestUser(t)
+	coOwner := createTestUser(t)
+	account := createTestAccount(t, owner.Username, "USD", 10_000)
+	createTestAccountMember(t, server, account, coOwner.Username, db.AccountRoleCoOwner, 0)
+	ctx := authContext(t, coOwner.Username)
+
+	_, err := server.UpdateAccount(ctx, &pb.UpdateAccountRequest{Id: account.ID, Balance: 1_000_000})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = NewServerV2(server).UpdateAccount(ctx, &pbv2.UpdateAccountRequest{
+		Id:      account.ID,
+		Balance: &pbv2.Money{Currency: "USD", Units: 100_000_000},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	got, err := testStore.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(10_000), got.Balance)
+
+	// Co-owners still rename the account and manage its members.
+	_, err = server.UpdateAccountNickname(ctx, &pb.UpdateAccountNicknameRequest{Id: account.ID, Nickname: "Bills"})
+	require.NoError(t, err)
+	_, err = server.InviteAccountMember(ctx, &pb.InviteAccountMemberRequest{
+		AccountId: account.ID,
+		Username:  createTestUser(t).Username,
+		Role:      db.AccountRoleViewer,
+	})
+	require.NoError(t, err)
+
+	_, err = server.UpdateAccount(authContext(t, owner.Username), &pb.UpdateAccountRequest{Id: account.ID, Balance: 50})
+	require.NoError(t, err)
+}
+
+func TestSpenderTransferLimit(t *testing.T) {
+	server := newTestServer(t)
+
+	owner := createTestUser(t)
+	spender := createTestUser(t)
+	recipient := createTestUser(t)
+	account := createTestAccount(t, owner.Username, "USD", 10_000)
+	to := createTestAccount(t, recipient.Username, "USD", 0)
+	createTestAccountMember(t, server, account, spender.Username, db.AccountRoleSpender, 5)
+
+	ctx := authContext(t, spender.Username)
+	_, err := server.CreateTransfer(ctx, &pb.CreateTransferRequest{
+		FromAccountId: account.ID,
+		ToAccountId:   to.ID,
+		Amount:        5.01,
+		Currency:      "USD",
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	resp, err := server.CreateTransfer(ctx, &pb.CreateTransferRequest{
+		FromAccountId: account.ID,
+		ToAccountId:   to.ID,
+		Amount:        5,
+		Currency:      "USD",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 95.0, resp.FromAccount.Balance)
+
+	// The limit covers everything sent in 24 hours, not each transfer.
+	_, err = server.CreateTransfer(ctx, &pb.CreateTransferRequest{
+		FromAccountId: account.ID,
+		ToAccountId:   to.ID,
+		Amount:        0.01,
+		Currency:      "USD",
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// The owner is not held to it.
+	_, err = server.CreateTransfer(authContext(t, owner.Username), &pb.CreateTransferRequest{
+		FromAccountId: account.ID,
+		ToAccountId:   to.ID,
+		Amount:        10,
+		Currency:      "USD",
+	})
+	require.NoError(t, err)
+
+	_, err = testDB.Exec(context.Background(),
+		"UPDATE transfers SET created_at = now() - interval '25 hours' WHERE id = $1", resp.Transfer.Id)
+	require.NoError(t, err)
+	_, err = server.CreateTransfer(ctx, &pb.CreateTransferRequest{
+		FromAccountId: account.ID,
+		ToAccountId:   to.ID,
+		Amount:        5,
+		Currency:      "USD",
+	})
+	require.NoError(t, err)
+
+	// Shared accounts are listed for their members.
+	accounts, err := server.ListAccounts(ctx, &pb.ListAccountsRequest{PageId: 1, PageSize: 5})
+	require.NoError(t, err)
+	require.Len(t, accounts.Accounts, 1)
+	require.Equal(t, account.ID, accounts.Accounts[0].Id)
+
+	// Only the owner can delete the account.
+	_, err = server.DeleteAccount(ctx, &pb.DeleteAccountRequest{Id: account.ID})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestRemoveAccountMember(t *testing.T) {
+	server := newTestServer(t)
+
+	owner := createTestUser(t)
+	coOwner := createTestUser(t)
+	viewer := createTestUser(t)
+	account := createTestAccount(t, owner.Username, "EUR", 0)
+	createTestAccountMember(t, server, account, coOwner.Username, db.AccountRoleCoOwner, 0)
+	createTestAccountMember(t, server, account, viewer.Username, db.AccountRoleViewer, 0)
+
+	// A viewer cannot remove someone else, but a co-owner can.
+	_, err := server.RemoveAccountMember(authContext(t, viewer.Username), &pb.RemoveAccountMemberRequest{AccountId: account.ID, Username: coOwner.Username})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = server.RemoveAccountMember(authContext(t, coOwner.Username), &pb.RemoveAccountMemberRequest{AccountId: account.ID, Username: owner.Username})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = server.RemoveAccountMember(authContext(t, coOwner.Username), &pb.RemoveAccountMemberRequest{AccountId: account.ID, Username: viewer.Username})
+	require.NoError(t, err)
+
+	// Members can leave on their own.
+	_, err = server.RemoveAccountMember(authContext(t, coOwner.Username), &pb.RemoveAccountMemberRequest{AccountId: account.ID, Username: coOwner.Username})
+	require.NoError(t, err)
+
+	members, err := server.ListAccountMembers(authContext(t, owner.Username), &pb.ListAccountMembersRequest{AccountId: account.ID})
+	require.NoError(t, err)
+	require.Len(t, members.Members, 1)
+	require.Equal(t, db.AccountRoleOwner, members.Members[0].Role)
+
+	_, err = server.RemoveAccountMember(authContext(t, owner.Username), &pb.RemoveAccountMemberRequest{AccountId: account.ID, Username: viewer.Username})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestTransferNotifiesMembers(t *testing.T) {
+	server := newTestServer(t)
+
+	owner := createTestUser(t)
+	coOwner := createTestUser(t)
+	viewer := createTestUser(t)
+	invited := createTestUser(t)
+	recipient := createTestUser(t)
+	account := createTestAccount(t, owner.Username, "USD", 10_000)
+	to := createTestAccount(t, recipient.Username, "USD", 0)
+	createTestAccountMember(t, server, account, coOwner.Username, db.AccountRoleCoOwner, 0)
+	createTestAccountMember(t, server, account, viewer.Username, db.AccountRoleViewer, 0)
+
+	// Invitees hear nothing until they accept.
+	_, err := server.InviteAccountMember(authContext(t, owner.Username), &pb.InviteAccountMemberRequest{
+		AccountId: account.ID,
+		Username:  invited.Username,
+		Role:      db.AccountRoleViewer,
+	})
+	require.NoError(t, err)
+
+	resp, err := server.CreateTransfer(authContext(t, coOwner.Username), &pb.CreateTransferRequest{
+		FromAccountId: account.ID,
+		ToAccountId:   to.ID,
+		Amount:        1,
+		Currency:      "USD",
+	})
+	require.NoError(t, err)
+
+	recipients := func(taskType, direction string) []string {
+		rows, err := testDB.Query(context.Background(), `
+			SELECT coalesce(payload->>'username', '') FROM outbox
+			WHERE task_type = $1
+			  AND coalesce(payload->>'transfer_id', payload->'event'->'data'->>'id')::bigint = $2
+			  AND coalesce(payload->>'direction', payload->'event'->>'type') = $3
+			ORDER BY id`,
+			taskType, resp.Transfer.Id, direction,
+		)
+		require.NoError(t, err)
+		defer rows.Close()
+
+		var usernames []string
+		for rows.Next() {
+			var username string
+			require.NoError(t, rows.Scan(&username))
+			usernames = append(usernames, username)
+		}
+		require.NoError(t, rows.Err())
+		return usernames
+	}
+
+	members := []string{owner.Username, coOwner.Username, viewer.Username}
+	require.ElementsMatch(t, members, recipients(worker.TaskSendTransferNotification, worker.TransferDirectionSent))
+	require.ElementsMatch(t, []string{recipient.Username}, recipients(worker.TaskSendTransferNotification, worker.TransferDirectionReceived))
+	require.ElementsMatch(t, members, recipients(worker.TaskDispatchWebhookEvent, webhook.EventTransferSent))
+	require.ElementsMatch(t, []string{recipient.Username}, recipients(worker.TaskDispatchWebhookEvent, webhook.EventTransferReceived))
+}
@@ -0,0 +1,155 @@
+package gapi
+
+import (
+	"strings"
+	"testing"
+
+	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
+	"github.com/a7medalyapany/GoBank.git/pb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCreateAccountTypes(t *testing.T) {
+	server := newTestServer(t)
+
+	user := createTestUser(t)
+	ctx := authContext(t, user.Username)
+
+	checking, err := server.CreateAccount(ctx, &pb.CreateAccountRequest{Currency: "USD"})
+	require.NoError(t, err)
+	require.Equal(t, db.AccountTypeChecking, checking.Account.Type)
+	require.Empty(t, checking.Account.Nickname)
+
+	// A second account in the same currency is allowed.
+	savings, err := server.CreateAccount(ctx, &pb.CreateAccountRequest{
+		Currency: "USD",
+		Type:     db.AccountTypeSavings,
+		Nickname: "Holiday fund",
+	})
+	require.NoError(t, err)
+	require.Equal(t, db.AccountTypeSavings, savings.Account.Type)
+	require.Equal(t, "Holiday fund", savings.Account.Nickname)
+
+	for _, req := range []*pb.CreateAccountRequest{
+		{Currency: "USD", Type: "brokerage"},
+		{Currency: "USD", Nickname: strings.Repeat("x", 41)},
+		{Currency: "USD", Nickname: " padded "},
+	} {
+		_, err := server.CreateAccount(ctx, req)
+		require.Equal(t, codes.InvalidArgument, status.Code(err), req.String())
+	}
+
+	resp, err := server.ListAccounts(ctx, &pb.ListAccountsRequest{
+		PageId:   1,
+		PageSize: 5,
+		Currency: proto.String("USD"),
+		Type:     proto.String(db.AccountTypeSavings),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Accounts, 1)
+	require.Equal(t, savings.Account.Id, resp.Accounts[0].Id)
+
+	_, err = server.ListAccounts(ctx, &pb.ListAccountsRequest{PageId: 1, PageSize: 5, Type: proto.String("brokerage")})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUpdateAccountNickname(t *testing.T) {
+	server := newTestServer(t)
+
+	owner := createTestUser(t)
+	viewer := createTestUser(t)
+	account := createTestAccount(t, owner.Username, "EUR", 0)
+	createTestAccountMember(t, server, account, viewer.Username, db.AccountRoleViewer, 0)
+
+	_, err := server.UpdateAccountNickname(authContext(t, viewer.Username), &pb.UpdateAccountNicknameRequest{Id: account.ID, Nickname: "Mine"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	resp, err := server.UpdateAccountNickname(authContext(t, owner.Username), &pb.UpdateAccountNicknameRequest{Id: account.ID, Nickname: "Rent"})
+	require.NoError(t, err)
+	require.Equal(t, "Rent", resp.Account.Nickname)
+
+	lookUp, err := server.LookUpAccount(authContext(t, viewer.Username), &pb.LookUpAccountRequest{AccountNumber: account.AccountNumber})
+	require.NoError(t, err)
+	require.Equal(t, "Rent", lookUp.Account.Nickname)
+	require.Equal(t, db.AccountTypeChecking, lookUp.Account.Type)
+
+	// An empty nickname clears it.
+	resp, err = server.UpdateAccountNickname(authContext(t, owner.Username), &pb.UpdateAccountNicknameRequest{Id: account.ID})
+	require.NoError(t, err)
+	require.Empty(t, resp.Account.Nickname)
+}
+
+func TestLookUpAccount(t *testing.T) {
+	server := newTestServer(t)
+
+	owner := createTestUser(t)
+	stranger := createTestUser(t)
+
+	created, err := server.CreateAccount(authContext(t, owner.Username), &pb.CreateAccountRequest{
+		Currency: "EUR",
+		Type:     db.AccountTypeSavings,
+		Nickname: "Holiday",
+	})
+	require.NoError(t, err)
+
+	// Anyone can tell a recipient's accounts apart by type and nickname.
+	lookUp, err := server.LookUpAccount(authContext(t, stranger.Username), &pb.LookUpAccountRequest{AccountNumber: created.Account.AccountNumber})
+	require.NoError(t, err)
+	require.Equal(t, owner.Username, lookUp.Account.Owner)
+	require.Equal(t, "EUR", lookUp.Account.Currency)
+	require.Equal(t, db.AccountTypeSavings, lookUp.Account.Type)
+	require.Equal(t, "Holiday", lookUp.Account.Nickname)
+	require.Equal(t, created.Account.AccountNumber, lookUp.Account.AccountNumber)
+}
+
+func TestAccountNumbers(t *testing.T) {
+	server := newTestServer(t)
+
+	sender := createTestUser(t)
+	recipient := createTestUser(t)
+	from := createTestAccount(t, sender.Username, "USD", 10_000)
+	to := createTestAccount(t, recipient.Username, "USD", 0)
+	ctx := authContext(t, sender.Username)
+
+	// Numbers are accepted as printed: grouped and in any case.
+	number := to.AccountNumber
+	printed := strings.ToLower(number[:4] + " " + number[4:8] + " " + number[8:12] + " " + number[12:])
+
+	lookUp, err := server.LookUpAccount(ctx, &pb.LookUpAccountRequest{AccountNumber: printed})
+	require.NoError(t, err)
+	require.Equal(t, recipient.Username, lookUp.Account.Owner)
+	require.Equal(t, number, lookUp.Account.AccountNumber)
+
+	// A single mistyped digit fails the check digits.
+	last := number[len(number)-1]
+	mistyped := number[:len(number)-1] + string('0'+(last-'0'+1)%10)
+	for _, req := range []*pb.LookUpAccountRequest{
+		{},
+		{AccountNumber: mistyped},
+		{AccountNumber: "GB92000012345678"},
+	} {
+		_, err := server.LookUpAccount(ctx, req)
+		require.Equal(t, codes.InvalidArgument, status.Code(err), req.String())
+	}
+
+	resp, err := server.CreateTransfer(ctx, &pb.CreateTransferRequest{
+		FromAccountId:   from.ID,
+		ToAccountNumber: printed,
+		Amount:          10,
+		Currency:        "USD",
+	})
+	require.NoError(t, err)
+	require.Equal(t, to.ID, resp.Transfer.ToAccountId)
+	require.Equal(t, 10.0, resp.ToAccount.Balance)
+
+	_, err = server.CreateTransfer(ctx, &pb.CreateTransferRequest{
+		FromAccountId:   from.ID,
+		ToAccountNumber: from.AccountNumber,
+		Amount:          10,
+		Currency:        "USD",
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,208 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/a7medalyapany/GoBank.git/pb"
+	"github.com/a7medalyapany/GoBank.git/token"
+	"github.com/a7medalyapany/GoBank.git/util"
+	"github.com/a7medalyapany/GoBank.git/val"
+	"github.com/a7medalyapany/GoBank.git/worker"
+	"github.com/hibiken/asynq"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func convertQueue(q *asynq.QueueInfo) *pb.Queue {
+	return &pb.Queue{
+		Name:           q.Queue,
+		Size:           int32(q.Size),
+		Pending:        int32(q.Pending),
+		Active:         int32(q.Active),
+		Scheduled:      int32(q.Scheduled),
+		Retry:          int32(q.Retry),
+		Archived:       int32(q.Archived),
+		Completed:      int32(q.Completed),
+		ProcessedToday: int32(q.Processed),
+		FailedToday:    int32(q.Failed),
+		Latency:        durationpb.New(q.Latency),
+		Paused:         q.Paused,
+	}
+}
+
+func convertQueueTask(t *asynq.TaskInfo) *pb.QueueTask {
+	task := &pb.QueueTask{
+		Id:        t.ID,
+		Queue:     t.Queue,
+		Type:      t.Type,
+		Payload:   string(t.Payload),
+		State:     t.State.String(),
+		MaxRetry:  int32(t.MaxRetry),
+		Retried:   int32(t.Retried),
+		LastError: t.LastErr,
+	}
+	if !t.LastFailedAt.IsZero() {
+		task.LastFailedAt = timestamppb.New(t.LastFailedAt)
+	}
+	if !t.NextProcessAt.IsZero() {
+		task.NextProcessAt = timestamppb.New(t.NextProcessAt)
+	}
+	return task
+}
+
+// authorizeAdmin checks that the authenticated user has the admin role. The
+// role is read from the database on every call, so revoking it takes effect
+// immediately.
+func (server *Server) authorizeAdmin(ctx context.Context) error {
+	authPayload, ok := ctx.Value(authPayloadKey).(*token.Payload)
+	if !ok || authPayload == nil {
+		return status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+
+	user, err := server.store.GetUser(ctx, authPayload.Username)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+
+	if user.Role != util.RoleAdmin {
+		return status.Errorf(codes.PermissionDenied, "admin role required")
+	}
+
+	return nil
+}
+
+// authorizeQueueAdmin is authorizeAdmin for the queue RPCs, which also need
+// a task inspector.
+func (server *Server) authorizeQueueAdmin(ctx context.Context) error {
+	if err := server.authorizeAdmin(ctx); err != nil {
+		return err
+	}
+
+	if server.taskInspector == nil {
+		return status.Errorf(codes.Unavailable, "task queues are not configured")
+	}
+
+	return nil
+}
+
+// queueTaskError maps inspector errors to gRPC codes.
+func queueTaskError(action string, err error) error {
+	switch {
+	case errors.Is(err, asynq.ErrQueueNotFound):
+		return status.Errorf(codes.NotFound, "queue not found")
+	case errors.Is(err, asynq.ErrTaskNotFound):
+		return status.Errorf(codes.NotFound, "task not found")
+	}
+	return status.Errorf(codes.FailedPrecondition, "failed to %s task: %v", action, err)
+}
+
+// ListQueues
+func (server *Server) ListQueues(ctx context.Context, req *pb.ListQueuesRequest) (*pb.ListQueuesResponse, error) {
+	if err := server.authorizeQueueAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	queues, err := server.taskInspector.ListQueues()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list queues: %v", err)
+	}
+
+	pbQueues := make([]*pb.Queue, len(queues))
+	for i, q := range queues {
+		pbQueues[i] = convertQueue(q)
+	}
+
+	return &pb.ListQueuesResponse{Queues: pbQueues}, nil
+}
+
+// ListQueueTasks
+func (server *Server) ListQueueTasks(ctx context.Context, req *pb.ListQueueTasksRequest) (*pb.ListQueueTasksResponse, error) {
+	if violations := validateListQueueTasksRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	if err := server.authorizeQueueAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	tasks, err := server.taskInspector.ListTasks(req.GetQueue(), req.GetState(), int(req.GetPageId()), int(req.GetPageSize()))
+	if err != nil {
+		if errors.Is(err, asynq.ErrQueueNotFound) {
+			return nil, status.Errorf(codes.NotFound, "queue not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list tasks: %v", err)
+	}
+
+	pbTasks := make([]*pb.QueueTask, len(tasks))
+	for i, t := range tasks {
+		pbTasks[i] = convertQueueTask(t)
+	}
+
+	return &pb.ListQueueTasksResponse{Tasks: pbTasks}, nil
+}
+
+func validateListQueueTasksRequest(req *pb.ListQueueTasksRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateString(req.GetQueue(), 1, 100); err != nil {
+		violations = append(violations, fieldViolation("queue", err))
+	}
+	if !worker.IsKnownTaskState(req.GetState()) {
+		violations = append(violations, fieldViolation("state",
+			fmt.Errorf("must be one of pending, active, scheduled, retry, archived, completed")))
+	}
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+	return
+}
+
+// RetryQueueTask
+func (server *Server) RetryQueueTask(ctx context.Context, req *pb.RetryQueueTaskRequest) (*pb.RetryQueueTaskResponse, error) {
+	if violations := validateQueueTaskRef(req.GetQueue(), req.GetTaskId()); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	if err := server.authorizeQueueAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := server.taskInspector.RunTask(req.GetQueue(), req.GetTaskId()); err != nil {
+		return nil, queueTaskError("retry", err)
+	}
+
+	return &pb.RetryQueueTaskResponse{Status: worker.TaskStatePending}, nil
+}
+
+// DeleteQueueTask
+func (server *Server) DeleteQueueTask(ctx context.Context, req *pb.DeleteQueueTaskRequest) (*pb.DeleteQueueTaskResponse, error) {
+	if violations := validateQueueTaskRef(req.GetQueue(), req.GetTaskId()); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	if err := server.authorizeQueueAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := server.taskInspector.DeleteTask(req.GetQueue(), req.GetTaskId()); err != nil {
+		return nil, queueTaskError("delete", err)
+	}
+
+	return &pb.DeleteQueueTaskResponse{Status: "deleted"}, nil
+}
+
+func validateQueueTaskRef(queue string, taskID string) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateString(queue, 1, 100); err != nil {
+		violations = append(violations, fieldViolation("queue", err))
+	}
+	if err := val.ValidateString(taskID, 1, 200); err != nil {
+		violations = append(violations, fieldViolation("task_id", err))
+	}
+	return
+}
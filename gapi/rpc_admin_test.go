@@ -0,0 +1,205 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
+	"github.com/a7medalyapany/GoBank.git/pb"
+	"github.com/a7medalyapany/GoBank.git/util"
+	"github.com/a7medalyapany/GoBank.git/worker"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeTaskInspector serves archived tasks from memory.
+type fakeTaskInspector struct {
+	archived map[string]*asynq.TaskInfo
+	ran      []string
+}
+
+func (f *fakeTaskInspector) ListQueues() ([]*asynq.QueueInfo, error) {
+	return []*asynq.QueueInfo{
+		{Queue: worker.QueueCritical},
+		{Queue: worker.QueueDefault, Size: len(f.archived), Archived: len(f.archived), Latency: time.Second},
+		{Queue: worker.QueueLow},
+	}, nil
+}
+
+func (f *fakeTaskInspector) ListTasks(queue string, state string, pageID int, pageSize int) ([]*asynq.TaskInfo, error) {
+	if queue != worker.QueueDefault {
+		return nil, fmt.Errorf("asynq: %w", asynq.ErrQueueNotFound)
+	}
+	tasks := []*asynq.TaskInfo{}
+	if state == worker.TaskStateArchived {
+		for _, task := range f.archived {
+			tasks = append(tasks, task)
+		}
+	}
+	return tasks, nil
+}
+
+func (f *fakeTaskInspector) RunTask(queue string, taskID string) error {
+	if _, ok := f.archived[taskID]; !ok || queue != worker.QueueDefault {
+		return fmt.Errorf("asynq: %w", asynq.ErrTaskNotFound)
+	}
+	delete(f.archived, taskID)
+	f.ran = append(f.ran, taskID)
+	return nil
+}
+
+func (f *fakeTaskInspector) DeleteTask(queue string, taskID string) error {
+	if _, ok := f.archived[taskID]; !ok || queue != worker.QueueDefault {
+		return fmt.Errorf("asynq: %w", asynq.ErrTaskNotFound)
+	}
+	delete(f.archived, taskID)
+	return nil
+}
+
+func newTestAdminServer(t *testing.T) (*Server, *fakeTaskInspector) {
+	t.Helper()
+
+	inspector := &fakeTaskInspector{
+		archived: map[string]*asynq.TaskInfo{
+			"outbox:1": {
+				ID:       "outbox:1",
+				Queue:    worker.QueueDefault,
+				Type:     worker.TaskSendVerifyEmail,
+				Payload:  []byte(`{"username":"alice"}`),
+				State:    asynq.TaskStateArchived,
+				MaxRetry: 10,
+				Retried:  10,
+				LastErr:  "smtp: connection refused",
+			},
+			"outbox:2": {
+				ID:    "outbox:2",
+				Queue: worker.QueueDefault,
+				Type:  worker.TaskSendVerifyEmail,
+				State: asynq.TaskStateArchived,
+			},
+		},
+	}
+
+	server := newTestServer(t)
+	server.taskInspector = inspector
+	return server, inspector
+}
+
+func createTestAdmin(t *testing.T) db.User {
+	t.Helper()
+
+	user := createTestUser(t)
+	_, err := testDB.Exec(context.Background(), "UPDATE users SET role = $2 WHERE username = $1", user.Username, util.RoleAdmin)
+	require.NoError(t, err)
+
+	return user
+}
+
+func TestListQueues(t *testing.T) {
+	server, _ := newTestAdminServer(t)
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		resp, err := server.ListQueues(authContext(t, createTestUser(t).Username), &pb.ListQueuesRequest{})
+		require.Nil(t, resp)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("OK", func(t *testing.T) {
+		resp, err := server.ListQueues(authContext(t, createTestAdmin(t).Username), &pb.ListQueuesRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.Queues, 3)
+		require.Equal(t, worker.QueueDefault, resp.Queues[1].Name)
+		require.EqualValues(t, 2, resp.Queues[1].Archived)
+		require.Equal(t, time.Second, resp.Queues[1].Latency.AsDuration())
+	})
+}
+
+func TestListQueueTasks(t *testing.T) {
+	server, _ := newTestAdminServer(t)
+	admin := createTestAdmin(t)
+
+	t.Run("InvalidState", func(t *testing.T) {
+		resp, err := server.ListQueueTasks(authContext(t, admin.Username), &pb.ListQueueTasksRequest{
+			Queue:    worker.QueueDefault,
+			State:    "stuck",
+			PageId:   1,
+			PageSize: 10,
+		})
+		require.Nil(t, resp)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("QueueNotFound", func(t *testing.T) {
+		resp, err := server.ListQueueTasks(authContext(t, admin.Username), &pb.ListQueueTasksRequest{
+			Queue:    "unknown",
+			State:    worker.TaskStateArchived,
+			PageId:   1,
+			PageSize: 10,
+		})
+		require.Nil(t, resp)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("Archived", func(t *testing.T) {
+		resp, err := server.ListQueueTasks(authContext(t, admin.Username), &pb.ListQueueTasksRequest{
+			Queue:    worker.QueueDefault,
+			State:    worker.TaskStateArchived,
+			PageId:   1,
+			PageSize: 10,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Tasks, 2)
+		for _, task := range resp.Tasks {
+			require.Equal(t, worker.TaskStateArchived, task.State)
+			require.Equal(t, worker.TaskSendVerifyEmail, task.Type)
+		}
+	})
+}
+
+func TestRetryAndDeleteQueueTask(t *testing.T) {
+	server, inspector := newTestAdminServer(t)
+	admin := createTestAdmin(t)
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		resp, err := server.RetryQueueTask(authContext(t, createTestUser(t).Username), &pb.RetryQueueTaskRequest{
+			Queue:  worker.QueueDefault,
+			TaskId: "outbox:1",
+		})
+		require.Nil(t, resp)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		require.Empty(t, inspector.ran)
+	})
+
+	t.Run("Retry", func(t *testing.T) {
+		resp, err := server.RetryQueueTask(authContext(t, admin.Username), &pb.RetryQueueTaskRequest{
+			Queue:  worker.QueueDefault,
+			TaskId: "outbox:1",
+		})
+		require.NoError(t, err)
+		require.Equal(t, worker.TaskStatePending, resp.Status)
+		require.Equal(t, []string{"outbox:1"}, inspector.ran)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		resp, err := server.DeleteQueueTask(authContext(t, admin.Username), &pb.DeleteQueueTaskRequest{
+			Queue:  worker.QueueDefault,
+			TaskId: "outbox:2",
+		})
+		require.NoError(t, err)
+		require.Equal(t, "deleted", resp.Status)
+		require.Empty(t, inspector.archived)
+	})
+
+	t.Run("TaskNotFound", func(t *testing.T) {
+		resp, err := server.DeleteQueueTask(authContext(t, admin.Username), &pb.DeleteQueueTaskRequest{
+			Queue:  worker.QueueDefault,
+			TaskId: "outbox:2",
+		})
+		require.Nil(t, resp)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
@@ -0,0 +1,199 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
+	"github.com/a7medalyapany/GoBank.git/pb"
+	"github.com/a7medalyapany/GoBank.git/token"
+	"github.com/a7medalyapany/GoBank.git/val"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func convertApiKey(k db.ApiKey) *pb.ApiKey {
+	apiKey := &pb.ApiKey{
+		Id:        k.ID,
+		Name:      k.Name,
+		Prefix:    k.Prefix,
+		Scopes:    k.Scopes,
+		CreatedAt: timestamppb.New(k.CreatedAt.Time),
+	}
+	if k.ExpiresAt.Valid {
+		apiKey.ExpiresAt = timestamppb.New(k.ExpiresAt.Time)
+	}
+	if k.LastUsedAt.Valid {
+		apiKey.LastUsedAt = timestamppb.New(k.LastUsedAt.Time)
+	}
+	if k.RevokedAt.Valid {
+		apiKey.RevokedAt = timestamppb.New(k.RevokedAt.Time)
+	}
+	return apiKey
+}
+
+// apiKeyCreateAttempts bounds how often CreateApiKey draws a new key when the
+// 32-bit lookup prefix collides with an existing one.
+const apiKeyCreateAttempts = 5
+
+// generateAPIKey is swapped in tests to force prefix collisions.
+var generateAPIKey = token.GenerateAPIKey
+
+// CreateApiKey
+func (server *Server) CreateApiKey(ctx context.Context, req *pb.CreateApiKeyRequest) (*pb.CreateApiKeyResponse, error) {
+	if violations := validateCreateApiKeyRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	authPayload, ok := ctx.Value(authPayloadKey).(*token.Payload)
+	if !ok || authPayload == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+
+	// A key can never grant more than the credential used to create it.
+	for _, scope := range req.GetScopes() {
+		if !authPayload.HasScope(scope) {
+			return nil, status.Errorf(codes.PermissionDenied, "cannot grant scope %s", scope)
+		}
+	}
+
+	arg := db.CreateApiKeyParams{
+		Username: authPayload.Username,
+		Name:     req.GetName(),
+		Scopes:   req.GetScopes(),
+	}
+	if req.ExpiresAt != nil {
+		arg.ExpiresAt = pgtype.Timestamptz{Time: req.GetExpiresAt().AsTime(), Valid: true}
+	}
+
+	var generated token.APIKey
+	var apiKey db.ApiKey
+	for attempt := 1; ; attempt++ {
+		var err error
+		generated, err = generateAPIKey()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate api key: %v", err)
+		}
+		arg.Prefix = generated.Prefix
+		arg.HashedKey = generated.HashedKey
+
+		apiKey, err = server.store.CreateApiKey(ctx, arg)
+		if err == nil {
+			break
+		}
+
+		// The prefix is unique, so a collision just means drawing another key.
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" && attempt < apiKeyCreateAttempts { // unique_violation
+			continue
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create api key: %v", err)
+	}
+
+	return &pb.CreateApiKeyResponse{
+		ApiKey: convertApiKey(apiKey),
+		Key:    generated.Key,
+	}, nil
+}
+
+func validateCreateApiKeyRequest(req *pb.CreateApiKeyRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateString(req.GetName(), 1, 100); err != nil {
+		violations = append(violations, fieldViolation("name", err))
+	}
+	if len(req.GetScopes()) == 0 {
+		violations = append(violations, fieldViolation("scopes", errors.New("must grant at least one scope")))
+	}
+	for _, scope := range req.GetScopes() {
+		switch {
+		case !token.IsKnownScope(scope):
+			violations = append(violations, fieldViolation("scopes", fmt.Errorf("unknown scope: %s", scope)))
+		case scope == token.ScopeAPIKeysManage:
+			violations = append(violations, fieldViolation("scopes", fmt.Errorf("scope %s cannot be granted to an api key", scope)))
+		}
+	}
+	if req.ExpiresAt != nil {
+		if err := req.GetExpiresAt().CheckValid(); err != nil {
+			violations = append(violations, fieldViolation("expires_at", err))
+		} else if !req.GetExpiresAt().AsTime().After(time.Now()) {
+			violations = append(violations, fieldViolation("expires_at", errors.New("must be in the future")))
+		}
+	}
+	return
+}
+
+// ListApiKeys
+func (server *Server) ListApiKeys(ctx context.Context, req *pb.ListApiKeysRequest) (*pb.ListApiKeysResponse, error) {
+	if violations := validateListApiKeysRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	authPayload, ok := ctx.Value(authPayloadKey).(*token.Payload)
+	if !ok || authPayload == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+
+	apiKeys, err := server.store.ListApiKeys(ctx, db.ListApiKeysParams{
+		Username: authPayload.Username,
+		Limit:    req.GetPageSize(),
+		Offset:   (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list api keys: %v", err)
+	}
+
+	pbApiKeys := make([]*pb.ApiKey, len(apiKeys))
+	for i, k := range apiKeys {
+		pbApiKeys[i] = convertApiKey(k)
+	}
+
+	return &pb.ListApiKeysResponse{ApiKeys: pbApiKeys}, nil
+}
+
+func validateListApiKeysRequest(req *pb.ListApiKeysRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+	return
+}
+
+// RevokeApiKey
+func (server *Server) RevokeApiKey(ctx context.Context, req *pb.RevokeApiKeyRequest) (*pb.RevokeApiKeyResponse, error) {
+	if violations := validateRevokeApiKeyRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	authPayload, ok := ctx.Value(authPayloadKey).(*token.Payload)
+	if !ok || authPayload == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+
+	apiKey, err := server.store.RevokeApiKey(ctx, db.RevokeApiKeyParams{
+		ID:       req.GetId(),
+		Username: authPayload.Username,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "api key not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to revoke api key: %v", err)
+	}
+
+	return &pb.RevokeApiKeyResponse{ApiKey: convertApiKey(apiKey)}, nil
+}
+
+func validateRevokeApiKeyRequest(req *pb.RevokeApiKeyRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+	return
+}
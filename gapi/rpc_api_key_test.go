@@ -0,0 +1,237 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
+	"github.com/a7medalyapany/GoBank.git/pb"
+	"github.com/a7medalyapany/GoBank.git/token"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// callWithAPIKey runs method through authInterceptor with an "ApiKey"
+// authorization header and returns the payload the handler received.
+func callWithAPIKey(server *Server, method string, key string) (*token.Payload, error) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "ApiKey "+key))
+
+	var payload *token.Payload
+	_, err := server.authInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req any) (any, error) {
+		payload, _ = ctx.Value(authPayloadKey).(*token.Payload)
+		return nil, nil
+	})
+	return payload, err
+}
+
+// createTestApiKey stores a key for username directly, so tests can give it
+// any expiry.
+func createTestApiKey(t *testing.T, username string, expiresAt pgtype.Timestamptz, scopes ...string) (db.ApiKey, string) {
+	t.Helper()
+
+	generated, err := token.GenerateAPIKey()
+	require.NoError(t, err)
+
+	apiKey, err := testStore.CreateApiKey(context.Background(), db.CreateApiKeyParams{
+		Username:  username,
+		Name:      "test key",
+		Prefix:    generated.Prefix,
+		HashedKey: generated.HashedKey,
+		Scopes:    scopes,
+		ExpiresAt: expiresAt,
+	})
+	require.NoError(t, err)
+
+	return apiKey, generated.Key
+}
+
+func TestApiKeyRPCs(t *testing.T) {
+	server := newTestServer(t)
+
+	user := createTestUser(t)
+	ctx := authContext(t, user.Username)
+
+	t.Run("InvalidScopes", func(t *testing.T) {
+		for _, req := range []*pb.CreateApiKeyRequest{
+			{Name: "ci", Scopes: nil},
+			{Name: "ci", Scopes: []string{"accounts:everything"}},
+			{Name: "ci", Scopes: []string{token.ScopeAPIKeysManage}},
+			{Name: "ci", Scopes: []string{token.ScopeAccountsRead}, ExpiresAt: timestamppb.New(time.Now().Add(-time.Hour))},
+		} {
+			_, err := server.CreateApiKey(ctx, req)
+			require.Equal(t, codes.InvalidArgument, status.Code(err), req.String())
+		}
+	})
+
+	t.Run("CannotGrantMoreThanCaller", func(t *testing.T) {
+		payload, err := token.NewPayload(user.Username, token.TokenTypeAccess, time.Minute, token.WithScopes(token.ScopeAPIKeysManage, token.ScopeAccountsRead))
+		require.NoError(t, err)
+		limited := context.WithValue(context.Background(), authPayloadKey, payload)
+
+		_, err = server.CreateApiKey(limited, &pb.CreateApiKeyRequest{Name: "ci", Scopes: []string{token.ScopeTransfersWrite}})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("CreateListRevoke", func(t *testing.T) {
+		created, err := server.CreateApiKey(ctx, &pb.CreateApiKeyRequest{
+			Name:   "reporting",
+			Scopes: []string{token.ScopeAccountsRead},
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, created.Key)
+		require.Contains(t, created.Key, created.ApiKey.Prefix)
+		require.Nil(t, created.ApiKey.ExpiresAt)
+
+		list, err := server.ListApiKeys(ctx, &pb.ListApiKeysRequest{PageId: 1, PageSize: 10})
+		require.NoError(t, err)
+		require.Len(t, list.ApiKeys, 1)
+		require.Equal(t, created.ApiKey.Id, list.ApiKeys[0].Id)
+
+		// Another user can neither see nor revoke the key.
+		other := authContext(t, createTestUser(t).Username)
+		list, err = server.ListApiKeys(other, &pb.ListApiKeysRequest{PageId: 1, PageSize: 10})
+		require.NoError(t, err)
+		require.Empty(t, list.ApiKeys)
+		_, err = server.RevokeApiKey(other, &pb.RevokeApiKeyRequest{Id: created.ApiKey.Id})
+		require.Equal(t, codes.NotFound, status.Code(err))
+
+		revoked, err := server.RevokeApiKey(ctx, &pb.RevokeApiKeyRequest{Id: created.ApiKey.Id})
+		require.NoError(t, err)
+		require.NotNil(t, revoked.ApiKey.RevokedAt)
+
+		_, err = server.RevokeApiKey(ctx, &pb.RevokeApiKeyRequest{Id: created.ApiKey.Id})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("RetriesPrefixCollision", func(t *testing.T) {
+		taken, _ := createTestApiKey(t, user.Username, pgtype.Timestamptz{}, token.ScopeAccountsRead)
+
+		// The first draw reuses an existing prefix; the next one is fresh.
+		calls := 0
+		generateAPIKey = func() (token.APIKey, error) {
+			calls++
+			generated, err := token.GenerateAPIKey()
+			if calls == 1 {
+				generated.Prefix = taken.Prefix
+			}
+			return generated, err
+		}
+		t.Cleanup(func() { generateAPIKey = token.GenerateAPIKey })
+
+		created, err := server.CreateApiKey(ctx, &pb.CreateApiKeyRequest{
+			Name:   "retried",
+			Scopes: []string{token.ScopeAccountsRead},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, calls)
+		require.NotEqual(t, taken.Prefix, created.ApiKey.Prefix)
+
+		// A prefix that keeps colliding gives up after apiKeyCreateAttempts.
+		calls = 0
+		generateAPIKey = func() (token.APIKey, error) {
+			calls++
+			generated, err := token.GenerateAPIKey()
+			generated.Prefix = taken.Prefix
+			return generated, err
+		}
+
+		_, err = server.CreateApiKey(ctx, &pb.CreateApiKeyRequest{
+			Name:   "unlucky",
+			Scopes: []string{token.ScopeAccountsRead},
+		})
+		require.Equal(t, codes.Internal, status.Code(err))
+		require.Equal(t, apiKeyCreateAttempts, calls)
+	})
+}
+
+func TestAuthInterceptorApiKey(t *testing.T) {
+	server := newTestServer(t)
+	user := createTestUser(t)
+
+	t.Run("OK", func(t *testing.T) {
+		_, key := createTestApiKey(t, user.Username, pgtype.Timestamptz{}, token.ScopeAccountsRead)
+
+		payload, err := callWithAPIKey(server, "/pb.GoBank/ListAccounts", key)
+		require.NoError(t, err)
+		require.NotNil(t, payload)
+		require.Equal(t, user.Username, payload.Username)
+		require.Equal(t, []string{token.ScopeAccountsRead}, payload.Scopes)
+	})
+
+	t.Run("SchemeIsCaseInsensitive", func(t *testing.T) {
+		_, key := createTestApiKey(t, user.Username, pgtype.Timestamptz{}, token.ScopeAccountsRead)
+
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "apikey "+key))
+		_, err := server.authInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/pb.GoBank/ListAccounts"}, func(ctx context.Context, req any) (any, error) {
+			return nil, nil
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("UnknownKey", func(t *testing.T) {
+		_, err := callWithAPIKey(server, "/pb.GoBank/ListAccounts", "gbk_00000000_invalid")
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		_, err = callWithAPIKey(server, "/pb.GoBank/ListAccounts", "not-a-key")
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("Revoked", func(t *testing.T) {
+		apiKey, key := createTestApiKey(t, user.Username, pgtype.Timestamptz{}, token.ScopeAccountsRead)
+		_, err := testStore.RevokeApiKey(context.Background(), db.RevokeApiKeyParams{ID: apiKey.ID, Username: user.Username})
+		require.NoError(t, err)
+
+		_, err = callWithAPIKey(server, "/pb.GoBank/ListAccounts", key)
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+		require.Contains(t, status.Convert(err).Message(), "revoked")
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		_, key := createTestApiKey(t, user.Username, pgtype.Timestamptz{Time: time.Now().Add(-time.Minute), Valid: true}, token.ScopeAccountsRead)
+
+		_, err := callWithAPIKey(server, "/pb.GoBank/ListAccounts", key)
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+		require.Contains(t, status.Convert(err).Message(), "expired")
+	})
+
+	t.Run("ScopeMismatch", func(t *testing.T) {
+		_, key := createTestApiKey(t, user.Username, pgtype.Timestamptz{}, token.ScopeAccountsRead)
+
+		_, err := callWithAPIKey(server, "/pb.GoBank/CreateTransfer", key)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("LastUsedThrottle", func(t *testing.T) {
+		apiKey, key := createTestApiKey(t, user.Username, pgtype.Timestamptz{}, token.ScopeAccountsRead)
+		require.False(t, apiKey.LastUsedAt.Valid)
+
+		_, err := callWithAPIKey(server, "/pb.GoBank/ListAccounts", key)
+		require.NoError(t, err)
+		first, err := testStore.GetApiKeyByPrefix(context.Background(), apiKey.Prefix)
+		require.NoError(t, err)
+		require.True(t, first.LastUsedAt.Valid)
+
+		// A second use within a minute is not written.
+		_, err = callWithAPIKey(server, "/pb.GoBank/ListAccounts", key)
+		require.NoError(t, err)
+		second, err := testStore.GetApiKeyByPrefix(context.Background(), apiKey.Prefix)
+		require.NoError(t, err)
+		require.Equal(t, first.LastUsedAt.Time, second.LastUsedAt.Time)
+
+		// Once the last use is over a minute old, the next one is recorded.
+		_, err = testDB.Exec(context.Background(),
+			"UPDATE api_keys SET last_used_at = now() - interval '2 minutes' WHERE id = $1", apiKey.ID)
+		require.NoError(t, err)
+		_, err = callWithAPIKey(server, "/pb.GoBank/ListAccounts", key)
+		require.NoError(t, err)
+		third, err := testStore.GetApiKeyByPrefix(context.Background(), apiKey.Prefix)
+		require.NoError(t, err)
+		require.WithinDuration(t, time.Now(), third.LastUsedAt.Time, 10*time.Second)
+	})
+}
@@ -0,0 +1,104 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
+	"github.com/a7medalyapany/GoBank.git/pb"
+	"github.com/a7medalyapany/GoBank.git/val"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func convertAuditEvent(e db.AuditEvent) *pb.AuditEvent {
+	return &pb.AuditEvent{
+		Id:         e.ID,
+		Actor:      e.Actor,
+		Action:     e.Action,
+		TargetType: e.TargetType,
+		TargetId:   e.TargetID,
+		Before:     string(e.Before),
+		After:      string(e.After),
+		ClientIp:   e.ClientIp,
+		UserAgent:  e.UserAgent,
+		RequestId:  e.RequestID,
+		CreatedAt:  timestamppb.New(e.CreatedAt.Time),
+	}
+}
+
+// optionalText maps an empty filter to NULL, which the query treats as "any".
+func optionalText(s string) pgtype.Text {
+	return pgtype.Text{String: s, Valid: s != ""}
+}
+
+func optionalTimestamp(ts *timestamppb.Timestamp) pgtype.Timestamptz {
+	if ts == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: ts.AsTime(), Valid: true}
+}
+
+// QueryAuditLog
+func (server *Server) QueryAuditLog(ctx context.Context, req *pb.QueryAuditLogRequest) (*pb.QueryAuditLogResponse, error) {
+	if violations := validateQueryAuditLogRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	if err := server.authorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	events, err := server.store.ListAuditEvents(ctx, db.ListAuditEventsParams{
+		Actor:         optionalText(req.GetActor()),
+		Action:        optionalText(req.GetAction()),
+		TargetType:    optionalText(req.GetTargetType()),
+		TargetID:      optionalText(req.GetTargetId()),
+		CreatedAfter:  optionalTimestamp(req.GetStartTime()),
+		CreatedBefore: optionalTimestamp(req.GetEndTime()),
+		LimitArg:      req.GetPageSize(),
+		OffsetArg:     (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query audit log: %v", err)
+	}
+
+	pbEvents := make([]*pb.AuditEvent, len(events))
+	for i, e := range events {
+		pbEvents[i] = convertAuditEvent(e)
+	}
+
+	return &pb.QueryAuditLogResponse{Events: pbEvents}, nil
+}
+
+func validateQueryAuditLogRequest(req *pb.QueryAuditLogRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	for _, filter := range []struct{ field, value string }{
+		{"actor", req.GetActor()},
+		{"action", req.GetAction()},
+		{"target_type", req.GetTargetType()},
+		{"target_id", req.GetTargetId()},
+	} {
+		if filter.value == "" {
+			continue
+		}
+		if err := val.ValidateString(filter.value, 1, 200); err != nil {
+			violations = append(violations, fieldViolation(filter.field, err))
+		}
+	}
+	if req.GetTargetId() != "" && req.GetTargetType() == "" {
+		violations = append(violations, fieldViolation("target_type", errors.New("is required with target_id")))
+	}
+	if start, end := req.GetStartTime(), req.GetEndTime(); start != nil && end != nil && !end.AsTime().After(start.AsTime()) {
+		violations = append(violations, fieldViolation("end_time", errors.New("must be after start_time")))
+	}
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+	return
+}
@@ -0,0 +1,91 @@
+package gapi
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
+	"github.com/a7medalyapany/GoBank.git/pb"
+	"github.com/a7medalyapany/GoBank.git/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestQueryAuditLog(t *testing.T) {
+	server := newTestServer(t)
+	admin := createTestAdmin(t)
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		resp, err := server.QueryAuditLog(authContext(t, createTestUser(t).Username), &pb.QueryAuditLogRequest{
+			PageId:   1,
+			PageSize: 10,
+		})
+		require.Nil(t, resp)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("TargetIDWithoutType", func(t *testing.T) {
+		resp, err := server.QueryAuditLog(authContext(t, admin.Username), &pb.QueryAuditLogRequest{
+			TargetId: "1",
+			PageId:   1,
+			PageSize: 10,
+		})
+		require.Nil(t, resp)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("AccountUpdated", func(t *testing.T) {
+		owner := createTestUser(t)
+		account := createTestAccount(t, owner.Username, util.USD, 1000)
+
+		_, err := server.UpdateAccount(authContext(t, owner.Username), &pb.UpdateAccountRequest{
+			Id:      account.ID,
+			Balance: 25,
+		})
+		require.NoError(t, err)
+
+		resp, err := server.QueryAuditLog(authContext(t, admin.Username), &pb.QueryAuditLogRequest{
+			TargetType: db.AuditTargetAccount,
+			TargetId:   strconv.FormatInt(account.ID, 10),
+			PageId:     1,
+			PageSize:   10,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Events, 1)
+
+		event := resp.Events[0]
+		require.Equal(t, owner.Username, event.Actor)
+		require.Equal(t, db.AuditAccountUpdated, event.Action)
+
+		var before, after db.Account
+		require.NoError(t, json.Unmarshal([]byte(event.Before), &before))
+		require.NoError(t, json.Unmarshal([]byte(event.After), &after))
+		require.Equal(t, int64(1000), before.Balance)
+		require.Equal(t, int64(2500), after.Balance)
+	})
+	t.Run("ActorIsCaller", func(t *testing.T) {
+		owner := createTestUser(t)
+		coOwner := createTestUser(t)
+		account := createTestAccount(t, owner.Username, util.USD, 1000)
+		createTestAccountMember(t, server, account, coOwner.Username, db.AccountRoleCoOwner, 0)
+
+		_, err := server.UpdateAccountNickname(authContext(t, coOwner.Username), &pb.UpdateAccountNicknameRequest{
+			Id:       account.ID,
+			Nickname: "Holidays",
+		})
+		require.NoError(t, err)
+
+		resp, err := server.QueryAuditLog(authContext(t, admin.Username), &pb.QueryAuditLogRequest{
+			TargetType: db.AuditTargetAccount,
+			TargetId:   strconv.FormatInt(account.ID, 10),
+			Action:     db.AuditAccountUpdated,
+			PageId:     1,
+			PageSize:   10,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Events, 1)
+		require.Equal(t, coOwner.Username, resp.Events[0].Actor)
+	})
+}
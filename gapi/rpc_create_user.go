@@ -27,14 +27,20 @@ func (server *Server) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 		return nil, status.Errorf(codes.Internal, "cannot hash password: %v", err)
 	}
 
+	locale := util.DefaultLocale
+	if req.Locale != nil {
+		locale = req.GetLocale()
+	}
+
 	arg := db.CreateUserTxParams{
 		CreateUserParams: db.CreateUserParams{
 			Username:       req.GetUsername(),
 			HashedPassword: hashedPassword,
 			FullName:       req.GetFullName(),
 			Email:          req.GetEmail(),
+			Locale:         locale,
 		},
-		AfterCreate: func(user db.User) error {
+		AfterCreate: func(user db.User) ([]db.CreateOutboxMessageParams, error) {
 			taskPayload := &worker.PayloadSendVerifyEmail{
 				Username: user.Username,
 			}
@@ -45,7 +51,12 @@ func (server *Server) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 				asynq.Queue(worker.QueueCritical),
 			}
 
-			return server.taskDistributor.DistributeTaskSendVerifyEmail(ctx, taskPayload, opts...)
+			// Written to the outbox in the user's transaction; the relay publishes it after commit.
+			message, err := worker.NewOutboxMessage(worker.TaskSendVerifyEmail, taskPayload, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return []db.CreateOutboxMessageParams{message}, nil
 		},
 	}
 
@@ -78,5 +89,10 @@ func validateCreateUserRequest(req *pb.CreateUserRequest) (violations []*errdeta
 	if err := val.ValidateFullname(req.GetFullName()); err != nil {
 		violations = append(violations, fieldViolation("full_name", err))
 	}
+	if req.Locale != nil {
+		if err := val.ValidateLocale(req.GetLocale()); err != nil {
+			violations = append(violations, fieldViolation("locale", err))
+		}
+	}
 	return
 }
\ No newline at end of file
@@ -0,0 +1,74 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
+	"github.com/a7medalyapany/GoBank.git/pb"
+	"github.com/a7medalyapany/GoBank.git/val"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ConfirmEmailChange
+func (server *Server) ConfirmEmailChange(ctx context.Context, req *pb.ConfirmEmailChangeRequest) (*pb.ConfirmEmailChangeResponse, error) {
+	if violations := validateEmailChangeLink(req.GetId(), req.GetCode()); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	audit := server.newAuditEntry(ctx, "", db.AuditUserEmailChangeConfirmed)
+	result, err := server.store.ConfirmEmailChangeTx(ctx, db.EmailChangeTxParams{
+		ID:    req.GetId(),
+		Code:  req.GetCode(),
+		Audit: &audit,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrEmailChangeInvalid) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" { // unique_violation
+			return nil, status.Errorf(codes.AlreadyExists, "email already in use")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to confirm email change: %v", err)
+	}
+
+	return &pb.ConfirmEmailChangeResponse{User: convertUser(result.User)}, nil
+}
+
+// CancelEmailChange
+func (server *Server) CancelEmailChange(ctx context.Context, req *pb.CancelEmailChangeRequest) (*pb.CancelEmailChangeResponse, error) {
+	if violations := validateEmailChangeLink(req.GetId(), req.GetCode()); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	audit := server.newAuditEntry(ctx, "", db.AuditUserEmailChangeCancelled)
+	result, err := server.store.CancelEmailChangeTx(ctx, db.EmailChangeTxParams{
+		ID:    req.GetId(),
+		Code:  req.GetCode(),
+		Audit: &audit,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrEmailChangeInvalid) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to cancel email change: %v", err)
+	}
+
+	return &pb.CancelEmailChangeResponse{Email: result.User.Email}, nil
+}
+
+func validateEmailChangeLink(id int64, code string) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(id); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+	if err := val.ValidateString(code, 32, 128); err != nil {
+		violations = append(violations, fieldViolation("code", err))
+	}
+	return
+}
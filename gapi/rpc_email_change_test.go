@@ -0,0 +1,90 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/a7medalyapany/GoBank.git/pb"
+	"github.com/a7medalyapany/GoBank.git/util"
+	"github.com/a7medalyapany/GoBank.git/worker"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUpdateUserEmailChange(t *testing.T) {
+	server := newTestServer(t)
+	user := createTestUser(t)
+	newEmail := util.RandomEmail()
+
+	resp, err := server.UpdateUser(authContext(t, user.Username), &pb.UpdateUserRequest{
+		Username: user.Username,
+		Email:    &newEmail,
+	})
+	require.NoError(t, err)
+	require.Equal(t, user.Email, resp.User.Email)
+	require.Equal(t, newEmail, resp.PendingEmail)
+
+	change, err := testStore.GetPendingEmailChange(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Equal(t, newEmail, change.NewEmail)
+	require.Equal(t, user.Email, change.OldEmail)
+
+	var count int
+	err = testDB.QueryRow(context.Background(),
+		"SELECT count(*) FROM outbox WHERE task_type = $1 AND (payload->>'email_change_id')::bigint = $2",
+		worker.TaskSendEmailChange, change.ID,
+	).Scan(&count)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	t.Run("ConfirmWrongCode", func(t *testing.T) {
+		resp, err := server.ConfirmEmailChange(context.Background(), &pb.ConfirmEmailChangeRequest{
+			Id:   change.ID,
+			Code: change.CancelCode,
+		})
+		require.Nil(t, resp)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Confirm", func(t *testing.T) {
+		resp, err := server.ConfirmEmailChange(context.Background(), &pb.ConfirmEmailChangeRequest{
+			Id:   change.ID,
+			Code: change.SecretCode,
+		})
+		require.NoError(t, err)
+		require.Equal(t, newEmail, resp.User.Email)
+		require.True(t, resp.User.IsEmailVerified)
+	})
+
+	t.Run("CancelReverts", func(t *testing.T) {
+		resp, err := server.CancelEmailChange(context.Background(), &pb.CancelEmailChangeRequest{
+			Id:   change.ID,
+			Code: change.CancelCode,
+		})
+		require.NoError(t, err)
+		require.Equal(t, user.Email, resp.Email)
+	})
+}
+
+func TestConfirmEmailChangeTaken(t *testing.T) {
+	server := newTestServer(t)
+	user := createTestUser(t)
+	other := createTestUser(t)
+
+	_, err := server.UpdateUser(authContext(t, user.Username), &pb.UpdateUserRequest{
+		Username: user.Username,
+		Email:    &other.Email,
+	})
+	require.NoError(t, err)
+
+	change, err := testStore.GetPendingEmailChange(context.Background(), user.Username)
+	require.NoError(t, err)
+
+	resp, err := server.ConfirmEmailChange(context.Background(), &pb.ConfirmEmailChangeRequest{
+		Id:   change.ID,
+		Code: change.SecretCode,
+	})
+	require.Nil(t, resp)
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+}
@@ -25,13 +25,9 @@ func (server *Server) ListEntries(ctx context.Context, req *pb.ListEntriesReques
 		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
 	}
 
-	rows, err := server.store.ListActivityEntries(ctx, db.ListActivityEntriesParams{
-		Owner:  authPayload.Username,
-		LimitArg: req.GetPageSize(),
-		OffsetArg: (req.GetPageId() - 1) * req.GetPageSize(),
-	})
+	rows, err := server.listActivityEntries(ctx, authPayload.Username, req.GetPageId(), req.GetPageSize())
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list activity entries: %v", err)
+		return nil, err
 	}
 
 	entries := make([]*pb.ActivityEntry, len(rows))
@@ -42,6 +38,20 @@ func (server *Server) ListEntries(ctx context.Context, req *pb.ListEntriesReques
 	return &pb.ListEntriesResponse{Entries: entries}, nil
 }
 
+// listActivityEntries returns one page of the entries of every account
+// username is an active member of.
+func (server *Server) listActivityEntries(ctx context.Context, username string, pageID, pageSize int32) ([]db.ListActivityEntriesRow, error) {
+	rows, err := server.store.ListActivityEntries(ctx, db.ListActivityEntriesParams{
+		Username:  username,
+		LimitArg:  pageSize,
+		OffsetArg: (pageID - 1) * pageSize,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list activity entries: %v", err)
+	}
+	return rows, nil
+}
+
 func validateListEntriesRequest(req *pb.ListEntriesRequest) (violations []*errdetails.BadRequest_FieldViolation) {
 	if err := val.ValidatePageID(req.GetPageId()); err != nil {
 		violations = append(violations, fieldViolation("page_id", err))
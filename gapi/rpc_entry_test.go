@@ -146,6 +146,7 @@ func createTestUser(t *testing.T) db.User {
 		HashedPassword: hashedPassword,
 		FullName:       util.RandomOwner(),
 		Email:          util.RandomEmail(),
+		Locale:         util.LocaleEnglish,
 	})
 	require.NoError(t, err)
 
@@ -159,6 +160,7 @@ func createTestAccount(t *testing.T, owner string, currency string, balance int6
 		Owner:    owner,
 		Balance:  balance,
 		Currency: currency,
+		Type:     db.AccountTypeChecking,
 	})
 	require.NoError(t, err)
 
@@ -168,7 +170,7 @@ func createTestAccount(t *testing.T, owner string, currency string, balance int6
 func authContext(t *testing.T, username string) context.Context {
 	t.Helper()
 
-	payload, err := token.NewPayload(username, time.Minute)
+	payload, err := token.NewPayload(username, token.TokenTypeAccess, time.Minute)
 	require.NoError(t, err)
 
 	return context.WithValue(context.Background(), authPayloadKey, payload)
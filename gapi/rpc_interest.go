@@ -0,0 +1,120 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
+	"github.com/a7medalyapany/GoBank.git/pb"
+	"github.com/a7medalyapany/GoBank.git/util"
+	"github.com/a7medalyapany/GoBank.git/val"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func convertInterestPosting(p db.InterestPosting, currency string) *pb.InterestPosting {
+	return &pb.InterestPosting{
+		Id:          p.ID,
+		Amount:      util.FromMinorUnits(p.Amount, currency),
+		PeriodStart: p.PeriodStart.Time.Format(time.DateOnly),
+		PeriodEnd:   p.PeriodEnd.Time.Format(time.DateOnly),
+		TransferId:  p.TransferID.Int64,
+		CreatedAt:   timestamppb.New(p.CreatedAt.Time),
+	}
+}
+
+// GetAccountInterest
+func (server *Server) GetAccountInterest(ctx context.Context, req *pb.GetAccountInterestRequest) (*pb.GetAccountInterestResponse, error) {
+	if violations := validateGetAccountInterestRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	interest, err := server.getAccountInterest(ctx, req.GetAccountId(), req.GetPageId(), req.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+
+	postings := make([]*pb.InterestPosting, len(interest.postings))
+	for i, p := range interest.postings {
+		postings[i] = convertInterestPosting(p, interest.account.Currency)
+	}
+
+	return &pb.GetAccountInterestResponse{
+		AnnualRate:      float64(interest.rateBPS) / 100,
+		PendingInterest: util.FromMicros(interest.pendingMicros, interest.account.Currency),
+		Postings:        postings,
+	}, nil
+}
+
+// accountInterest is what GetAccountInterest reports about an account.
+type accountInterest struct {
+	account db.Account
+	// rateBPS is the annual rate in basis points.
+	rateBPS int64
+	// pendingMicros is the interest not posted yet, in millionths of a minor unit.
+	pendingMicros int64
+	postings      []db.InterestPosting
+}
+
+// getAccountInterest authorizes the caller to view accountID and loads its
+// interest. It is shared by the v1 and v2 GetAccountInterest RPCs.
+func (server *Server) getAccountInterest(ctx context.Context, accountID int64, pageID, pageSize int32) (accountInterest, error) {
+	account, err := server.authorizeAccount(ctx, accountID, accountView)
+	if err != nil {
+		return accountInterest{}, err
+	}
+
+	interest := accountInterest{account: account}
+	if account.Type == db.AccountTypeSavings {
+		interest.rateBPS = util.InterestRate(account.Currency)
+	}
+
+	// Pending interest is every accrual not posted yet plus the fraction of
+	// a minor unit the last posting carried over.
+	unposted, err := server.store.GetUnpostedInterest(ctx, db.GetUnpostedInterestParams{
+		AccountID: account.ID,
+		Before:    pgtype.Date{InfinityModifier: pgtype.Infinity, Valid: true},
+	})
+	if err != nil {
+		return accountInterest{}, status.Errorf(codes.Internal, "failed to get unposted interest: %v", err)
+	}
+
+	last, err := server.store.GetLastInterestPosting(ctx, account.ID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return accountInterest{}, status.Errorf(codes.Internal, "failed to get last interest posting: %v", err)
+	}
+	interest.pendingMicros = unposted.AmountMicros + last.CarryMicros
+
+	interest.postings, err = server.store.ListInterestPostings(ctx, db.ListInterestPostingsParams{
+		AccountID: account.ID,
+		LimitArg:  pageSize,
+		OffsetArg: (pageID - 1) * pageSize,
+	})
+	if err != nil {
+		return accountInterest{}, status.Errorf(codes.Internal, "failed to list interest postings: %v", err)
+	}
+
+	return interest, nil
+}
+
+func validateGetAccountInterestRequest(req *pb.GetAccountInterestRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	return validateAccountInterestPage(req.GetAccountId(), req.GetPageId(), req.GetPageSize())
+}
+
+func validateAccountInterestPage(accountID int64, pageID, pageSize int32) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(accountID); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+	if err := val.ValidatePageID(pageID); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+	if err := val.ValidatePageSize(pageSize); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+	return
+}
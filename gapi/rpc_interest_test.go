@@ -0,0 +1,102 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
+	"github.com/a7medalyapany/GoBank.git/pb"
+	pbv2 "github.com/a7medalyapany/GoBank.git/pb/v2"
+	"github.com/a7medalyapany/GoBank.git/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetAccountInterest(t *testing.T) {
+	server := newTestServer(t)
+
+	rates, err := util.NewInterestRates("USD:2.5")
+	require.NoError(t, err)
+	util.SetInterestRates(rates)
+	t.Cleanup(func() {
+		empty, _ := util.NewInterestRates("")
+		util.SetInterestRates(empty)
+	})
+
+	owner := createTestUser(t)
+	viewer := createTestUser(t)
+	stranger := createTestUser(t)
+
+	created, err := server.CreateAccount(authContext(t, owner.Username), &pb.CreateAccountRequest{
+		Currency: "USD",
+		Type:     db.AccountTypeSavings,
+	})
+	require.NoError(t, err)
+	account, err := testStore.GetAccount(context.Background(), created.Account.Id)
+	require.NoError(t, err)
+	createTestAccountMember(t, server, account, viewer.Username, db.AccountRoleViewer, 0)
+
+	for _, day := range []string{"2026-09-01", "2026-09-02", "2026-10-01"} {
+		date, err := time.Parse(time.DateOnly, day)
+		require.NoError(t, err)
+		_, err = testStore.CreateInterestAccrual(context.Background(), db.CreateInterestAccrualParams{
+			AccountID:     account.ID,
+			AccrualDate:   pgtype.Date{Time: date, Valid: true},
+			Balance:       100_000,
+			AnnualRateBps: 250,
+			AmountMicros:  6_849_315,
+		})
+		require.NoError(t, err)
+	}
+
+	october, err := time.Parse(time.DateOnly, "2026-10-01")
+	require.NoError(t, err)
+	posted, err := testStore.PostInterestTx(context.Background(), db.PostInterestTxParams{
+		AccountID: account.ID,
+		Before:    pgtype.Date{Time: october, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(13), posted.Posting.Amount)
+
+	_, err = server.GetAccountInterest(authContext(t, stranger.Username), &pb.GetAccountInterestRequest{AccountId: account.ID, PageId: 1, PageSize: 10})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = server.GetAccountInterest(authContext(t, viewer.Username), &pb.GetAccountInterestRequest{AccountId: account.ID})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	resp, err := server.GetAccountInterest(authContext(t, viewer.Username), &pb.GetAccountInterestRequest{AccountId: account.ID, PageId: 1, PageSize: 10})
+	require.NoError(t, err)
+	require.Equal(t, 2.5, resp.AnnualRate)
+	// October's accrual plus September's carry of 0.698630 cents.
+	require.InDelta(t, 0.07547945, resp.PendingInterest, 1e-9)
+
+	require.Len(t, resp.Postings, 1)
+	require.Equal(t, 0.13, resp.Postings[0].Amount)
+	require.Equal(t, "2026-09-01", resp.Postings[0].PeriodStart)
+	require.Equal(t, "2026-09-02", resp.Postings[0].PeriodEnd)
+	require.Equal(t, posted.Transfer.Transfer.ID, resp.Postings[0].TransferId)
+
+	// v2 reports the same values exactly.
+	respV2, err := NewServerV2(server).GetAccountInterest(authContext(t, viewer.Username), &pbv2.GetAccountInterestRequest{AccountId: account.ID, PageId: 1, PageSize: 10})
+	require.NoError(t, err)
+	require.Equal(t, "2.50", respV2.AnnualRate)
+	require.Equal(t, int64(7_547_945), respV2.PendingInterestMicros)
+	require.Equal(t, &pbv2.Money{Currency: "USD", Units: 7, Decimal: "0.07"}, respV2.PendingInterest)
+	require.Len(t, respV2.Postings, 1)
+	require.Equal(t, &pbv2.Money{Currency: "USD", Units: 13, Decimal: "0.13"}, respV2.Postings[0].Amount)
+	require.Equal(t, resp.Postings[0].Id, respV2.Postings[0].Id)
+
+	_, err = NewServerV2(server).GetAccountInterest(authContext(t, stranger.Username), &pbv2.GetAccountInterestRequest{AccountId: account.ID, PageId: 1, PageSize: 10})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Checking accounts earn nothing.
+	checking := createTestAccount(t, owner.Username, "USD", 100_000)
+	resp, err = server.GetAccountInterest(authContext(t, owner.Username), &pb.GetAccountInterestRequest{AccountId: checking.ID, PageId: 1, PageSize: 10})
+	require.NoError(t, err)
+	require.Zero(t, resp.AnnualRate)
+	require.Zero(t, resp.PendingInterest)
+	require.Empty(t, resp.Postings)
+}
@@ -0,0 +1,80 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/a7medalyapany/GoBank.git/logger"
+	"github.com/a7medalyapany/GoBank.git/pb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxDebugLogTokenTTL bounds how long a debug log token stays valid, since
+// anyone holding it can make the server log verbosely.
+const maxDebugLogTokenTTL = time.Hour
+
+// GetLogLevel
+func (server *Server) GetLogLevel(ctx context.Context, req *pb.GetLogLevelRequest) (*pb.GetLogLevelResponse, error) {
+	if err := server.authorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	return &pb.GetLogLevelResponse{Level: logger.GlobalLevel().String()}, nil
+}
+
+// SetLogLevel changes the level of the global logger in this process only.
+func (server *Server) SetLogLevel(ctx context.Context, req *pb.SetLogLevelRequest) (*pb.SetLogLevelResponse, error) {
+	level, err := logger.ParseLevel(req.GetLevel())
+	if err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{
+			fieldViolation("level", fmt.Errorf("must be one of debug, info, warn, error, fatal")),
+		})
+	}
+
+	if err := server.authorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	previous := logger.GlobalLevel()
+	logger.SetGlobalLevel(level)
+
+	return &pb.SetLogLevelResponse{
+		Level:         level.String(),
+		PreviousLevel: previous.String(),
+	}, nil
+}
+
+// CreateDebugLogToken signs a logger.DebugHeader value with LOG_DEBUG_SECRET.
+func (server *Server) CreateDebugLogToken(ctx context.Context, req *pb.CreateDebugLogTokenRequest) (*pb.CreateDebugLogTokenResponse, error) {
+	if violations := validateCreateDebugLogTokenRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	if err := server.authorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if server.config.LOG_DEBUG_SECRET == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "debug log tokens are not configured")
+	}
+
+	expiresAt := time.Now().Add(req.GetTtl().AsDuration())
+
+	return &pb.CreateDebugLogTokenResponse{
+		Header:    logger.DebugHeader,
+		Token:     logger.SignDebugToken([]byte(server.config.LOG_DEBUG_SECRET), expiresAt),
+		ExpiresAt: timestamppb.New(expiresAt),
+	}, nil
+}
+
+func validateCreateDebugLogTokenRequest(req *pb.CreateDebugLogTokenRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	ttl := req.GetTtl()
+	if ttl == nil || ttl.CheckValid() != nil || ttl.AsDuration() <= 0 || ttl.AsDuration() > maxDebugLogTokenTTL {
+		violations = append(violations, fieldViolation("ttl", fmt.Errorf("must be positive and at most 1h")))
+	}
+	return
+}
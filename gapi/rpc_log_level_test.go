@@ -0,0 +1,88 @@
+package gapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/a7medalyapany/GoBank.git/logger"
+	"github.com/a7medalyapany/GoBank.git/pb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestSetLogLevel(t *testing.T) {
+	server := newTestServer(t)
+	admin := createTestAdmin(t)
+
+	original := logger.GlobalLevel()
+	t.Cleanup(func() { logger.SetGlobalLevel(original) })
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		resp, err := server.SetLogLevel(authContext(t, createTestUser(t).Username), &pb.SetLogLevelRequest{Level: "debug"})
+		require.Nil(t, resp)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		require.Equal(t, original, logger.GlobalLevel())
+	})
+
+	t.Run("InvalidLevel", func(t *testing.T) {
+		resp, err := server.SetLogLevel(authContext(t, admin.Username), &pb.SetLogLevelRequest{Level: "verbose"})
+		require.Nil(t, resp)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("OK", func(t *testing.T) {
+		logger.SetGlobalLevel(logger.InfoLevel)
+
+		resp, err := server.SetLogLevel(authContext(t, admin.Username), &pb.SetLogLevelRequest{Level: "debug"})
+		require.NoError(t, err)
+		require.Equal(t, "debug", resp.Level)
+		require.Equal(t, "info", resp.PreviousLevel)
+
+		got, err := server.GetLogLevel(authContext(t, admin.Username), &pb.GetLogLevelRequest{})
+		require.NoError(t, err)
+		require.Equal(t, "debug", got.Level)
+	})
+}
+
+func TestCreateDebugLogToken(t *testing.T) {
+	server := newTestServer(t)
+	admin := createTestAdmin(t)
+
+	t.Run("NotConfigured", func(t *testing.T) {
+		resp, err := server.CreateDebugLogToken(authContext(t, admin.Username), &pb.CreateDebugLogTokenRequest{
+			Ttl: durationpb.New(time.Minute),
+		})
+		require.Nil(t, resp)
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	server.config.LOG_DEBUG_SECRET = "debug-secret"
+
+	t.Run("InvalidTTL", func(t *testing.T) {
+		resp, err := server.CreateDebugLogToken(authContext(t, admin.Username), &pb.CreateDebugLogTokenRequest{
+			Ttl: durationpb.New(2 * time.Hour),
+		})
+		require.Nil(t, resp)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		resp, err := server.CreateDebugLogToken(authContext(t, createTestUser(t).Username), &pb.CreateDebugLogTokenRequest{
+			Ttl: durationpb.New(time.Minute),
+		})
+		require.Nil(t, resp)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("OK", func(t *testing.T) {
+		resp, err := server.CreateDebugLogToken(authContext(t, admin.Username), &pb.CreateDebugLogTokenRequest{
+			Ttl: durationpb.New(time.Minute),
+		})
+		require.NoError(t, err)
+		require.Equal(t, logger.DebugHeader, resp.Header)
+		require.WithinDuration(t, time.Now().Add(time.Minute), resp.ExpiresAt.AsTime(), time.Second)
+		require.True(t, logger.VerifyDebugToken([]byte("debug-secret"), resp.Token, time.Now()))
+	})
+}
@@ -3,9 +3,12 @@ package gapi
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
+	"github.com/a7medalyapany/GoBank.git/metrics"
 	"github.com/a7medalyapany/GoBank.git/pb"
+	"github.com/a7medalyapany/GoBank.git/token"
 	"github.com/a7medalyapany/GoBank.git/util"
 	"github.com/a7medalyapany/GoBank.git/val"
 	"github.com/jackc/pgx/v5"
@@ -24,39 +27,70 @@ func (server *Server) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (
 	user, err := server.store.GetUser(ctx, req.GetUsername())
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
+			metrics.ObserveLoginFailure(metrics.LoginFailureUnknownUser)
 			return nil, status.Errorf(codes.NotFound, "user not found")
 		}
 		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
 	}
 
 	if err = util.CheckPassword(req.GetPassword(), user.HashedPassword); err != nil {
+		metrics.ObserveLoginFailure(metrics.LoginFailureWrongPassword)
+
+		audit := server.newAuditEntry(ctx, user.Username, db.AuditUserLoginFailed)
+		audit.TargetType = db.AuditTargetUser
+		audit.TargetID = user.Username
+		if _, err := server.store.RecordAuditEvent(ctx, audit); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to record audit event: %v", err)
+		}
+
 		return nil, status.Errorf(codes.Unauthenticated, "invalid password")
 	}
 
-	accessToken, accessPayload, err := server.tokenMaker.CreateToken(user.Username, server.config.ACCESS_TOKEN_DURATION)
+	var opts []token.PayloadOption
+	if len(req.GetScopes()) > 0 {
+		opts = append(opts, token.WithScopes(req.GetScopes()...))
+	}
+
+	accessToken, accessPayload, err := server.tokenMaker.CreateToken(user.Username, token.TokenTypeAccess, server.config.ACCESS_TOKEN_DURATION, opts...)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create access token: %v", err)
 	}
 
-	refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(user.Username, server.config.REFRESH_TOKEN_DURATION)
+	refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(user.Username, token.TokenTypeRefresh, server.config.REFRESH_TOKEN_DURATION, opts...)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create refresh token: %v", err)
 	}
 
 	mt := server.extractMetadata(ctx)
-	session, err := server.store.CreateSession(ctx, db.CreateSessionParams{
-		ID:           pgtype.UUID{Bytes: refreshPayload.ID, Valid: true},
-		Username:     user.Username,
-		RefreshToken: refreshToken,
-		UserAgent:    mt.UserAgent,
-		ClientIp:     mt.ClientIp,
-		IsBlocked:    false,
-		ExpiresAt:    pgtype.Timestamptz{Time: refreshPayload.ExpiredAt, Valid: true},
+	var session db.Session
+	err = server.store.OutboxTx(ctx, func(q *db.Queries) ([]db.CreateOutboxMessageParams, error) {
+		var err error
+		session, err = q.CreateSession(ctx, db.CreateSessionParams{
+			ID:           pgtype.UUID{Bytes: refreshPayload.ID, Valid: true},
+			Username:     user.Username,
+			RefreshToken: refreshToken,
+			UserAgent:    mt.UserAgent,
+			ClientIp:     mt.ClientIp,
+			IsBlocked:    false,
+			ExpiresAt:    pgtype.Timestamptz{Time: refreshPayload.ExpiredAt, Valid: true},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		audit := server.newAuditEntry(ctx, user.Username, db.AuditUserLogin)
+		audit.TargetType = db.AuditTargetSession
+		audit.TargetID = session.ID.String()
+		audit.After = map[string]any{"scopes": accessPayload.Scopes}
+		_, err = q.RecordAuditEvent(ctx, audit)
+		return nil, err
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create session: %v", err)
 	}
 
+	metrics.ObserveLogin()
+
 	return &pb.LoginUserResponse{
 		SessionId:             session.ID.String(),
 		AccessToken:           accessToken,
@@ -74,5 +108,10 @@ func validateLoginUserRequest(req *pb.LoginUserRequest) (violations []*errdetail
 	if err := val.ValidatePassword(req.GetPassword()); err != nil {
 		violations = append(violations, fieldViolation("password", err))
 	}
+	for _, scope := range req.GetScopes() {
+		if !token.IsKnownScope(scope) {
+			violations = append(violations, fieldViolation("scopes", fmt.Errorf("unknown scope: %s", scope)))
+		}
+	}
 	return
 }
\ No newline at end of file
@@ -0,0 +1,153 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
+	"github.com/a7medalyapany/GoBank.git/pb"
+	"github.com/a7medalyapany/GoBank.git/token"
+	"github.com/a7medalyapany/GoBank.git/val"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func convertNotification(n db.Notification) *pb.Notification {
+	notification := &pb.Notification{
+		Id:        n.ID,
+		Type:      n.Type,
+		Title:     n.Title,
+		Body:      n.Body,
+		CreatedAt: timestamppb.New(n.CreatedAt.Time),
+	}
+	if n.TransferID.Valid {
+		notification.TransferId = wrapperspb.Int64(n.TransferID.Int64)
+	}
+	if n.ReadAt.Valid {
+		notification.ReadAt = timestamppb.New(n.ReadAt.Time)
+	}
+	return notification
+}
+
+func convertNotificationPreferences(p db.NotificationPreference) *pb.NotificationPreferences {
+	return &pb.NotificationPreferences{
+		TransferEmail: p.TransferEmail,
+		TransferInApp: p.TransferInApp,
+	}
+}
+
+// ListNotifications
+func (server *Server) ListNotifications(ctx context.Context, req *pb.ListNotificationsRequest) (*pb.ListNotificationsResponse, error) {
+	if violations := validateListNotificationsRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	authPayload, ok := ctx.Value(authPayloadKey).(*token.Payload)
+	if !ok || authPayload == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+
+	notifications, err := server.store.ListNotifications(ctx, db.ListNotificationsParams{
+		Username:   authPayload.Username,
+		UnreadOnly: req.GetUnreadOnly(),
+		LimitArg:   req.GetPageSize(),
+		OffsetArg:  (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list notifications: %v", err)
+	}
+
+	pbNotifications := make([]*pb.Notification, len(notifications))
+	for i, n := range notifications {
+		pbNotifications[i] = convertNotification(n)
+	}
+
+	return &pb.ListNotificationsResponse{Notifications: pbNotifications}, nil
+}
+
+func validateListNotificationsRequest(req *pb.ListNotificationsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+	return
+}
+
+// MarkNotificationRead
+func (server *Server) MarkNotificationRead(ctx context.Context, req *pb.MarkNotificationReadRequest) (*pb.MarkNotificationReadResponse, error) {
+	if violations := validateMarkNotificationReadRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	authPayload, ok := ctx.Value(authPayloadKey).(*token.Payload)
+	if !ok || authPayload == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+
+	notification, err := server.store.MarkNotificationRead(ctx, db.MarkNotificationReadParams{
+		ID:       req.GetId(),
+		Username: authPayload.Username,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "notification not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to mark notification read: %v", err)
+	}
+
+	return &pb.MarkNotificationReadResponse{Notification: convertNotification(notification)}, nil
+}
+
+func validateMarkNotificationReadRequest(req *pb.MarkNotificationReadRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+	return
+}
+
+// GetNotificationPreferences
+func (server *Server) GetNotificationPreferences(ctx context.Context, req *pb.GetNotificationPreferencesRequest) (*pb.GetNotificationPreferencesResponse, error) {
+	authPayload, ok := ctx.Value(authPayloadKey).(*token.Payload)
+	if !ok || authPayload == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+
+	prefs, err := server.store.GetNotificationPreferencesOrDefault(ctx, authPayload.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get notification preferences: %v", err)
+	}
+
+	return &pb.GetNotificationPreferencesResponse{Preferences: convertNotificationPreferences(prefs)}, nil
+}
+
+// UpdateNotificationPreferences
+func (server *Server) UpdateNotificationPreferences(ctx context.Context, req *pb.UpdateNotificationPreferencesRequest) (*pb.UpdateNotificationPreferencesResponse, error) {
+	authPayload, ok := ctx.Value(authPayloadKey).(*token.Payload)
+	if !ok || authPayload == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+
+	arg := db.UpsertNotificationPreferencesParams{
+		Username: authPayload.Username,
+	}
+	if req.TransferEmail != nil {
+		arg.TransferEmail = pgtype.Bool{Bool: req.GetTransferEmail(), Valid: true}
+	}
+	if req.TransferInApp != nil {
+		arg.TransferInApp = pgtype.Bool{Bool: req.GetTransferInApp(), Valid: true}
+	}
+
+	prefs, err := server.store.UpsertNotificationPreferences(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update notification preferences: %v", err)
+	}
+
+	return &pb.UpdateNotificationPreferencesResponse{Preferences: convertNotificationPreferences(prefs)}, nil
+}
@@ -0,0 +1,97 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+
+	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
+	"github.com/a7medalyapany/GoBank.git/pb"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestListNotifications(t *testing.T) {
+	server := newTestServer(t)
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		resp, err := server.ListNotifications(context.Background(), &pb.ListNotificationsRequest{
+			PageId:   1,
+			PageSize: 5,
+		})
+		require.Nil(t, resp)
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("ListsAuthenticatedUserNotificationsOnly", func(t *testing.T) {
+		fixture := createActivityFixture(t)
+		notification := createTestNotification(t, fixture.user.Username, fixture.transferResult.Transfer.ID)
+		createTestNotification(t, fixture.counterparty.Username, fixture.transferResult.Transfer.ID)
+
+		resp, err := server.ListNotifications(authContext(t, fixture.user.Username), &pb.ListNotificationsRequest{
+			PageId:   1,
+			PageSize: 5,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Notifications, 1)
+		require.Equal(t, notification.ID, resp.Notifications[0].Id)
+		require.Equal(t, fixture.transferResult.Transfer.ID, resp.Notifications[0].TransferId.GetValue())
+		require.Nil(t, resp.Notifications[0].ReadAt)
+	})
+}
+
+func TestMarkNotificationRead(t *testing.T) {
+	server := newTestServer(t)
+	fixture := createActivityFixture(t)
+	notification := createTestNotification(t, fixture.user.Username, fixture.transferResult.Transfer.ID)
+
+	t.Run("OtherUser", func(t *testing.T) {
+		resp, err := server.MarkNotificationRead(authContext(t, fixture.outsider.Username), &pb.MarkNotificationReadRequest{
+			Id: notification.ID,
+		})
+		require.Nil(t, resp)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("OK", func(t *testing.T) {
+		resp, err := server.MarkNotificationRead(authContext(t, fixture.user.Username), &pb.MarkNotificationReadRequest{
+			Id: notification.ID,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, resp.Notification.ReadAt)
+	})
+}
+
+func TestNotificationPreferences(t *testing.T) {
+	server := newTestServer(t)
+	ctx := authContext(t, createTestUser(t).Username)
+
+	got, err := server.GetNotificationPreferences(ctx, &pb.GetNotificationPreferencesRequest{})
+	require.NoError(t, err)
+	require.True(t, got.Preferences.TransferEmail)
+	require.True(t, got.Preferences.TransferInApp)
+
+	updated, err := server.UpdateNotificationPreferences(ctx, &pb.UpdateNotificationPreferencesRequest{
+		TransferEmail: proto.Bool(false),
+	})
+	require.NoError(t, err)
+	require.False(t, updated.Preferences.TransferEmail)
+	require.True(t, updated.Preferences.TransferInApp)
+}
+
+func createTestNotification(t *testing.T, username string, transferID int64) db.Notification {
+	t.Helper()
+
+	notification, err := testStore.CreateNotification(context.Background(), db.CreateNotificationParams{
+		Username:   username,
+		Type:       "transfer_received",
+		Title:      "You received a transfer",
+		Body:       "You received money.",
+		TransferID: pgtype.Int8{Int64: transferID, Valid: true},
+	})
+	require.NoError(t, err)
+
+	return notification
+}
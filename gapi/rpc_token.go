@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/a7medalyapany/GoBank.git/pb"
+	"github.com/a7medalyapany/GoBank.git/token"
 	"github.com/a7medalyapany/GoBank.git/val"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -20,7 +21,7 @@ func (server *Server) RenewAccessToken(ctx context.Context, req *pb.RenewAccessT
 		return nil, invalidArgumentError(violations)
 	}
 
-	refreshPayload, err := server.tokenMaker.VerifyToken(req.GetRefreshToken())
+	refreshPayload, err := server.tokenMaker.VerifyToken(req.GetRefreshToken(), token.TokenTypeRefresh)
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "invalid refresh token: %v", err)
 	}
@@ -46,7 +47,13 @@ func (server *Server) RenewAccessToken(ctx context.Context, req *pb.RenewAccessT
 		return nil, status.Errorf(codes.Unauthenticated, "session expired")
 	}
 
-	accessToken, accessPayload, err := server.tokenMaker.CreateToken(refreshPayload.Username, server.config.ACCESS_TOKEN_DURATION)
+	accessToken, accessPayload, err := server.tokenMaker.CreateToken(
+		refreshPayload.Username,
+		token.TokenTypeAccess,
+		server.config.ACCESS_TOKEN_DURATION,
+		token.WithScopes(refreshPayload.Scopes...),
+		token.WithAudience(refreshPayload.Audience),
+	)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create access token: %v", err)
 	}
@@ -58,7 +65,7 @@ func (server *Server) RenewAccessToken(ctx context.Context, req *pb.RenewAccessT
 }
 
 func validateRenewAccessTokenRequest(req *pb.RenewAccessTokenRequest) (violations []*errdetails.BadRequest_FieldViolation) {
-	if err := val.ValidateString(req.GetRefreshToken(), 1, 512); err != nil {
+	if err := val.ValidateString(req.GetRefreshToken(), 1, 1024); err != nil {
 		violations = append(violations, fieldViolation("refresh_token", errors.New("must not be empty")))
 	}
 	return
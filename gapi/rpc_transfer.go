@@ -5,10 +5,14 @@ import (
 	"errors"
 
 	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
+	"github.com/a7medalyapany/GoBank.git/metrics"
 	"github.com/a7medalyapany/GoBank.git/pb"
 	"github.com/a7medalyapany/GoBank.git/token"
 	"github.com/a7medalyapany/GoBank.git/util"
 	"github.com/a7medalyapany/GoBank.git/val"
+	"github.com/a7medalyapany/GoBank.git/webhook"
+	"github.com/a7medalyapany/GoBank.git/worker"
+	"github.com/hibiken/asynq"
 	"github.com/jackc/pgx/v5"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
@@ -26,53 +30,162 @@ func (server *Server) CreateTransfer(ctx context.Context, req *pb.CreateTransfer
 		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
 	}
 
-	fromAccount, err := server.validateTransferAccount(ctx, req.GetFromAccountId(), req.GetCurrency())
+	toAccountID, err := server.transferDestination(ctx, req.GetFromAccountId(), req.GetToAccountId(), req.GetToAccountNumber())
 	if err != nil {
 		return nil, err
 	}
 
-	if fromAccount.Owner != authPayload.Username {
-		return nil, status.Errorf(codes.PermissionDenied, "from_account doesn't belong to the authenticated user")
+	amount := util.ToMinorUnits(req.GetAmount(), req.GetCurrency())
+	result, err := server.createTransfer(ctx, authPayload.Username, req.GetFromAccountId(), toAccountID, amount, req.GetCurrency())
+	if err != nil {
+		return nil, err
 	}
 
-	if _, err = server.validateTransferAccount(ctx, req.GetToAccountId(), req.GetCurrency()); err != nil {
-		return nil, err
+	return convertTransferResult(result), nil
+}
+
+// transferDestination returns the ID of the account to credit, looking it up
+// by account number when the request gives one instead of toAccountID.
+func (server *Server) transferDestination(ctx context.Context, fromAccountID, toAccountID int64, toAccountNumber string) (int64, error) {
+	number := util.NormalizeAccountNumber(toAccountNumber)
+	if number == "" {
+		return toAccountID, nil
+	}
+
+	account, err := server.store.GetAccountByNumber(ctx, number)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, status.Errorf(codes.NotFound, "account %s not found", number)
+		}
+		return 0, status.Errorf(codes.Internal, "failed to get account %s: %v", number, err)
+	}
+
+	if account.ID == fromAccountID {
+		return 0, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{
+			fieldViolation("to_account_number", errors.New("cannot transfer to the same account")),
+		})
+	}
+	return account.ID, nil
+}
+
+// createTransfer moves amount, in minor units of currency, from an account
+// username may spend from to another account. It is shared by the v1 and v2
+// CreateTransfer RPCs.
+func (server *Server) createTransfer(ctx context.Context, username string, fromAccountID, toAccountID, amount int64, currency string) (db.TransferTxResult, error) {
+	fromAccount, err := server.validateTransferAccount(ctx, fromAccountID, currency)
+	if err != nil {
+		return db.TransferTxResult{}, err
 	}
 
-	amountCents := util.FloatToCents(req.GetAmount())
-	if fromAccount.Balance < amountCents {
-		return nil, status.Errorf(codes.FailedPrecondition,
+	member, err := server.authorizeMember(ctx, fromAccountID, username, accountSpend)
+	if err != nil {
+		return db.TransferTxResult{}, err
+	}
+
+	if _, err = server.validateTransferAccount(ctx, toAccountID, currency); err != nil {
+		return db.TransferTxResult{}, err
+	}
+
+	if fromAccount.Balance < amount {
+		return db.TransferTxResult{}, status.Errorf(codes.FailedPrecondition,
 			"insufficient balance: account %d has %s but transfer requires %s",
-			req.GetFromAccountId(),
+			fromAccountID,
 			util.FormatMoney(fromAccount.Balance, fromAccount.Currency),
-			util.FormatMoney(amountCents, req.GetCurrency()),
+			util.FormatMoney(amount, currency),
 		)
 	}
 
+	// A transfer over the limit on its own can be refused up front; TransferTx
+	// checks it against everything else sent in the window.
+	if member.SpendLimit.Valid && amount > member.SpendLimit.Int64 {
+		return db.TransferTxResult{}, spendLimitError(amount, member.SpendLimit.Int64, currency, fromAccountID)
+	}
+
+	audit := server.newAuditEntry(ctx, username, db.AuditTransferCreated)
 	result, err := server.store.TransferTx(ctx, db.TransferTxParams{
-		FromAccountID: req.GetFromAccountId(),
-		ToAccountID:   req.GetToAccountId(),
-		Amount:        amountCents,
+		FromAccountID: fromAccountID,
+		ToAccountID:   toAccountID,
+		Amount:        amount,
+		InitiatedBy:   username,
+		SpendLimit:    member.SpendLimit,
+		Audit:         &audit,
+		AfterTransfer: func(result db.TransferTxResult) ([]db.CreateOutboxMessageParams, error) {
+			data := webhook.TransferData{
+				ID:            result.Transfer.ID,
+				FromAccountID: result.Transfer.FromAccountID,
+				ToAccountID:   result.Transfer.ToAccountID,
+				Amount:        result.Transfer.Amount,
+				Currency:      result.FromAccount.Currency,
+				CreatedAt:     result.Transfer.CreatedAt.Time,
+			}
+
+			// Every member of each account hears about the transfer, not
+			// just its owner.
+			messages := make([]db.CreateOutboxMessageParams, 0, 2*(len(result.FromMembers)+len(result.ToMembers)))
+			for _, side := range []struct {
+				members   []string
+				direction string
+				eventType string
+			}{
+				{result.FromMembers, worker.TransferDirectionSent, webhook.EventTransferSent},
+				{result.ToMembers, worker.TransferDirectionReceived, webhook.EventTransferReceived},
+			} {
+				for _, username := range side.members {
+					message, err := worker.NewOutboxMessage(worker.TaskSendTransferNotification, &worker.PayloadSendTransferNotification{
+						TransferID: result.Transfer.ID,
+						Direction:  side.direction,
+						Username:   username,
+					}, asynq.MaxRetry(5), asynq.Queue(worker.QueueDefault))
+					if err != nil {
+						return nil, err
+					}
+					messages = append(messages, message)
+
+					message, err = worker.NewWebhookEventMessage(username, side.eventType, data)
+					if err != nil {
+						return nil, err
+					}
+					messages = append(messages, message)
+				}
+			}
+			return messages, nil
+		},
 	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "transfer transaction failed: %v", err)
+		if errors.Is(err, db.ErrSpendLimitExceeded) {
+			return db.TransferTxResult{}, spendLimitError(amount, member.SpendLimit.Int64, currency, fromAccountID)
+		}
+		return db.TransferTxResult{}, status.Errorf(codes.Internal, "transfer transaction failed: %v", err)
 	}
 
-	return convertTransferResult(result), nil
+	metrics.ObserveTransfer(result.FromAccount.Currency, result.Transfer.Amount)
+
+	return result, nil
+}
+
+func spendLimitError(amount, limit int64, currency string, accountID int64) error {
+	return status.Errorf(codes.PermissionDenied,
+		"transfer of %s would take you over your spend limit of %s in 24 hours on account %d",
+		util.FormatMoney(amount, currency),
+		util.FormatMoney(limit, currency),
+		accountID,
+	)
 }
 
 func validateCreateTransferRequest(req *pb.CreateTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
 	if err := val.ValidateID(req.GetFromAccountId()); err != nil {
 		violations = append(violations, fieldViolation("from_account_id", err))
 	}
-	if err := val.ValidateID(req.GetToAccountId()); err != nil {
-		violations = append(violations, fieldViolation("to_account_id", err))
+	if violation := validateAccountIDOrNumber("to_account_id", req.GetToAccountId(), "to_account_number", req.GetToAccountNumber()); violation != nil {
+		violations = append(violations, violation)
 	}
 	if err := val.ValidateAmount(req.GetAmount()); err != nil {
 		violations = append(violations, fieldViolation("amount", err))
 	}
 	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
 		violations = append(violations, fieldViolation("currency", err))
+	} else if err := val.ValidateMinorUnits(req.GetAmount(), req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
 	}
 	// Edge case: transferring to yourself isn't caught by DB constraints
 	if req.GetFromAccountId() > 0 && req.GetFromAccountId() == req.GetToAccountId() {
@@ -106,31 +219,31 @@ func convertTransferResult(r db.TransferTxResult) *pb.CreateTransferResponse {
 			Id:            r.Transfer.ID,
 			FromAccountId: r.Transfer.FromAccountID,
 			ToAccountId:   r.Transfer.ToAccountID,
-			Amount:        util.CentsToFloat(r.Transfer.Amount),
+			Amount:        util.FromMinorUnits(r.Transfer.Amount, r.FromAccount.Currency),
 			CreatedAt:     timestamppb.New(r.Transfer.CreatedAt.Time),
 		},
 		FromEntry: &pb.TransferEntry{
 			Id:        r.FromEntry.ID,
 			AccountId: r.FromEntry.AccountID,
-			Amount:    util.CentsToFloat(r.FromEntry.Amount),
+			Amount:    util.FromMinorUnits(r.FromEntry.Amount, r.FromAccount.Currency),
 			CreatedAt: timestamppb.New(r.FromEntry.CreatedAt.Time),
 		},
 		ToEntry: &pb.TransferEntry{
 			Id:        r.ToEntry.ID,
 			AccountId: r.ToEntry.AccountID,
-			Amount:    util.CentsToFloat(r.ToEntry.Amount),
+			Amount:    util.FromMinorUnits(r.ToEntry.Amount, r.ToAccount.Currency),
 			CreatedAt: timestamppb.New(r.ToEntry.CreatedAt.Time),
 		},
 		FromAccount: &pb.CreateTransferResponse_AccountSnapshot{
 			Id:       r.FromAccount.ID,
 			Owner:    r.FromAccount.Owner,
-			Balance:  util.CentsToFloat(r.FromAccount.Balance),
+			Balance:  util.FromMinorUnits(r.FromAccount.Balance, r.FromAccount.Currency),
 			Currency: r.FromAccount.Currency,
 		},
 		ToAccount: &pb.CreateTransferResponse_AccountSnapshot{
 			Id:       r.ToAccount.ID,
 			Owner:    r.ToAccount.Owner,
-			Balance:  util.CentsToFloat(r.ToAccount.Balance),
+			Balance:  util.FromMinorUnits(r.ToAccount.Balance, r.ToAccount.Currency),
 			Currency: r.ToAccount.Currency,
 		},
 	}
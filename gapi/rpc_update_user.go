@@ -10,8 +10,8 @@ import (
 	"github.com/a7medalyapany/GoBank.git/token"
 	"github.com/a7medalyapany/GoBank.git/util"
 	"github.com/a7medalyapany/GoBank.git/val"
+	"github.com/a7medalyapany/GoBank.git/worker"
 	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
@@ -41,8 +41,8 @@ func (server *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 		arg.FullName = pgtype.Text{String: req.GetFullName(), Valid: true}
 	}
 
-	if req.Email != nil {
-		arg.Email = pgtype.Text{String: req.GetEmail(), Valid: true}
+	if req.Locale != nil {
+		arg.Locale = pgtype.Text{String: req.GetLocale(), Valid: true}
 	}
 
 	if req.Password != nil {
@@ -55,28 +55,70 @@ func (server *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 		arg.PasswordChangedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
 	}
 
-	user, err := server.store.UpdateUser(ctx, arg)
+	// A new email is not applied here: it stays pending until the new address
+	// is confirmed, and the old address is told how to cancel the change.
+	var (
+		user         db.User
+		pendingEmail string
+	)
+	err := server.store.OutboxTx(ctx, func(q *db.Queries) ([]db.CreateOutboxMessageParams, error) {
+		before, err := q.GetUserForUpdate(ctx, arg.Username)
+		if err != nil {
+			return nil, err
+		}
+
+		user, err = q.UpdateUser(ctx, arg)
+		if err != nil {
+			return nil, err
+		}
+
+		audit := server.newAuditEntry(ctx, authPayload.Username, db.AuditUserUpdated)
+		audit.TargetType = db.AuditTargetUser
+		audit.TargetID = user.Username
+		audit.Before = db.NewAuditUser(before)
+		audit.After = db.NewAuditUser(user)
+		if _, err := q.RecordAuditEvent(ctx, audit); err != nil {
+			return nil, err
+		}
+
+		if req.Email == nil || req.GetEmail() == user.Email {
+			return nil, nil
+		}
+
+		if _, err := q.CancelPendingEmailChanges(ctx, user.Username); err != nil {
+			return nil, err
+		}
+
+		change, err := q.CreateEmailChange(ctx, db.CreateEmailChangeParams{
+			Username:         user.Username,
+			OldEmail:         user.Email,
+			OldEmailVerified: user.IsEmailVerified,
+			NewEmail:         req.GetEmail(),
+			SecretCode:       util.RandomString(32),
+			CancelCode:       util.RandomString(32),
+		})
+		if err != nil {
+			return nil, err
+		}
+		pendingEmail = change.NewEmail
+
+		return worker.NewEmailChangeMessages(change.ID)
+	})
 	if err != nil {
 		// User was deleted between auth check and update (race condition)
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, status.Errorf(codes.NotFound, "user not found")
 		}
 
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) {
-			switch pgErr.Code {
-			case "23505": // unique_violation
-				return nil, status.Errorf(codes.AlreadyExists, "email already in use")
-			}
-		}
-
 		return nil, status.Errorf(codes.Internal, "failed to update user: %v", err)
 	}
 
-	return &pb.UpdateUserResponse{User: convertUser(user)}, nil
+	return &pb.UpdateUserResponse{
+		User:         convertUser(user),
+		PendingEmail: pendingEmail,
+	}, nil
 }
 
-
 func validateUpdateUserRequest(req *pb.UpdateUserRequest) (violations []*errdetails.BadRequest_FieldViolation) {
 	// username is always required — it identifies who to update
 	if err := val.ValidateUsername(req.GetUsername()); err != nil {
@@ -102,8 +144,14 @@ func validateUpdateUserRequest(req *pb.UpdateUserRequest) (violations []*errdeta
 		}
 	}
 
+	if req.Locale != nil {
+		if err := val.ValidateLocale(req.GetLocale()); err != nil {
+			violations = append(violations, fieldViolation("locale", err))
+		}
+	}
+
 	// Edge case: sending an update with no fields to change is a client mistake
-	if req.FullName == nil && req.Email == nil && req.Password == nil {
+	if req.FullName == nil && req.Email == nil && req.Password == nil && req.Locale == nil {
 		violations = append(violations, fieldViolation("body", errors.New("at least one field must be provided for update")))
 	}
 
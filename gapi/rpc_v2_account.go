@@ -0,0 +1,153 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
+	pbv2 "github.com/a7medalyapany/GoBank.git/pb/v2"
+	"github.com/a7medalyapany/GoBank.git/token"
+	"github.com/a7medalyapany/GoBank.git/val"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func convertAccountV2(a db.Account) *pbv2.Account {
+	return &pbv2.Account{
+		Id:            a.ID,
+		Owner:         a.Owner,
+		Balance:       convertMoney(a.Balance, a.Currency),
+		CreatedAt:     timestamppb.New(a.CreatedAt.Time),
+		Type:          a.Type,
+		Nickname:      a.Nickname,
+		AccountNumber: a.AccountNumber,
+	}
+}
+
+// CreateAccount
+func (v2 *ServerV2) CreateAccount(ctx context.Context, req *pbv2.CreateAccountRequest) (*pbv2.CreateAccountResponse, error) {
+	if violations := validateNewAccount(req.GetCurrency(), req.GetType(), req.GetNickname()); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	authPayload, ok := ctx.Value(authPayloadKey).(*token.Payload)
+	if !ok || authPayload == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+
+	account, err := v2.server.createAccount(ctx, db.CreateAccountParams{
+		Owner:    authPayload.Username,
+		Currency: req.GetCurrency(),
+		Type:     req.GetType(),
+		Nickname: req.GetNickname(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbv2.CreateAccountResponse{Account: convertAccountV2(account)}, nil
+}
+
+// GetAccount
+func (v2 *ServerV2) GetAccount(ctx context.Context, req *pbv2.GetAccountRequest) (*pbv2.GetAccountResponse, error) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("id", err)})
+	}
+
+	account, err := v2.server.authorizeAccount(ctx, req.GetId(), accountView)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbv2.GetAccountResponse{Account: convertAccountV2(account)}, nil
+}
+
+// ListAccounts
+func (v2 *ServerV2) ListAccounts(ctx context.Context, req *pbv2.ListAccountsRequest) (*pbv2.ListAccountsResponse, error) {
+	var violations []*errdetails.BadRequest_FieldViolation
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+	violations = append(violations, validateAccountFilters(req.Currency, req.Type)...)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	authPayload, ok := ctx.Value(authPayloadKey).(*token.Payload)
+	if !ok || authPayload == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+
+	accounts, err := v2.server.listAccounts(ctx, db.ListMemberAccountsParams{
+		Username:  authPayload.Username,
+		Currency:  pgtype.Text{String: req.GetCurrency(), Valid: req.Currency != nil},
+		Type:      pgtype.Text{String: req.GetType(), Valid: req.Type != nil},
+		LimitArg:  req.GetPageSize(),
+		OffsetArg: (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pbAccounts := make([]*pbv2.Account, len(accounts))
+	for i, a := range accounts {
+		pbAccounts[i] = convertAccountV2(a)
+	}
+
+	return &pbv2.ListAccountsResponse{Accounts: pbAccounts}, nil
+}
+
+// UpdateAccount
+func (v2 *ServerV2) UpdateAccount(ctx context.Context, req *pbv2.UpdateAccountRequest) (*pbv2.UpdateAccountResponse, error) {
+	var violations []*errdetails.BadRequest_FieldViolation
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+	if req.GetBalance() == nil {
+		violations = append(violations, fieldViolation("balance", errors.New("is required")))
+	}
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := v2.server.authorizeAccount(ctx, req.GetId(), accountSetBalance)
+	if err != nil {
+		return nil, err
+	}
+
+	// The balance is checked against the account currency, which is only
+	// known once the account is loaded.
+	balance, violation := validateBalanceV2(req.GetBalance(), account.Currency)
+	if violation != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{violation})
+	}
+
+	updated, err := v2.server.updateAccountBalance(ctx, account, balance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbv2.UpdateAccountResponse{Account: convertAccountV2(updated)}, nil
+}
+
+func validateBalanceV2(balance *pbv2.Money, currency string) (int64, *errdetails.BadRequest_FieldViolation) {
+	if balance.GetCurrency() != currency {
+		return 0, fieldViolation("balance.currency", fmt.Errorf("must be the account currency %s", currency))
+	}
+
+	units, err := moneyUnits(balance)
+	if err != nil {
+		return 0, fieldViolation("balance.decimal", err)
+	}
+	if units < 0 {
+		return 0, fieldViolation("balance", errors.New("must be non-negative"))
+	}
+	return units, nil
+}
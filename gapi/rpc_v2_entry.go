@@ -0,0 +1,69 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
+	pbv2 "github.com/a7medalyapany/GoBank.git/pb/v2"
+	"github.com/a7medalyapany/GoBank.git/token"
+	"github.com/a7medalyapany/GoBank.git/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func (v2 *ServerV2) ListEntries(ctx context.Context, req *pbv2.ListEntriesRequest) (*pbv2.ListEntriesResponse, error) {
+	var violations []*errdetails.BadRequest_FieldViolation
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+	if err := validateEntriesPageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	authPayload, ok := ctx.Value(authPayloadKey).(*token.Payload)
+	if !ok || authPayload == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+
+	rows, err := v2.server.listActivityEntries(ctx, authPayload.Username, req.GetPageId(), req.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*pbv2.ActivityEntry, len(rows))
+	for i, row := range rows {
+		entries[i] = convertActivityEntryV2(row)
+	}
+
+	return &pbv2.ListEntriesResponse{Entries: entries}, nil
+}
+
+func convertActivityEntryV2(row db.ListActivityEntriesRow) *pbv2.ActivityEntry {
+	entry := &pbv2.ActivityEntry{
+		Id:        row.ID,
+		AccountId: row.AccountID,
+		Amount:    convertMoney(row.Amount, row.Currency),
+		CreatedAt: timestamppb.New(row.CreatedAt.Time),
+	}
+
+	if row.TransferID.Valid {
+		entry.TransferId = wrapperspb.Int64(row.TransferID.Int64)
+	}
+	if row.CounterpartAccountID.Valid {
+		entry.CounterpartAccountId = wrapperspb.Int64(row.CounterpartAccountID.Int64)
+	}
+	if row.CounterpartOwner.Valid {
+		entry.CounterpartOwner = wrapperspb.String(row.CounterpartOwner.String)
+	}
+	if row.CounterpartCurrency.Valid {
+		entry.CounterpartCurrency = wrapperspb.String(row.CounterpartCurrency.String)
+	}
+
+	return entry
+}
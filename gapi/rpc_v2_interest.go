@@ -0,0 +1,48 @@
+package gapi
+
+import (
+	"context"
+	"time"
+
+	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
+	pbv2 "github.com/a7medalyapany/GoBank.git/pb/v2"
+	"github.com/a7medalyapany/GoBank.git/util"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func convertInterestPostingV2(p db.InterestPosting, currency string) *pbv2.InterestPosting {
+	return &pbv2.InterestPosting{
+		Id:          p.ID,
+		Amount:      convertMoney(p.Amount, currency),
+		PeriodStart: p.PeriodStart.Time.Format(time.DateOnly),
+		PeriodEnd:   p.PeriodEnd.Time.Format(time.DateOnly),
+		TransferId:  p.TransferID.Int64,
+		CreatedAt:   timestamppb.New(p.CreatedAt.Time),
+	}
+}
+
+// GetAccountInterest
+func (v2 *ServerV2) GetAccountInterest(ctx context.Context, req *pbv2.GetAccountInterestRequest) (*pbv2.GetAccountInterestResponse, error) {
+	if violations := validateAccountInterestPage(req.GetAccountId(), req.GetPageId(), req.GetPageSize()); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	interest, err := v2.server.getAccountInterest(ctx, req.GetAccountId(), req.GetPageId(), req.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+
+	currency := interest.account.Currency
+	postings := make([]*pbv2.InterestPosting, len(interest.postings))
+	for i, p := range interest.postings {
+		postings[i] = convertInterestPostingV2(p, currency)
+	}
+
+	return &pbv2.GetAccountInterestResponse{
+		AnnualRate: util.FormatRate(interest.rateBPS),
+		// Postings round down too, so this is what posting now would credit.
+		PendingInterest:       convertMoney(interest.pendingMicros/util.MicrosPerMinorUnit, currency),
+		PendingInterestMicros: interest.pendingMicros,
+		Postings:              postings,
+	}, nil
+}
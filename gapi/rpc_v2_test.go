@@ -0,0 +1,122 @@
+package gapi
+
+import (
+	"testing"
+
+	pbv2 "github.com/a7medalyapany/GoBank.git/pb/v2"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMoneyUnits(t *testing.T) {
+	units, err := moneyUnits(&pbv2.Money{Currency: "USD", Units: 1050})
+	require.NoError(t, err)
+	require.Equal(t, int64(1050), units)
+
+	units, err = moneyUnits(&pbv2.Money{Currency: "USD", Decimal: "10.50"})
+	require.NoError(t, err)
+	require.Equal(t, int64(1050), units)
+
+	units, err = moneyUnits(&pbv2.Money{Currency: "USD", Units: 1050, Decimal: "10.5"})
+	require.NoError(t, err)
+	require.Equal(t, int64(1050), units)
+
+	_, err = moneyUnits(&pbv2.Money{Currency: "USD", Units: 1051, Decimal: "10.50"})
+	require.Error(t, err)
+
+	_, err = moneyUnits(&pbv2.Money{Currency: "USD", Decimal: "0.001"})
+	require.Error(t, err)
+
+	require.Equal(t, &pbv2.Money{Currency: "USD", Units: -5, Decimal: "-0.05"}, convertMoney(-5, "USD"))
+}
+
+func TestCreateTransferV2(t *testing.T) {
+	server := NewServerV2(newTestServer(t))
+
+	sender := createTestUser(t)
+	receiver := createTestUser(t)
+	from := createTestAccount(t, sender.Username, "USD", 10_000)
+	to := createTestAccount(t, receiver.Username, "USD", 0)
+	ctx := authContext(t, sender.Username)
+
+	t.Run("OK", func(t *testing.T) {
+		resp, err := server.CreateTransfer(ctx, &pbv2.CreateTransferRequest{
+			FromAccountId: from.ID,
+			ToAccountId:   to.ID,
+			Amount:        &pbv2.Money{Currency: "USD", Decimal: "0.10"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, &pbv2.Money{Currency: "USD", Units: 10, Decimal: "0.10"}, resp.Transfer.Amount)
+		require.Equal(t, "-0.10", resp.FromEntry.Amount.Decimal)
+		require.Equal(t, &pbv2.Money{Currency: "USD", Units: 9_990, Decimal: "99.90"}, resp.FromAccount.Balance)
+		require.Equal(t, &pbv2.Money{Currency: "USD", Units: 10, Decimal: "0.10"}, resp.ToAccount.Balance)
+	})
+
+	t.Run("InvalidAmount", func(t *testing.T) {
+		for _, amount := range []*pbv2.Money{
+			nil,
+			{Currency: "USD", Decimal: "0.001"},
+			{Currency: "USD", Units: 1, Decimal: "0.02"},
+			{Currency: "USD", Units: -1},
+			{Currency: "XYZ", Units: 1},
+		} {
+			resp, err := server.CreateTransfer(ctx, &pbv2.CreateTransferRequest{
+				FromAccountId: from.ID,
+				ToAccountId:   to.ID,
+				Amount:        amount,
+			})
+			require.Nil(t, resp)
+			require.Equal(t, codes.InvalidArgument, status.Code(err), amount.String())
+		}
+	})
+
+	t.Run("CurrencyMismatch", func(t *testing.T) {
+		resp, err := server.CreateTransfer(ctx, &pbv2.CreateTransferRequest{
+			FromAccountId: from.ID,
+			ToAccountId:   to.ID,
+			Amount:        &pbv2.Money{Currency: "EUR", Units: 1},
+		})
+		require.Nil(t, resp)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestUpdateAccountV2(t *testing.T) {
+	server := NewServerV2(newTestServer(t))
+
+	user := createTestUser(t)
+	account := createTestAccount(t, user.Username, "EUR", 0)
+	ctx := authContext(t, user.Username)
+
+	resp, err := server.UpdateAccount(ctx, &pbv2.UpdateAccountRequest{
+		Id:      account.ID,
+		Balance: &pbv2.Money{Currency: "EUR", Decimal: "1234.56"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, &pbv2.Money{Currency: "EUR", Units: 123_456, Decimal: "1234.56"}, resp.Account.Balance)
+
+	for _, balance := range []*pbv2.Money{
+		nil,
+		{Currency: "USD", Units: 100},
+		{Currency: "EUR", Units: -1},
+		{Currency: "EUR", Decimal: "1.234"},
+	} {
+		resp, err := server.UpdateAccount(ctx, &pbv2.UpdateAccountRequest{Id: account.ID, Balance: balance})
+		require.Nil(t, resp)
+		require.Equal(t, codes.InvalidArgument, status.Code(err), balance.String())
+	}
+}
+
+func TestListEntriesV2(t *testing.T) {
+	server := NewServerV2(newTestServer(t))
+
+	fixture := createActivityFixture(t)
+	ctx := authContext(t, fixture.user.Username)
+
+	resp, err := server.ListEntries(ctx, &pbv2.ListEntriesRequest{PageId: 1, PageSize: 5})
+	require.NoError(t, err)
+	require.Len(t, resp.Entries, 2)
+	require.Equal(t, &pbv2.Money{Currency: "EUR", Units: 12_345, Decimal: "123.45"}, resp.Entries[0].Amount)
+	require.Equal(t, &pbv2.Money{Currency: "USD", Units: 5_000, Decimal: "50.00"}, resp.Entries[1].Amount)
+}
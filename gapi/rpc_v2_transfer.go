@@ -0,0 +1,102 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
+	pbv2 "github.com/a7medalyapany/GoBank.git/pb/v2"
+	"github.com/a7medalyapany/GoBank.git/token"
+	"github.com/a7medalyapany/GoBank.git/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (v2 *ServerV2) CreateTransfer(ctx context.Context, req *pbv2.CreateTransferRequest) (*pbv2.CreateTransferResponse, error) {
+	amount, violations := validateCreateTransferRequestV2(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	authPayload, ok := ctx.Value(authPayloadKey).(*token.Payload)
+	if !ok || authPayload == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+
+	toAccountID, err := v2.server.transferDestination(ctx, req.GetFromAccountId(), req.GetToAccountId(), req.GetToAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := v2.server.createTransfer(ctx, authPayload.Username, req.GetFromAccountId(), toAccountID, amount, req.GetAmount().GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+
+	return convertTransferResultV2(result), nil
+}
+
+// validateCreateTransferRequestV2 also returns the amount in minor units, so
+// the decimal is parsed only once.
+func validateCreateTransferRequestV2(req *pbv2.CreateTransferRequest) (amount int64, violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetFromAccountId()); err != nil {
+		violations = append(violations, fieldViolation("from_account_id", err))
+	}
+	if violation := validateAccountIDOrNumber("to_account_id", req.GetToAccountId(), "to_account_number", req.GetToAccountNumber()); violation != nil {
+		violations = append(violations, violation)
+	}
+
+	if req.GetAmount() == nil {
+		violations = append(violations, fieldViolation("amount", errors.New("is required")))
+	} else if err := val.ValidateCurrency(req.GetAmount().GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("amount.currency", err))
+	} else if units, err := moneyUnits(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount.decimal", err))
+	} else if units <= 0 {
+		violations = append(violations, fieldViolation("amount", errors.New("must be greater than zero")))
+	} else {
+		amount = units
+	}
+
+	// Edge case: transferring to yourself isn't caught by DB constraints
+	if req.GetFromAccountId() > 0 && req.GetFromAccountId() == req.GetToAccountId() {
+		violations = append(violations, fieldViolation("to_account_id", errors.New("cannot transfer to the same account")))
+	}
+	return
+}
+
+func convertTransferResultV2(r db.TransferTxResult) *pbv2.CreateTransferResponse {
+	return &pbv2.CreateTransferResponse{
+		Transfer: &pbv2.TransferRecord{
+			Id:            r.Transfer.ID,
+			FromAccountId: r.Transfer.FromAccountID,
+			ToAccountId:   r.Transfer.ToAccountID,
+			Amount:        convertMoney(r.Transfer.Amount, r.FromAccount.Currency),
+			CreatedAt:     timestamppb.New(r.Transfer.CreatedAt.Time),
+		},
+		FromEntry: &pbv2.TransferEntry{
+			Id:        r.FromEntry.ID,
+			AccountId: r.FromEntry.AccountID,
+			Amount:    convertMoney(r.FromEntry.Amount, r.FromAccount.Currency),
+			CreatedAt: timestamppb.New(r.FromEntry.CreatedAt.Time),
+		},
+		ToEntry: &pbv2.TransferEntry{
+			Id:        r.ToEntry.ID,
+			AccountId: r.ToEntry.AccountID,
+			Amount:    convertMoney(r.ToEntry.Amount, r.ToAccount.Currency),
+			CreatedAt: timestamppb.New(r.ToEntry.CreatedAt.Time),
+		},
+		FromAccount: &pbv2.CreateTransferResponse_AccountSnapshot{
+			Id:      r.FromAccount.ID,
+			Owner:   r.FromAccount.Owner,
+			Balance: convertMoney(r.FromAccount.Balance, r.FromAccount.Currency),
+		},
+		ToAccount: &pbv2.CreateTransferResponse_AccountSnapshot{
+			Id:      r.ToAccount.ID,
+			Owner:   r.ToAccount.Owner,
+			Balance: convertMoney(r.ToAccount.Balance, r.ToAccount.Currency),
+		},
+	}
+}
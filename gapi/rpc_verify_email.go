@@ -6,10 +6,17 @@ import (
 
 	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
 	"github.com/a7medalyapany/GoBank.git/pb"
+	"github.com/a7medalyapany/GoBank.git/token"
+	"github.com/a7medalyapany/GoBank.git/util"
 	"github.com/a7medalyapany/GoBank.git/val"
+	"github.com/a7medalyapany/GoBank.git/worker"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (server *Server) VerifyEmail(ctx context.Context, req *pb.VerifyEmailRequest) (*pb.VerifyEmailResponse, error) {
@@ -22,6 +29,9 @@ func (server *Server) VerifyEmail(ctx context.Context, req *pb.VerifyEmailReques
         SecretCode: req.GetSecretCode(),
     })
     if err != nil {
+        if errors.Is(err, pgx.ErrNoRows) || errors.Is(err, db.ErrVerifyEmailStale) {
+            return nil, status.Errorf(codes.InvalidArgument, "verification link is invalid, already used or expired")
+        }
         return nil, status.Errorf(codes.Internal, "failed to verify email: %v", err)
     }
 
@@ -38,4 +48,57 @@ func validateVerifyEmailRequest(req *pb.VerifyEmailRequest) (violations []*errde
         violations = append(violations, fieldViolation("secret_code", errors.New("must not be empty")))
     }
     return
-}
\ No newline at end of file
+}
+
+// ResendVerifyEmail
+func (server *Server) ResendVerifyEmail(ctx context.Context, req *pb.ResendVerifyEmailRequest) (*pb.ResendVerifyEmailResponse, error) {
+	authPayload, ok := ctx.Value(authPayloadKey).(*token.Payload)
+	if !ok || authPayload == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+
+	result, err := server.store.ResendVerifyEmailTx(ctx, db.ResendVerifyEmailTxParams{
+		Username:   authPayload.Username,
+		SecretCode: util.RandomString(32),
+		Cooldown:   server.config.VERIFY_EMAIL_RESEND_COOLDOWN,
+		AfterCreate: func(verifyEmail db.VerifyEmail) ([]db.CreateOutboxMessageParams, error) {
+			message, err := worker.NewOutboxMessage(worker.TaskSendVerifyEmail, &worker.PayloadSendVerifyEmail{
+				Username:      verifyEmail.Username,
+				VerifyEmailID: verifyEmail.ID,
+			}, asynq.MaxRetry(10), asynq.Queue(worker.QueueCritical))
+			if err != nil {
+				return nil, err
+			}
+			return []db.CreateOutboxMessageParams{message}, nil
+		},
+	})
+	if err != nil {
+		var cooldownErr *db.ResendCooldownError
+		switch {
+		case errors.As(err, &cooldownErr):
+			return nil, resendCooldownError(cooldownErr)
+		case errors.Is(err, db.ErrEmailAlreadyVerified):
+			return nil, status.Errorf(codes.FailedPrecondition, "email is already verified")
+		case errors.Is(err, pgx.ErrNoRows):
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to resend verification email: %v", err)
+	}
+
+	return &pb.ResendVerifyEmailResponse{
+		ExpiresAt: timestamppb.New(result.VerifyEmail.ExpiresAt.Time),
+	}, nil
+}
+
+// resendCooldownError reports a cooldown as ResourceExhausted with a
+// RetryInfo detail telling the client when to try again.
+func resendCooldownError(err *db.ResendCooldownError) error {
+	st := status.New(codes.ResourceExhausted, err.Error())
+	detailed, detailErr := st.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(err.RetryAfter),
+	})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
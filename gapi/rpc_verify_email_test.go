@@ -0,0 +1,62 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/a7medalyapany/GoBank.git/pb"
+	"github.com/a7medalyapany/GoBank.git/worker"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestResendVerifyEmail(t *testing.T) {
+	server := newTestServer(t)
+	server.config.VERIFY_EMAIL_RESEND_COOLDOWN = time.Minute
+	user := createTestUser(t)
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		resp, err := server.ResendVerifyEmail(context.Background(), &pb.ResendVerifyEmailRequest{})
+		require.Nil(t, resp)
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("OK", func(t *testing.T) {
+		resp, err := server.ResendVerifyEmail(authContext(t, user.Username), &pb.ResendVerifyEmailRequest{})
+		require.NoError(t, err)
+		require.True(t, resp.ExpiresAt.AsTime().After(time.Now()))
+
+		var count int
+		err = testDB.QueryRow(context.Background(),
+			"SELECT count(*) FROM outbox WHERE task_type = $1 AND payload->>'username' = $2",
+			worker.TaskSendVerifyEmail, user.Username,
+		).Scan(&count)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+	})
+
+	t.Run("Cooldown", func(t *testing.T) {
+		resp, err := server.ResendVerifyEmail(authContext(t, user.Username), &pb.ResendVerifyEmailRequest{})
+		require.Nil(t, resp)
+
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		require.Equal(t, codes.ResourceExhausted, st.Code())
+		require.Len(t, st.Details(), 1)
+		retryInfo, ok := st.Details()[0].(*errdetails.RetryInfo)
+		require.True(t, ok)
+		require.Greater(t, retryInfo.RetryDelay.AsDuration(), time.Duration(0))
+	})
+
+	t.Run("AlreadyVerified", func(t *testing.T) {
+		_, err := testDB.Exec(context.Background(), "UPDATE users SET is_email_verified = true WHERE username = $1", user.Username)
+		require.NoError(t, err)
+
+		resp, err := server.ResendVerifyEmail(authContext(t, user.Username), &pb.ResendVerifyEmailRequest{})
+		require.Nil(t, resp)
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
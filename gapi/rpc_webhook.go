@@ -0,0 +1,301 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
+	"github.com/a7medalyapany/GoBank.git/pb"
+	"github.com/a7medalyapany/GoBank.git/token"
+	"github.com/a7medalyapany/GoBank.git/val"
+	"github.com/a7medalyapany/GoBank.git/webhook"
+	"github.com/a7medalyapany/GoBank.git/worker"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func convertWebhookEndpoint(e db.WebhookEndpoint) *pb.WebhookEndpoint {
+	return &pb.WebhookEndpoint{
+		Id:         e.ID,
+		Url:        e.Url,
+		EventTypes: e.EventTypes,
+		CreatedAt:  timestamppb.New(e.CreatedAt.Time),
+	}
+}
+
+func convertWebhookDelivery(d db.WebhookDelivery) *pb.WebhookDelivery {
+	delivery := &pb.WebhookDelivery{
+		Id:         d.ID,
+		EndpointId: d.EndpointID,
+		EventId:    d.EventID,
+		EventType:  d.EventType,
+		Payload:    string(d.Payload),
+		Status:     d.Status,
+		Attempts:   d.Attempts,
+		CreatedAt:  timestamppb.New(d.CreatedAt.Time),
+	}
+	if d.ResponseStatus.Valid {
+		delivery.ResponseStatus = wrapperspb.Int32(d.ResponseStatus.Int32)
+	}
+	if d.LastError.Valid {
+		delivery.LastError = wrapperspb.String(d.LastError.String)
+	}
+	if d.DeliveredAt.Valid {
+		delivery.DeliveredAt = timestamppb.New(d.DeliveredAt.Time)
+	}
+	return delivery
+}
+
+// authorizeWebhookEndpoint loads an endpoint owned by the authenticated user.
+// Endpoints of other users are reported as not found.
+func (server *Server) authorizeWebhookEndpoint(ctx context.Context, endpointID int64) (db.WebhookEndpoint, error) {
+	authPayload, ok := ctx.Value(authPayloadKey).(*token.Payload)
+	if !ok || authPayload == nil {
+		return db.WebhookEndpoint{}, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+
+	endpoint, err := server.store.GetWebhookEndpoint(ctx, endpointID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return db.WebhookEndpoint{}, status.Errorf(codes.NotFound, "webhook endpoint not found")
+		}
+		return db.WebhookEndpoint{}, status.Errorf(codes.Internal, "failed to get webhook endpoint: %v", err)
+	}
+
+	if endpoint.Username != authPayload.Username {
+		return db.WebhookEndpoint{}, status.Errorf(codes.NotFound, "webhook endpoint not found")
+	}
+
+	return endpoint, nil
+}
+
+// CreateWebhookEndpoint
+func (server *Server) CreateWebhookEndpoint(ctx context.Context, req *pb.CreateWebhookEndpointRequest) (*pb.CreateWebhookEndpointResponse, error) {
+	if violations := validateCreateWebhookEndpointRequest(req, server.config.ENVIRONMENT == "production"); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// The url parsed during validation. Its host must not resolve to an
+	// internal address either.
+	u, _ := url.Parse(req.GetUrl())
+	if webhook.CheckHost(ctx, u.Hostname()) != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{
+			fieldViolation("url", errors.New("must not point to a loopback or private address")),
+		})
+	}
+
+	authPayload, ok := ctx.Value(authPayloadKey).(*token.Payload)
+	if !ok || authPayload == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+
+	secret, err := webhook.GenerateSecret()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate webhook secret: %v", err)
+	}
+
+	endpoint, err := server.store.CreateWebhookEndpoint(ctx, db.CreateWebhookEndpointParams{
+		Username:   authPayload.Username,
+		Url:        req.GetUrl(),
+		Secret:     secret,
+		EventTypes: req.GetEventTypes(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create webhook endpoint: %v", err)
+	}
+
+	return &pb.CreateWebhookEndpointResponse{
+		Endpoint: convertWebhookEndpoint(endpoint),
+		Secret:   secret,
+	}, nil
+}
+
+func validateCreateWebhookEndpointRequest(req *pb.CreateWebhookEndpointRequest, requireHTTPS bool) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateWebhookURL(req.GetUrl(), requireHTTPS); err != nil {
+		violations = append(violations, fieldViolation("url", err))
+	}
+	if len(req.GetEventTypes()) == 0 {
+		violations = append(violations, fieldViolation("event_types", errors.New("must contain at least one event type")))
+	}
+	for _, eventType := range req.GetEventTypes() {
+		if !webhook.IsKnownEventType(eventType) {
+			violations = append(violations, fieldViolation("event_types",
+				fmt.Errorf("unknown event type %q: must be one of %s", eventType, strings.Join(webhook.EventTypes(), ", "))))
+		}
+	}
+	return
+}
+
+// ListWebhookEndpoints
+func (server *Server) ListWebhookEndpoints(ctx context.Context, req *pb.ListWebhookEndpointsRequest) (*pb.ListWebhookEndpointsResponse, error) {
+	if violations := validateListWebhookEndpointsRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	authPayload, ok := ctx.Value(authPayloadKey).(*token.Payload)
+	if !ok || authPayload == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+
+	endpoints, err := server.store.ListWebhookEndpoints(ctx, db.ListWebhookEndpointsParams{
+		Username: authPayload.Username,
+		Limit:    req.GetPageSize(),
+		Offset:   (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list webhook endpoints: %v", err)
+	}
+
+	pbEndpoints := make([]*pb.WebhookEndpoint, len(endpoints))
+	for i, e := range endpoints {
+		pbEndpoints[i] = convertWebhookEndpoint(e)
+	}
+
+	return &pb.ListWebhookEndpointsResponse{Endpoints: pbEndpoints}, nil
+}
+
+func validateListWebhookEndpointsRequest(req *pb.ListWebhookEndpointsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+	return
+}
+
+// DeleteWebhookEndpoint
+func (server *Server) DeleteWebhookEndpoint(ctx context.Context, req *pb.DeleteWebhookEndpointRequest) (*pb.DeleteWebhookEndpointResponse, error) {
+	if violations := validateDeleteWebhookEndpointRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	authPayload, ok := ctx.Value(authPayloadKey).(*token.Payload)
+	if !ok || authPayload == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+	}
+
+	deleted, err := server.store.DeleteWebhookEndpoint(ctx, db.DeleteWebhookEndpointParams{
+		ID:       req.GetId(),
+		Username: authPayload.Username,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete webhook endpoint: %v", err)
+	}
+	if deleted == 0 {
+		return nil, status.Errorf(codes.NotFound, "webhook endpoint not found")
+	}
+
+	return &pb.DeleteWebhookEndpointResponse{Status: "deleted"}, nil
+}
+
+func validateDeleteWebhookEndpointRequest(req *pb.DeleteWebhookEndpointRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+	return
+}
+
+// ListWebhookDeliveries
+func (server *Server) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
+	if violations := validateListWebhookDeliveriesRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	endpoint, err := server.authorizeWebhookEndpoint(ctx, req.GetEndpointId())
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, err := server.store.ListWebhookDeliveries(ctx, db.ListWebhookDeliveriesParams{
+		EndpointID: endpoint.ID,
+		Status:     pgtype.Text{String: req.GetStatus(), Valid: req.Status != nil},
+		LimitArg:   req.GetPageSize(),
+		OffsetArg:  (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list webhook deliveries: %v", err)
+	}
+
+	pbDeliveries := make([]*pb.WebhookDelivery, len(deliveries))
+	for i, d := range deliveries {
+		pbDeliveries[i] = convertWebhookDelivery(d)
+	}
+
+	return &pb.ListWebhookDeliveriesResponse{Deliveries: pbDeliveries}, nil
+}
+
+func validateListWebhookDeliveriesRequest(req *pb.ListWebhookDeliveriesRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetEndpointId()); err != nil {
+		violations = append(violations, fieldViolation("endpoint_id", err))
+	}
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+	if req.Status != nil && !webhook.IsKnownDeliveryStatus(req.GetStatus()) {
+		violations = append(violations, fieldViolation("status", errors.New("must be one of pending, succeeded, dead")))
+	}
+	return
+}
+
+// ReplayWebhookDelivery
+func (server *Server) ReplayWebhookDelivery(ctx context.Context, req *pb.ReplayWebhookDeliveryRequest) (*pb.ReplayWebhookDeliveryResponse, error) {
+	if violations := validateReplayWebhookDeliveryRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	delivery, err := server.store.GetWebhookDelivery(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "webhook delivery not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get webhook delivery: %v", err)
+	}
+
+	if _, err := server.authorizeWebhookEndpoint(ctx, delivery.EndpointID); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.NotFound, "webhook delivery not found")
+		}
+		return nil, err
+	}
+
+	err = server.store.OutboxTx(ctx, func(q *db.Queries) ([]db.CreateOutboxMessageParams, error) {
+		var err error
+		delivery, err = q.ResetWebhookDelivery(ctx, delivery.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		message, err := worker.NewWebhookDeliveryMessage(delivery.ID, server.config.WEBHOOK_MAX_RETRY)
+		if err != nil {
+			return nil, err
+		}
+		return []db.CreateOutboxMessageParams{message}, nil
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "webhook delivery not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to replay webhook delivery: %v", err)
+	}
+
+	return &pb.ReplayWebhookDeliveryResponse{Delivery: convertWebhookDelivery(delivery)}, nil
+}
+
+func validateReplayWebhookDeliveryRequest(req *pb.ReplayWebhookDeliveryRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+	return
+}
@@ -0,0 +1,185 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+
+	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
+	"github.com/a7medalyapany/GoBank.git/pb"
+	"github.com/a7medalyapany/GoBank.git/util"
+	"github.com/a7medalyapany/GoBank.git/webhook"
+	"github.com/a7medalyapany/GoBank.git/worker"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCreateWebhookEndpoint(t *testing.T) {
+	server := newTestServer(t)
+	user := createTestUser(t)
+
+	t.Run("InvalidArguments", func(t *testing.T) {
+		resp, err := server.CreateWebhookEndpoint(authContext(t, user.Username), &pb.CreateWebhookEndpointRequest{
+			Url:        "ftp://example.com/hook",
+			EventTypes: []string{"transfer.lost"},
+		})
+		require.Nil(t, resp)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("InternalAddress", func(t *testing.T) {
+		for _, url := range []string{"https://127.0.0.1/hook", "https://10.1.2.3/hook", "https://localhost/hook"} {
+			resp, err := server.CreateWebhookEndpoint(authContext(t, user.Username), &pb.CreateWebhookEndpointRequest{
+				Url:        url,
+				EventTypes: []string{webhook.EventTransferReceived},
+			})
+			require.Nil(t, resp)
+			require.Equal(t, codes.InvalidArgument, status.Code(err), url)
+		}
+	})
+
+	t.Run("OK", func(t *testing.T) {
+		resp, err := server.CreateWebhookEndpoint(authContext(t, user.Username), &pb.CreateWebhookEndpointRequest{
+			Url:        "https://example.com/hooks/gobank",
+			EventTypes: []string{webhook.EventTransferReceived},
+		})
+		require.NoError(t, err)
+		require.NotZero(t, resp.Endpoint.Id)
+		require.Equal(t, []string{webhook.EventTransferReceived}, resp.Endpoint.EventTypes)
+		require.NotEmpty(t, resp.Secret)
+
+		list, err := server.ListWebhookEndpoints(authContext(t, user.Username), &pb.ListWebhookEndpointsRequest{
+			PageId:   1,
+			PageSize: 5,
+		})
+		require.NoError(t, err)
+		require.Len(t, list.Endpoints, 1)
+		require.Equal(t, resp.Endpoint.Id, list.Endpoints[0].Id)
+	})
+}
+
+func TestDeleteWebhookEndpoint(t *testing.T) {
+	server := newTestServer(t)
+	endpoint := createTestWebhookEndpoint(t, createTestUser(t).Username)
+
+	t.Run("OtherUser", func(t *testing.T) {
+		resp, err := server.DeleteWebhookEndpoint(authContext(t, createTestUser(t).Username), &pb.DeleteWebhookEndpointRequest{
+			Id: endpoint.ID,
+		})
+		require.Nil(t, resp)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("OK", func(t *testing.T) {
+		resp, err := server.DeleteWebhookEndpoint(authContext(t, endpoint.Username), &pb.DeleteWebhookEndpointRequest{
+			Id: endpoint.ID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "deleted", resp.Status)
+	})
+}
+
+func TestListWebhookDeliveries(t *testing.T) {
+	server := newTestServer(t)
+	endpoint := createTestWebhookEndpoint(t, createTestUser(t).Username)
+	createTestWebhookDelivery(t, endpoint.ID)
+	dead := createTestWebhookDelivery(t, endpoint.ID)
+	markTestWebhookDeliveryDead(t, dead.ID)
+
+	t.Run("OtherUser", func(t *testing.T) {
+		resp, err := server.ListWebhookDeliveries(authContext(t, createTestUser(t).Username), &pb.ListWebhookDeliveriesRequest{
+			EndpointId: endpoint.ID,
+			PageId:     1,
+			PageSize:   5,
+		})
+		require.Nil(t, resp)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("DeadLetters", func(t *testing.T) {
+		resp, err := server.ListWebhookDeliveries(authContext(t, endpoint.Username), &pb.ListWebhookDeliveriesRequest{
+			EndpointId: endpoint.ID,
+			PageId:     1,
+			PageSize:   5,
+			Status:     proto.String(webhook.DeliveryStatusDead),
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Deliveries, 1)
+		require.Equal(t, dead.ID, resp.Deliveries[0].Id)
+		require.Equal(t, "receiver returned 500", resp.Deliveries[0].LastError.GetValue())
+	})
+}
+
+func TestReplayWebhookDelivery(t *testing.T) {
+	server := newTestServer(t)
+	endpoint := createTestWebhookEndpoint(t, createTestUser(t).Username)
+	delivery := createTestWebhookDelivery(t, endpoint.ID)
+	markTestWebhookDeliveryDead(t, delivery.ID)
+
+	t.Run("OtherUser", func(t *testing.T) {
+		resp, err := server.ReplayWebhookDelivery(authContext(t, createTestUser(t).Username), &pb.ReplayWebhookDeliveryRequest{
+			Id: delivery.ID,
+		})
+		require.Nil(t, resp)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("OK", func(t *testing.T) {
+		resp, err := server.ReplayWebhookDelivery(authContext(t, endpoint.Username), &pb.ReplayWebhookDeliveryRequest{
+			Id: delivery.ID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, webhook.DeliveryStatusPending, resp.Delivery.Status)
+		require.Equal(t, delivery.EventID, resp.Delivery.EventId)
+
+		var count int
+		err = testDB.QueryRow(context.Background(),
+			"SELECT count(*) FROM outbox WHERE task_type = $1 AND (payload->>'delivery_id')::bigint = $2",
+			worker.TaskDeliverWebhook, delivery.ID,
+		).Scan(&count)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+	})
+}
+
+func createTestWebhookEndpoint(t *testing.T, username string) db.WebhookEndpoint {
+	t.Helper()
+
+	endpoint, err := testStore.CreateWebhookEndpoint(context.Background(), db.CreateWebhookEndpointParams{
+		Username:   username,
+		Url:        "https://example.com/hooks/" + util.RandomString(6),
+		Secret:     "whsec_" + util.RandomString(32),
+		EventTypes: webhook.EventTypes(),
+	})
+	require.NoError(t, err)
+
+	return endpoint
+}
+
+func createTestWebhookDelivery(t *testing.T, endpointID int64) db.WebhookDelivery {
+	t.Helper()
+
+	delivery, err := testStore.CreateWebhookDelivery(context.Background(), db.CreateWebhookDeliveryParams{
+		EndpointID: endpointID,
+		EventID:    util.RandomString(16),
+		EventType:  webhook.EventAccountCreated,
+		Payload:    []byte(`{"type":"account.created"}`),
+	})
+	require.NoError(t, err)
+
+	return delivery
+}
+
+func markTestWebhookDeliveryDead(t *testing.T, deliveryID int64) {
+	t.Helper()
+
+	err := testStore.MarkWebhookDeliveryFailed(context.Background(), db.MarkWebhookDeliveryFailedParams{
+		ID:             deliveryID,
+		Status:         webhook.DeliveryStatusDead,
+		ResponseStatus: pgtype.Int4{Int32: 500, Valid: true},
+		LastError:      pgtype.Text{String: "receiver returned 500", Valid: true},
+	})
+	require.NoError(t, err)
+}
@@ -1,7 +1,9 @@
 package gapi
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
 
 	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
 	"github.com/a7medalyapany/GoBank.git/pb"
@@ -18,23 +20,70 @@ type Server struct {
 	config     util.Config
 	tokenMaker token.Maker
 	taskDistributor worker.TaskDistributor
+	taskInspector   worker.TaskInspector
+	// verifiedEmailMethods holds the full method names that need a verified email.
+	verifiedEmailMethods map[string]bool
 }
 
-// NewServer creates a new gRPC Server instance with a Paseto token maker.
-func NewServer(store *db.Store, config util.Config, taskDistributor worker.TaskDistributor) (*Server, error) {
-	tokenMaker, err := token.NewPasetoMaker(config.TOKEN_SYMMETRIC_KEY)
+// NewServer creates a new gRPC Server instance with the token maker selected by TOKEN_MAKER.
+func NewServer(store *db.Store, config util.Config, taskDistributor worker.TaskDistributor, taskInspector worker.TaskInspector) (*Server, error) {
+	tokenMaker, err := newTokenMaker(config)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create token maker: %w", err)
 	}
 
+	verifiedEmailMethods, err := parseVerifiedEmailMethods(config.REQUIRE_VERIFIED_EMAIL)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Server{
 		store:      store,
 		config:     config,
 		tokenMaker: tokenMaker,
 		taskDistributor: taskDistributor,
+		taskInspector:   taskInspector,
+		verifiedEmailMethods: verifiedEmailMethods,
 	}, nil
 }
 
+// newTokenMaker picks the token implementation:
+//
+//	paseto         → PASETO v2.local, shared TOKEN_SYMMETRIC_KEY (default)
+//	paseto_public  → PASETO v4.public, Ed25519 keys from TOKEN_SIGNING_KEYS
+//	jwt_ed25519    → EdDSA JWT, Ed25519 keys from TOKEN_SIGNING_KEYS
+func newTokenMaker(config util.Config) (token.Maker, error) {
+	switch config.TOKEN_MAKER {
+	case "", "paseto":
+		return token.NewPasetoMaker(config.TOKEN_SYMMETRIC_KEY)
+	case "paseto_public", "jwt_ed25519":
+		keys, err := token.ParseKeySet(config.TOKEN_SIGNING_KEYS, config.TOKEN_VERIFY_KEYS, config.TOKEN_ACTIVE_KEY_ID)
+		if err != nil {
+			return nil, err
+		}
+		if config.TOKEN_MAKER == "paseto_public" {
+			return token.NewPasetoPublicMaker(keys)
+		}
+		return token.NewEd25519JWTMaker(keys)
+	}
+	return nil, fmt.Errorf("unsupported token maker %q", config.TOKEN_MAKER)
+}
+
+// JWKSHandler serves the public verification keys as a JWK Set.
+// Returns 404 when the server is configured with a symmetric token maker,
+// since there is nothing that can be published safely.
+func (server *Server) JWKSHandler(w http.ResponseWriter, _ *http.Request) {
+	provider, ok := server.tokenMaker.(token.PublicKeyProvider)
+	if !ok {
+		http.NotFound(w, nil)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	json.NewEncoder(w).Encode(provider.JWKS())
+}
+
 // GRPCServer builds and returns a *grpc.Server with the auth interceptor wired in.
 // Call this in main.go instead of constructing grpc.NewServer() manually.
 //
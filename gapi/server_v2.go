@@ -0,0 +1,47 @@
+package gapi
+
+import (
+	"fmt"
+
+	pbv2 "github.com/a7medalyapany/GoBank.git/pb/v2"
+	"github.com/a7medalyapany/GoBank.git/util"
+)
+
+// ServerV2 serves the v2 GoBank API, which carries amounts as exact Money
+// messages instead of float64 major units. It shares the store, config and
+// interceptors of server, and reuses its v1 handler logic.
+type ServerV2 struct {
+	pbv2.UnimplementedGoBankServer
+	server *Server
+}
+
+// NewServerV2 creates the v2 gRPC server on top of the v1 server.
+func NewServerV2(server *Server) *ServerV2 {
+	return &ServerV2{server: server}
+}
+
+// convertMoney returns amount, in minor units of currency, as a v2 Money.
+func convertMoney(amount int64, currency string) *pbv2.Money {
+	return &pbv2.Money{
+		Currency: currency,
+		Units:    amount,
+		Decimal:  util.FormatDecimal(amount, currency),
+	}
+}
+
+// moneyUnits returns the amount of money in minor units of its currency.
+// Decimal wins when set; units must then be 0 or agree with it.
+func moneyUnits(money *pbv2.Money) (int64, error) {
+	if money.GetDecimal() == "" {
+		return money.GetUnits(), nil
+	}
+
+	units, err := util.ParseDecimal(money.GetDecimal(), money.GetCurrency())
+	if err != nil {
+		return 0, err
+	}
+	if money.GetUnits() != 0 && money.GetUnits() != units {
+		return 0, fmt.Errorf("does not match units %d", money.GetUnits())
+	}
+	return units, nil
+}
@@ -0,0 +1,55 @@
+package health
+
+import (
+	"context"
+	"time"
+
+	"github.com/a7medalyapany/GoBank.git/logger"
+	"go.uber.org/zap"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Watch runs checker every interval and publishes the result on server for
+// the overall server ("") and each of services, until ctx is cancelled.
+// Watchers of grpc.health.v1 are notified only when the status changes, and
+// each transition is logged through the logger stored in ctx.
+func Watch(ctx context.Context, checker *Checker, server *grpchealth.Server, interval time.Duration, services ...string) {
+	services = append([]string{""}, services...)
+	current := healthpb.HealthCheckResponse_UNKNOWN
+
+	update := func() {
+		report := checker.Run(ctx)
+
+		status := healthpb.HealthCheckResponse_SERVING
+		if report.Status != StatusUp {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		if status != current {
+			log := logger.FromContext(ctx)
+			if status == healthpb.HealthCheckResponse_SERVING {
+				log.Info("health status changed", zap.String("status", status.String()))
+			} else {
+				log.Warn("health status changed", zap.String("status", status.String()), zap.Any("checks", report.Checks))
+			}
+			current = status
+		}
+		for _, service := range services {
+			server.SetServingStatus(service, status)
+		}
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		update()
+
+		select {
+		case <-ctx.Done():
+			server.Shutdown()
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/a7medalyapany/GoBank.git/logger"
+	"github.com/stretchr/testify/require"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func up(ctx context.Context) error { return nil }
+
+func down(ctx context.Context) error { return errors.New("connection refused") }
+
+func hang(ctx context.Context) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestCheckerRun(t *testing.T) {
+	checker := NewChecker(50 * time.Millisecond)
+	checker.Add("postgres", up)
+	checker.Add("redis", hang)
+
+	start := time.Now()
+	report := checker.Run(context.Background())
+	require.Less(t, time.Since(start), time.Second)
+
+	require.Equal(t, StatusDown, report.Status)
+	require.Equal(t, StatusUp, report.Checks["postgres"].Status)
+	require.Equal(t, StatusDown, report.Checks["redis"].Status)
+	require.Contains(t, report.Checks["redis"].Error, "deadline exceeded")
+
+	// With leaves the original checker untouched.
+	extended := checker.With("grpc", up)
+	require.Len(t, extended.Run(context.Background()).Checks, 3)
+	require.Len(t, checker.Run(context.Background()).Checks, 2)
+}
+
+func TestReadinessHandler(t *testing.T) {
+	testCases := []struct {
+		name   string
+		check  Check
+		status int
+	}{
+		{"Up", up, http.StatusOK},
+		{"Down", down, http.StatusServiceUnavailable},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			checker := NewChecker(time.Second)
+			checker.Add("postgres", tc.check)
+
+			recorder := httptest.NewRecorder()
+			checker.ReadinessHandler()(recorder, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+			require.Equal(t, tc.status, recorder.Code)
+
+			var report Report
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &report))
+			require.Contains(t, report.Checks, "postgres")
+		})
+	}
+}
+
+func TestWatch(t *testing.T) {
+	checker := NewChecker(time.Second)
+	checker.Add("postgres", down)
+	server := grpchealth.NewServer()
+
+	log := logger.MustNew(logger.DefaultConfig("gobank-test", "test", "test"))
+	ctx, cancel := context.WithCancel(logger.IntoContext(context.Background(), log))
+	done := make(chan struct{})
+	go func() {
+		Watch(ctx, checker, server, time.Hour, "pb.GoBank")
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool {
+		resp, err := server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "pb.GoBank"})
+		return err == nil && resp.GetStatus() == healthpb.HealthCheckResponse_NOT_SERVING
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+	<-done
+}
@@ -0,0 +1,52 @@
+package logger
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ─── Per-request debug logging
+
+// DebugHeader carries a signed token that turns on debug logging for one
+// request. gRPC metadata uses the lower-case form.
+const DebugHeader = "X-Debug-Log"
+
+// SignDebugToken returns a DebugHeader value that is valid until expiresAt.
+// The format is "<unix expiry>.<hex HMAC-SHA256 of the expiry>".
+func SignDebugToken(secret []byte, expiresAt time.Time) string {
+	expiry := strconv.FormatInt(expiresAt.Unix(), 10)
+	return expiry + "." + hex.EncodeToString(debugSignature(secret, expiry))
+}
+
+// VerifyDebugToken reports whether token was signed with secret and has not
+// expired. It always fails when secret is empty, so the header is ignored
+// unless a secret is configured.
+func VerifyDebugToken(secret []byte, token string, now time.Time) bool {
+	if len(secret) == 0 || token == "" {
+		return false
+	}
+
+	expiry, sig, ok := strings.Cut(token, ".")
+	if !ok {
+		return false
+	}
+	expiresAt, err := strconv.ParseInt(expiry, 10, 64)
+	if err != nil || now.Unix() > expiresAt {
+		return false
+	}
+	got, err := hex.DecodeString(sig)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(got, debugSignature(secret, expiry))
+}
+
+func debugSignature(secret []byte, expiry string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(expiry))
+	return mac.Sum(nil)
+}
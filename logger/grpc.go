@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/a7medalyapany/GoBank.git/token"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"google.golang.org/grpc"
@@ -14,6 +15,7 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 // ─── gRPC call context
@@ -28,6 +30,7 @@ type grpcFields struct {
 	spanID    string
 	username  string
 	deadline  *time.Time
+	debug     bool
 }
 
 // ─── Options
@@ -40,10 +43,15 @@ type GRPCLogOptions struct {
 	// e.g. "/grpc.health.v1.Health/Check"
 	SkipMethods map[string]bool
 
-	// LogPayloads enables request/response payload logging.
-	// WARNING: may log sensitive data. Development only.
+	// LogPayloads enables request/response payload logging. Payloads are
+	// logged as protojson with SensitiveField values replaced by Redacted.
 	LogPayloads bool
 
+	// SensitiveField reports fields to redact from logged payloads, usually
+	// SensitiveOption(pb.E_Sensitive). Defaults to matching common secret
+	// field names such as password and refresh_token.
+	SensitiveField func(protoreflect.FieldDescriptor) bool
+
 	// DeciderFunc overrides the default level-per-RPC logic.
 	// Return log=false to suppress a call entirely.
 	DeciderFunc func(fullMethod string, err error) (zapcore.Level, bool)
@@ -53,6 +61,11 @@ type GRPCLogOptions struct {
 	RequestIDHeader string
 	TraceIDHeader   string
 	SpanIDHeader    string
+
+	// DebugSecret verifies the DebugHeader token in the incoming metadata.
+	// A call carrying a valid token is logged at debug level whatever the
+	// current level is. Empty disables the header.
+	DebugSecret []byte
 }
 
 func (o *GRPCLogOptions) logger() *Logger {
@@ -62,6 +75,13 @@ func (o *GRPCLogOptions) logger() *Logger {
 	return G()
 }
 
+func (o *GRPCLogOptions) sensitiveField() func(protoreflect.FieldDescriptor) bool {
+	if o.SensitiveField != nil {
+		return o.SensitiveField
+	}
+	return sensitiveByName
+}
+
 func (o *GRPCLogOptions) requestIDHeader() string {
 	if o.RequestIDHeader != "" {
 		return strings.ToLower(o.RequestIDHeader)
@@ -156,10 +176,10 @@ func UnaryServerInterceptor(opts GRPCLogOptions) grpc.UnaryServerInterceptor {
 
 		fields := buildFinishFields(f, code, elapsed, err)
 		if opts.LogPayloads && req != nil {
-			fields = append(fields, zap.Any("grpc.req", req))
+			fields = append(fields, payloadField("grpc.req", req, opts.sensitiveField()))
 		}
 		if opts.LogPayloads && resp != nil {
-			fields = append(fields, zap.Any("grpc.resp", resp))
+			fields = append(fields, payloadField("grpc.resp", resp, opts.sensitiveField()))
 		}
 
 		logAtLevel(FromContext(ctx), level, "gRPC", fields...)
@@ -266,6 +286,7 @@ func extractGRPCFields(ctx context.Context, fullMethod string, opts GRPCLogOptio
 		f.requestID = firstMD(md, opts.requestIDHeader())
 		f.traceID = firstMD(md, opts.traceIDHeader())
 		f.spanID = firstMD(md, opts.spanIDHeader())
+		f.debug = VerifyDebugToken(opts.DebugSecret, firstMD(md, strings.ToLower(DebugHeader)), time.Now())
 
 		// grpc-gateway forwards the browser UA under this key
 		if ua := firstMD(md, "grpcgateway-user-agent"); ua != "" {
@@ -275,6 +296,12 @@ func extractGRPCFields(ctx context.Context, fullMethod string, opts GRPCLogOptio
 		}
 	}
 
+	// An OpenTelemetry span, when there is one, is the real trace.
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		f.traceID = sc.TraceID().String()
+		f.spanID = sc.SpanID().String()
+	}
+
 	// Username — may already be in ctx if auth ran before us in the chain.
 	if payload, ok := ctx.Value(authPayloadContextKey).(*token.Payload); ok && payload != nil {
 		f.username = payload.Username
@@ -296,9 +323,15 @@ func enrichContext(ctx context.Context, f grpcFields, base *Logger) context.Cont
 	if f.traceID != "" {
 		fields = append(fields, zap.String("trace_id", f.traceID))
 	}
+	if f.spanID != "" {
+		fields = append(fields, zap.String("span_id", f.spanID))
+	}
 	if f.username != "" {
 		fields = append(fields, zap.String("user", f.username))
 	}
+	if f.debug {
+		base = base.WithDebug()
+	}
 	return IntoContext(ctx, base.With(fields...))
 }
 
@@ -8,9 +8,11 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"slices"
 	"strings"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
@@ -29,6 +31,16 @@ type HTTPLogOptions struct {
 	MaxBodyLogSize     int64
 	SensitiveHeaders   []string
 	ObservabilityPaths []string
+
+	// RedactJSONPaths lists extra JSON paths (see RedactJSON) to redact from
+	// logged request bodies, on top of the common secret keys such as
+	// password and refresh_token. Bodies that are not JSON are not logged.
+	RedactJSONPaths []string
+
+	// DebugSecret verifies the DebugHeader token. A request carrying a valid
+	// token is logged at debug level whatever the current level is. Empty
+	// disables the header.
+	DebugSecret []byte
 }
 
 func (o *HTTPLogOptions) logger() *Logger {
@@ -59,11 +71,16 @@ func (o *HTTPLogOptions) maxBodySize() int64 {
 	return 4096
 }
 
+func (o *HTTPLogOptions) redactJSONPaths() []string {
+	return append(slices.Clone(defaultSensitiveNames), o.RedactJSONPaths...)
+}
+
 func (o *HTTPLogOptions) sensitiveHeaders() map[string]bool {
 	m := map[string]bool{
 		"authorization": true,
 		"cookie":        true,
 		"set-cookie":    true,
+		"x-debug-log":   true,
 	}
 	for _, h := range o.SensitiveHeaders {
 		m[strings.ToLower(h)] = true
@@ -128,6 +145,7 @@ func HTTPMiddleware(opts HTTPLogOptions) func(http.Handler) http.Handler {
 	}
 
 	sensitive := opts.sensitiveHeaders()
+	redactPaths := opts.redactJSONPaths()
 
 	decider := opts.DeciderFunc
 	if decider == nil {
@@ -157,7 +175,13 @@ func HTTPMiddleware(opts HTTPLogOptions) func(http.Handler) http.Handler {
 				reqID = generateRequestID()
 			}
 			w.Header().Set(opts.requestIDHeader(), reqID)
+			// Forward a generated ID too, so the gRPC server logs and audits
+			// the request under the same ID.
+			r.Header.Set(opts.requestIDHeader(), reqID)
 			traceID := r.Header.Get(opts.traceIDHeader())
+			if sc := trace.SpanContextFromContext(r.Context()); sc.IsValid() {
+				traceID = sc.TraceID().String()
+			}
 
 			// ── Request-scoped logger
 			ctx := r.Context()
@@ -176,6 +200,9 @@ func HTTPMiddleware(opts HTTPLogOptions) func(http.Handler) http.Handler {
 			}
 
 			scopedLogger := opts.logger().With(baseFields...)
+			if VerifyDebugToken(opts.DebugSecret, r.Header.Get(DebugHeader), time.Now()) {
+				scopedLogger = scopedLogger.WithDebug()
+			}
 			ctx = IntoContext(ctx, scopedLogger)
 			r = r.WithContext(ctx)
 
@@ -191,9 +218,15 @@ func HTTPMiddleware(opts HTTPLogOptions) func(http.Handler) http.Handler {
 				var buf bytes.Buffer
 				limited := http.MaxBytesReader(w, r.Body, opts.maxBodySize())
 				if _, err := buf.ReadFrom(limited); err == nil {
-					scopedLogger.Debug("HTTP request body",
-						zap.ByteString("http.body", buf.Bytes()),
-					)
+					if body, ok := RedactJSON(buf.Bytes(), redactPaths); ok {
+						scopedLogger.Debug("HTTP request body",
+							zap.ByteString("http.body", body),
+						)
+					} else if buf.Len() > 0 {
+						scopedLogger.Debug("HTTP request body",
+							zap.Int("http.body_bytes", buf.Len()),
+						)
+					}
 				}
 				r.Body = io.NopCloser(&buf)
 			}
@@ -0,0 +1,80 @@
+package logger
+
+import (
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// ─── Runtime level
+
+// ParseLevel parses "debug", "info", "warn", "error" or "fatal".
+func ParseLevel(text string) (Level, error) {
+	return zapcore.ParseLevel(text)
+}
+
+// GlobalLevel returns the current level of G().
+func GlobalLevel() Level {
+	return globalLevel.Level()
+}
+
+// SetGlobalLevel changes the level of G() and every logger derived from it,
+// including the request-scoped loggers already stored in contexts.
+func SetGlobalLevel(level Level) {
+	globalLevel.SetLevel(level)
+}
+
+// Level returns the current minimum level of l.
+func (l *Logger) Level() Level {
+	return l.level.Level()
+}
+
+// SetLevel changes the minimum level of l and of every logger sharing its
+// level (all loggers derived from the same New or InitGlobal call).
+func (l *Logger) SetLevel(level Level) {
+	l.level.SetLevel(level)
+}
+
+// WithDebug returns a child Logger that writes debug entries regardless of
+// the current level. Use it to trace a single request in production.
+func (l *Logger) WithDebug() *Logger {
+	z := l.zap.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		if lc, ok := core.(*levelCore); ok {
+			return &levelCore{Core: lc.Core, enabler: DebugLevel}
+		}
+		return core
+	}))
+	return &Logger{
+		zap:        z,
+		sugar:      z.Sugar(),
+		config:     l.config,
+		level:      l.level,
+		baseFields: l.baseFields,
+	}
+}
+
+// levelCore applies the minimum level in front of the encoder tree. Keeping
+// it outermost lets WithDebug swap the level without rebuilding the cores.
+type levelCore struct {
+	zapcore.Core
+	enabler zapcore.LevelEnabler
+}
+
+func (c *levelCore) Enabled(level zapcore.Level) bool {
+	return c.enabler.Enabled(level)
+}
+
+// Level lets zapcore.LevelOf report the effective level.
+func (c *levelCore) Level() zapcore.Level {
+	return zapcore.LevelOf(c.enabler)
+}
+
+func (c *levelCore) With(fields []zapcore.Field) zapcore.Core {
+	return &levelCore{Core: c.Core.With(fields), enabler: c.enabler}
+}
+
+func (c *levelCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if !c.Enabled(entry.Level) {
+		return checked
+	}
+	return c.Core.Check(entry, checked)
+}
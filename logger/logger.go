@@ -41,7 +41,8 @@ type Config struct {
 	// Development enables pretty-printed colored console output.
 	Development bool
 
-	// Level is the minimum log level. Defaults to InfoLevel.
+	// Level is the initial minimum log level. Defaults to InfoLevel.
+	// It can be changed at runtime with SetLevel.
 	Level Level
 
 	// ServiceName is injected into every log line.
@@ -102,6 +103,10 @@ type Logger struct {
 	config Config
 	mu     sync.RWMutex
 
+	// level is shared by every logger derived from the same New call, so
+	// SetLevel on one of them affects them all.
+	level zap.AtomicLevel
+
 	// Base fields applied to every log entry.
 	baseFields []zap.Field
 }
@@ -109,11 +114,19 @@ type Logger struct {
 var (
 	global *Logger
 	once   sync.Once
+
+	// globalLevel is the level of G(). It exists before InitGlobal so the
+	// level can be read and set without a global logger, e.g. in tests.
+	globalLevel = zap.NewAtomicLevelAt(InfoLevel)
 )
 
 // New creates a new Logger from Config.
 func New(cfg Config) (*Logger, error) {
-	core, err := buildCore(cfg)
+	return newLogger(cfg, zap.NewAtomicLevelAt(cfg.Level))
+}
+
+func newLogger(cfg Config, level zap.AtomicLevel) (*Logger, error) {
+	core, err := buildCore(cfg, level)
 	if err != nil {
 		return nil, err
 	}
@@ -132,6 +145,7 @@ func New(cfg Config) (*Logger, error) {
 		zap:    z,
 		sugar:  z.Sugar(),
 		config: cfg,
+		level:  level,
 		baseFields: []zap.Field{
 			zap.String("service", cfg.ServiceName),
 			zap.String("version", cfg.ServiceVersion),
@@ -155,7 +169,8 @@ func MustNew(cfg Config) *Logger {
 func InitGlobal(cfg Config) error {
 	var initErr error
 	once.Do(func() {
-		l, err := New(cfg)
+		globalLevel.SetLevel(cfg.Level)
+		l, err := newLogger(cfg, globalLevel)
 		if err != nil {
 			initErr = err
 			return
@@ -179,7 +194,7 @@ func G() *Logger {
 
 // ─── Core builder
 
-func buildCore(cfg Config) (zapcore.Core, error) {
+func buildCore(cfg Config, level zap.AtomicLevel) (zapcore.Core, error) {
 	outWriter := cfg.Output
 	if outWriter == nil {
 		outWriter = os.Stdout
@@ -189,8 +204,6 @@ func buildCore(cfg Config) (zapcore.Core, error) {
 		errWriter = os.Stderr
 	}
 
-	atomicLevel := zap.NewAtomicLevelAt(cfg.Level)
-
 	var enc zapcore.Encoder
 	if cfg.Development {
 		enc = buildConsoleEncoder()
@@ -198,12 +211,13 @@ func buildCore(cfg Config) (zapcore.Core, error) {
 		enc = buildJSONEncoder()
 	}
 
-	// Route Error+ to stderr, everything else to stdout.
+	// Route Error+ to stderr, everything else to stdout. The minimum level
+	// is applied once, by the levelCore wrapped around the whole tree.
 	highPriority := zap.LevelEnablerFunc(func(lvl zapcore.Level) bool {
-		return lvl >= ErrorLevel && atomicLevel.Enabled(lvl)
+		return lvl >= ErrorLevel
 	})
 	lowPriority := zap.LevelEnablerFunc(func(lvl zapcore.Level) bool {
-		return lvl < ErrorLevel && atomicLevel.Enabled(lvl)
+		return lvl < ErrorLevel
 	})
 
 	highCore := zapcore.NewCore(enc, zapcore.AddSync(errWriter), highPriority)
@@ -212,15 +226,15 @@ func buildCore(cfg Config) (zapcore.Core, error) {
 	combined := zapcore.NewTee(lowCore, highCore)
 
 	if cfg.Sampling != nil {
-		return zapcore.NewSamplerWithOptions(
+		combined = zapcore.NewSamplerWithOptions(
 			combined,
 			time.Second,
 			cfg.Sampling.Initial,
 			cfg.Sampling.Thereafter,
-		), nil
+		)
 	}
 
-	return combined, nil
+	return &levelCore{Core: combined, enabler: level}, nil
 }
 
 // ─── Encoders
@@ -258,6 +272,7 @@ func (l *Logger) With(fields ...zap.Field) *Logger {
 		zap:        l.zap.With(fields...),
 		sugar:      l.zap.With(fields...).Sugar(),
 		config:     l.config,
+		level:      l.level,
 		baseFields: l.baseFields,
 	}
 }
@@ -0,0 +1,106 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func newTestLogger(t *testing.T, level Level) (*Logger, *bytes.Buffer) {
+	t.Helper()
+
+	var out bytes.Buffer
+	l, err := New(Config{Level: level, Output: &out, ErrOutput: &out})
+	require.NoError(t, err)
+	return l, &out
+}
+
+func TestSetLevel(t *testing.T) {
+	l, out := newTestLogger(t, InfoLevel)
+	child := l.With()
+
+	child.Debug("hidden")
+	require.Empty(t, out.String())
+
+	l.SetLevel(DebugLevel)
+	require.Equal(t, DebugLevel, child.Level())
+
+	child.Debug("shown")
+	require.Contains(t, out.String(), "shown")
+}
+
+func TestWithDebug(t *testing.T) {
+	l, out := newTestLogger(t, WarnLevel)
+
+	l.WithDebug().With().Debug("forced")
+	require.Contains(t, out.String(), "forced")
+
+	out.Reset()
+	l.Debug("hidden")
+	l.Info("hidden")
+	require.Empty(t, out.String())
+}
+
+func TestDebugToken(t *testing.T) {
+	secret := []byte("secret")
+	now := time.Now()
+	token := SignDebugToken(secret, now.Add(time.Minute))
+
+	require.True(t, VerifyDebugToken(secret, token, now))
+	require.False(t, VerifyDebugToken(secret, token, now.Add(2*time.Minute)))
+	require.False(t, VerifyDebugToken([]byte("other"), token, now))
+	require.False(t, VerifyDebugToken(nil, token, now))
+	require.False(t, VerifyDebugToken(secret, "garbage", now))
+}
+
+func TestHTTPMiddlewareDebugHeader(t *testing.T) {
+	l, out := newTestLogger(t, InfoLevel)
+	secret := []byte("secret")
+
+	handler := HTTPMiddleware(HTTPLogOptions{Logger: l, DebugSecret: secret})(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			FromContext(r.Context()).Debug("handler debug")
+		}),
+	)
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v1/accounts", nil))
+	require.NotContains(t, out.String(), "handler debug")
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/accounts", nil)
+	req.Header.Set(DebugHeader, SignDebugToken(secret, time.Now().Add(time.Minute)))
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	require.Contains(t, out.String(), "handler debug")
+}
+
+func TestUnaryServerInterceptorDebugHeader(t *testing.T) {
+	l, out := newTestLogger(t, InfoLevel)
+	secret := []byte("secret")
+
+	interceptor := UnaryServerInterceptor(GRPCLogOptions{Logger: l, DebugSecret: secret})
+	info := &grpc.UnaryServerInfo{FullMethod: "/pb.GoBank/ListAccounts"}
+	handler := func(ctx context.Context, req any) (any, error) {
+		FromContext(ctx).Debug("handler debug")
+		return nil, nil
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"x-debug-log", SignDebugToken([]byte("wrong"), time.Now().Add(time.Minute)),
+	))
+	_, err := interceptor(ctx, nil, info, handler)
+	require.NoError(t, err)
+	require.NotContains(t, out.String(), "handler debug")
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"x-debug-log", SignDebugToken(secret, time.Now().Add(time.Minute)),
+	))
+	_, err = interceptor(ctx, nil, info, handler)
+	require.NoError(t, err)
+	require.Contains(t, out.String(), "handler debug")
+}
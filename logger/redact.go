@@ -0,0 +1,170 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ─── Payload redaction
+
+// Redacted replaces the value of every sensitive field in logged payloads.
+const Redacted = "[REDACTED]"
+
+// defaultSensitiveNames are field names redacted when no SensitiveField is
+// configured, and the top-level JSON keys always redacted from HTTP bodies.
+var defaultSensitiveNames = []string{
+	"password",
+	"access_token",
+	"refresh_token",
+	"secret_code",
+	"secret",
+	"code",
+	"token",
+	"key",
+}
+
+// SensitiveOption returns a GRPCLogOptions.SensitiveField that reports fields
+// marked with the bool field option ext, e.g. pb.E_Sensitive.
+func SensitiveOption(ext protoreflect.ExtensionType) func(protoreflect.FieldDescriptor) bool {
+	return func(fd protoreflect.FieldDescriptor) bool {
+		marked, _ := proto.GetExtension(fd.Options(), ext).(bool)
+		return marked
+	}
+}
+
+func sensitiveByName(fd protoreflect.FieldDescriptor) bool {
+	return slices.Contains(defaultSensitiveNames, string(fd.Name()))
+}
+
+// RedactProto returns a copy of msg in which every populated field for which
+// sensitive returns true holds Redacted (string and bytes fields) or is
+// cleared (any other kind). Nested messages, lists and maps are walked.
+func RedactProto(msg proto.Message, sensitive func(protoreflect.FieldDescriptor) bool) proto.Message {
+	clone := proto.Clone(msg)
+	redactMessage(clone.ProtoReflect(), sensitive)
+	return clone
+}
+
+func redactMessage(m protoreflect.Message, sensitive func(protoreflect.FieldDescriptor) bool) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if sensitive(fd) {
+			redactField(m, fd)
+			return true
+		}
+
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				redactMessage(list.Get(i).Message(), sensitive)
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				redactMessage(mv.Message(), sensitive)
+				return true
+			})
+		case fd.Message() != nil && !fd.IsList() && !fd.IsMap():
+			redactMessage(v.Message(), sensitive)
+		}
+		return true
+	})
+}
+
+func redactField(m protoreflect.Message, fd protoreflect.FieldDescriptor) {
+	if fd.IsList() || fd.IsMap() {
+		m.Clear(fd)
+		return
+	}
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		m.Set(fd, protoreflect.ValueOfString(Redacted))
+	case protoreflect.BytesKind:
+		m.Set(fd, protoreflect.ValueOfBytes([]byte(Redacted)))
+	default:
+		m.Clear(fd)
+	}
+}
+
+// payloadField logs v under key. Proto messages are redacted and written as
+// protojson so field names match the API; anything else is dropped, since
+// it cannot be redacted.
+func payloadField(key string, v any, sensitive func(protoreflect.FieldDescriptor) bool) zap.Field {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return zap.Skip()
+	}
+	b, err := protojson.Marshal(RedactProto(msg, sensitive))
+	if err != nil {
+		return zap.Skip()
+	}
+	return zap.Any(key, json.RawMessage(b))
+}
+
+// RedactJSON returns body with the value at each of paths replaced by
+// Redacted. A path is a dot-separated list of object keys from the root, and
+// "*" matches any key or array element, so "api_keys.*.key" redacts the key
+// of every element of api_keys. Keys written in snake_case also match their
+// lowerCamelCase JSON name (refresh_token matches refreshToken), since the
+// gateway accepts both. ok is false when body is not valid JSON.
+func RedactJSON(body []byte, paths []string) (redacted []byte, ok bool) {
+	// UseNumber keeps large IDs and amounts exactly as they were sent.
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var doc any
+	if err := dec.Decode(&doc); err != nil || dec.More() {
+		return nil, false
+	}
+	for _, path := range paths {
+		if path != "" {
+			doc = redactPath(doc, strings.Split(path, "."))
+		}
+	}
+	redacted, err := json.Marshal(doc)
+	if err != nil {
+		return nil, false
+	}
+	return redacted, true
+}
+
+func redactPath(node any, path []string) any {
+	if len(path) == 0 {
+		return Redacted
+	}
+	segment, rest := path[0], path[1:]
+
+	switch n := node.(type) {
+	case map[string]any:
+		camel := lowerCamel(segment)
+		for key, child := range n {
+			if segment == "*" || segment == key || camel == key {
+				n[key] = redactPath(child, rest)
+			}
+		}
+	case []any:
+		for i, child := range n {
+			if segment == "*" || segment == strconv.Itoa(i) {
+				n[i] = redactPath(child, rest)
+			}
+		}
+	}
+	return node
+}
+
+// lowerCamel converts a snake_case name to the lowerCamelCase protojson uses.
+func lowerCamel(name string) string {
+	parts := strings.Split(name, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
@@ -0,0 +1,110 @@
+package logger
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/a7medalyapany/GoBank.git/pb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRedactProto(t *testing.T) {
+	sensitive := SensitiveOption(pb.E_Sensitive)
+
+	req := &pb.LoginUserRequest{Username: "alice", Password: "supersecret123"}
+	redacted := RedactProto(req, sensitive).(*pb.LoginUserRequest)
+	require.Equal(t, "alice", redacted.Username)
+	require.Equal(t, Redacted, redacted.Password)
+	require.Equal(t, "supersecret123", req.Password, "original must not change")
+
+	resp := &pb.LoginUserResponse{
+		SessionId:    "session",
+		AccessToken:  "v2.local.access",
+		RefreshToken: "v2.local.refresh",
+		User:         &pb.User{Username: "alice"},
+	}
+	redactedResp := RedactProto(resp, sensitive).(*pb.LoginUserResponse)
+	require.Equal(t, "session", redactedResp.SessionId)
+	require.Equal(t, Redacted, redactedResp.AccessToken)
+	require.Equal(t, Redacted, redactedResp.RefreshToken)
+	require.Equal(t, "alice", redactedResp.User.Username)
+
+	// Unset sensitive fields stay unset.
+	empty := RedactProto(&pb.UpdateUserRequest{Username: "alice"}, sensitive).(*pb.UpdateUserRequest)
+	require.Nil(t, empty.Password)
+}
+
+func TestRedactProtoByName(t *testing.T) {
+	resp := &pb.CreateApiKeyResponse{ApiKey: &pb.ApiKey{Prefix: "3f9a1c0e"}, Key: "gbk_3f9a1c0e_secret"}
+	redacted := RedactProto(resp, sensitiveByName).(*pb.CreateApiKeyResponse)
+	require.Equal(t, Redacted, redacted.Key)
+	require.Equal(t, "3f9a1c0e", redacted.ApiKey.Prefix)
+}
+
+func TestRedactJSON(t *testing.T) {
+	body := []byte(`{"username":"alice","refreshToken":"abc","user":{"password":"x"},"keys":[{"key":"k1","id":1},{"key":"k2","id":2}]}`)
+
+	redacted, ok := RedactJSON(body, []string{"refresh_token", "user.password", "keys.*.key", "missing.path"})
+	require.True(t, ok)
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(redacted, &got))
+	require.Equal(t, "alice", got["username"])
+	require.Equal(t, Redacted, got["refreshToken"])
+	require.Equal(t, Redacted, got["user"].(map[string]any)["password"])
+	for _, k := range got["keys"].([]any) {
+		require.Equal(t, Redacted, k.(map[string]any)["key"])
+		require.NotEqual(t, Redacted, k.(map[string]any)["id"])
+	}
+
+	_, ok = RedactJSON([]byte("password=hunter2"), []string{"password"})
+	require.False(t, ok)
+}
+
+func TestHTTPMiddlewareRedactsBody(t *testing.T) {
+	l, out := newTestLogger(t, DebugLevel)
+
+	handler := HTTPMiddleware(HTTPLogOptions{
+		Logger:          l,
+		LogRequestBody:  true,
+		RedactJSONPaths: []string{"profile.phone"},
+	})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	body := `{"username":"alice","password":"supersecret123","profile":{"phone":"+201000000000"}}`
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/v1/users", strings.NewReader(body)))
+	require.Contains(t, out.String(), "alice")
+	require.NotContains(t, out.String(), "supersecret123")
+	require.NotContains(t, out.String(), "+201000000000")
+
+	out.Reset()
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/v1/users", strings.NewReader("password=supersecret123")))
+	require.NotContains(t, out.String(), "supersecret123")
+}
+
+func TestUnaryServerInterceptorRedactsPayloads(t *testing.T) {
+	l, out := newTestLogger(t, InfoLevel)
+
+	interceptor := UnaryServerInterceptor(GRPCLogOptions{
+		Logger:         l,
+		LogPayloads:    true,
+		SensitiveField: SensitiveOption(pb.E_Sensitive),
+	})
+	info := &grpc.UnaryServerInfo{FullMethod: "/pb.GoBank/RenewAccessToken"}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return &pb.RenewAccessTokenResponse{AccessToken: "new-access-token"}, nil
+	}
+
+	req := &pb.RenewAccessTokenRequest{RefreshToken: "old-refresh-token"}
+	_, err := interceptor(context.Background(), req, info, handler)
+	require.NoError(t, err)
+	require.Contains(t, out.String(), Redacted)
+	require.NotContains(t, out.String(), "old-refresh-token")
+	require.NotContains(t, out.String(), "new-access-token")
+	require.True(t, proto.Equal(req, &pb.RenewAccessTokenRequest{RefreshToken: "old-refresh-token"}))
+}
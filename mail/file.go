@@ -0,0 +1,105 @@
+package mail
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// FileSender appends every message to a local mbox file instead of sending it.
+// Useful for development and CI: open the file with any mail client, or just
+// read it to grab a verification link.
+type FileSender struct {
+	name             string
+	fromEmailAddress string
+	path             string
+	mu               sync.Mutex
+}
+
+func NewFileSender(name, fromEmailAddress, path string) (*FileSender, error) {
+	name, fromEmailAddress, err := normalizeSender(name, fromEmailAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if path == "" {
+		return nil, fmt.Errorf("mail file path cannot be empty")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create mail directory: %w", err)
+	}
+
+	return &FileSender{
+		name:             name,
+		fromEmailAddress: fromEmailAddress,
+		path:             path,
+	}, nil
+}
+
+func (sender *FileSender) SendEmail(
+	subject string,
+	content string,
+	to []string,
+	cc []string,
+	bcc []string,
+	attachFiles []string,
+) error {
+	return sender.SendMessage(plainMessage(subject, content, to, cc, bcc, attachFiles))
+}
+
+func (sender *FileSender) SendMessage(msg Message) error {
+	e, err := newMessage(sender.name, sender.fromEmailAddress, msg)
+	if err != nil {
+		return err
+	}
+
+	var raw bytes.Buffer
+	if _, err := e.WriteTo(&raw); err != nil {
+		return fmt.Errorf("failed to render email: %w", err)
+	}
+
+	sender.mu.Lock()
+	defer sender.mu.Unlock()
+
+	f, err := os.OpenFile(sender.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open mail file: %w", err)
+	}
+	defer f.Close()
+
+	if err := writeMbox(f, sender.fromEmailAddress, time.Now(), raw.Bytes()); err != nil {
+		return fmt.Errorf("failed to write email: %w", err)
+	}
+
+	return nil
+}
+
+// writeMbox writes one message in mboxrd format: a "From " separator line,
+// the message with body lines starting with ">*From " quoted, and a blank line.
+func writeMbox(out io.Writer, from string, at time.Time, raw []byte) error {
+	w := bufio.NewWriter(out)
+	fmt.Fprintf(w, "From %s %s\n", from, at.UTC().Format(time.ANSIC))
+
+	scanner := bufio.NewScanner(bytes.NewReader(raw))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(raw)+1)
+	for scanner.Scan() {
+		line := bytes.TrimSuffix(scanner.Bytes(), []byte("\r"))
+		if bytes.HasPrefix(bytes.TrimLeft(line, ">"), []byte("From ")) {
+			w.WriteByte('>')
+		}
+		w.Write(line)
+		w.WriteByte('\n')
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	w.WriteByte('\n')
+	return w.Flush()
+}
@@ -0,0 +1,44 @@
+package mail
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileSender(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "mail.mbox")
+
+	sender, err := NewFileSender("Go Bank", "sender@example.com", path)
+	require.NoError(t, err)
+
+	require.NoError(t, sender.SendEmail("first", "hello\nFrom the bank", []string{"a@example.com"}, nil, nil, nil))
+	require.NoError(t, sender.SendEmail("second", "bye", []string{"b@example.com"}, nil, nil, nil))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	mbox := string(data)
+
+	separators := 0
+	for _, line := range strings.Split(mbox, "\n") {
+		if strings.HasPrefix(line, "From sender@example.com ") {
+			separators++
+		}
+	}
+	require.Equal(t, 2, separators)
+	require.Contains(t, mbox, "Subject: first")
+	require.Contains(t, mbox, "Subject: second")
+	require.Contains(t, mbox, "\n>From the bank\n")
+}
+
+func TestFileSenderRequiresRecipient(t *testing.T) {
+	sender, err := NewFileSender("Go Bank", "sender@example.com", filepath.Join(t.TempDir(), "mail.mbox"))
+	require.NoError(t, err)
+
+	err = sender.SendEmail("subject", "content", nil, nil, nil, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "at least one recipient is required")
+}
@@ -0,0 +1,69 @@
+package mail
+
+import (
+	"slices"
+	"sync"
+)
+
+// MemorySender keeps sent messages in memory so tests can assert on them.
+// It applies the same validation as the real senders.
+type MemorySender struct {
+	name             string
+	fromEmailAddress string
+	mu               sync.Mutex
+	messages         []Message
+}
+
+func NewMemorySender(name, fromEmailAddress string) (*MemorySender, error) {
+	name, fromEmailAddress, err := normalizeSender(name, fromEmailAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MemorySender{
+		name:             name,
+		fromEmailAddress: fromEmailAddress,
+	}, nil
+}
+
+func (sender *MemorySender) SendEmail(
+	subject string,
+	content string,
+	to []string,
+	cc []string,
+	bcc []string,
+	attachFiles []string,
+) error {
+	return sender.SendMessage(plainMessage(subject, content, to, cc, bcc, attachFiles))
+}
+
+func (sender *MemorySender) SendMessage(msg Message) error {
+	if _, err := newMessage(sender.name, sender.fromEmailAddress, msg); err != nil {
+		return err
+	}
+
+	msg.To = slices.Clone(msg.To)
+	msg.Cc = slices.Clone(msg.Cc)
+	msg.Bcc = slices.Clone(msg.Bcc)
+	msg.AttachFiles = slices.Clone(msg.AttachFiles)
+
+	sender.mu.Lock()
+	defer sender.mu.Unlock()
+
+	sender.messages = append(sender.messages, msg)
+	return nil
+}
+
+// Messages returns a copy of every message sent so far.
+func (sender *MemorySender) Messages() []Message {
+	sender.mu.Lock()
+	defer sender.mu.Unlock()
+	return slices.Clone(sender.messages)
+}
+
+// Reset forgets all captured messages.
+func (sender *MemorySender) Reset() {
+	sender.mu.Lock()
+	defer sender.mu.Unlock()
+	sender.messages = nil
+}
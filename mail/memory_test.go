@@ -0,0 +1,28 @@
+package mail
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemorySender(t *testing.T) {
+	sender, err := NewMemorySender("Go Bank", "sender@example.com")
+	require.NoError(t, err)
+
+	err = sender.SendEmail("subject", "content", []string{"to@example.com"}, []string{"cc@example.com"}, nil, nil)
+	require.NoError(t, err)
+
+	messages := sender.Messages()
+	require.Len(t, messages, 1)
+	require.Equal(t, "subject", messages[0].Subject)
+	require.Equal(t, "content", messages[0].TextBody)
+	require.Equal(t, []string{"to@example.com"}, messages[0].To)
+	require.Equal(t, []string{"cc@example.com"}, messages[0].Cc)
+
+	require.Error(t, sender.SendEmail("subject", "content", nil, nil, nil, nil))
+	require.Len(t, sender.Messages(), 1)
+
+	sender.Reset()
+	require.Empty(t, sender.Messages())
+}
@@ -0,0 +1,41 @@
+package mail
+
+import (
+	"fmt"
+
+	"github.com/a7medalyapany/GoBank.git/util"
+)
+
+// Email providers selectable with EMAIL_PROVIDER.
+const (
+	ProviderGmail  = "gmail"
+	ProviderSMTP   = "smtp"
+	ProviderFile   = "file"
+	ProviderMemory = "memory"
+)
+
+// NewEmailSender builds the EmailSender selected by config.EMAIL_PROVIDER:
+//
+//	gmail   → smtp.gmail.com with EMAIL_SENDER_PASSWORD (default)
+//	smtp    → any relay described by SMTP_HOST / SMTP_PORT / SMTP_TLS_POLICY
+//	file    → appends to the mbox file at EMAIL_FILE_PATH
+//	memory  → keeps messages in memory (tests only)
+func NewEmailSender(config util.Config) (EmailSender, error) {
+	switch config.EMAIL_PROVIDER {
+	case "", ProviderGmail:
+		return NewGmailSender(config.EMAIL_SENDER_NAME, config.EMAIL_SENDER_ADDRESS, config.EMAIL_SENDER_PASSWORD)
+	case ProviderSMTP:
+		return NewSMTPSender(config.EMAIL_SENDER_NAME, config.EMAIL_SENDER_ADDRESS, SMTPConfig{
+			Host:      config.SMTP_HOST,
+			Port:      config.SMTP_PORT,
+			Username:  config.SMTP_USERNAME,
+			Password:  config.SMTP_PASSWORD,
+			TLSPolicy: config.SMTP_TLS_POLICY,
+		})
+	case ProviderFile:
+		return NewFileSender(config.EMAIL_SENDER_NAME, config.EMAIL_SENDER_ADDRESS, config.EMAIL_FILE_PATH)
+	case ProviderMemory:
+		return NewMemorySender(config.EMAIL_SENDER_NAME, config.EMAIL_SENDER_ADDRESS)
+	}
+	return nil, fmt.Errorf("unsupported email provider %q", config.EMAIL_PROVIDER)
+}
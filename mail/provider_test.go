@@ -0,0 +1,37 @@
+package mail
+
+import (
+	"testing"
+
+	"github.com/a7medalyapany/GoBank.git/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEmailSender(t *testing.T) {
+	config := util.Config{
+		EMAIL_SENDER_NAME:     "Go Bank",
+		EMAIL_SENDER_ADDRESS:  "sender@example.com",
+		EMAIL_SENDER_PASSWORD: "app-password",
+		EMAIL_FILE_PATH:       t.TempDir() + "/mail.mbox",
+		SMTP_HOST:             "localhost",
+		SMTP_PORT:             1025,
+		SMTP_TLS_POLICY:       TLSPolicyNone,
+	}
+
+	for provider, want := range map[string]EmailSender{
+		"":             &GmailSender{},
+		ProviderGmail:  &GmailSender{},
+		ProviderSMTP:   &SMTPSender{},
+		ProviderFile:   &FileSender{},
+		ProviderMemory: &MemorySender{},
+	} {
+		config.EMAIL_PROVIDER = provider
+		sender, err := NewEmailSender(config)
+		require.NoError(t, err, provider)
+		require.IsType(t, want, sender, provider)
+	}
+
+	config.EMAIL_PROVIDER = "pigeon"
+	_, err := NewEmailSender(config)
+	require.Error(t, err)
+}
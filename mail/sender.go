@@ -15,6 +15,7 @@ const (
 )
 
 type EmailSender interface {
+	// SendEmail sends a plain-text email.
 	SendEmail(
 		subject string,
 		content string,
@@ -23,6 +24,33 @@ type EmailSender interface {
 		bcc []string,
 		attachFiles []string,
 	) error
+
+	// SendMessage sends msg, as multipart/alternative when it has an HTML body.
+	SendMessage(msg Message) error
+}
+
+// Message is a complete email. TextBody is always sent; when HTMLBody is set
+// the email becomes multipart/alternative and clients pick the richest part.
+type Message struct {
+	Subject     string
+	TextBody    string
+	HTMLBody    string
+	To          []string
+	Cc          []string
+	Bcc         []string
+	AttachFiles []string
+}
+
+// plainMessage adapts the SendEmail arguments to a Message.
+func plainMessage(subject, content string, to, cc, bcc, attachFiles []string) Message {
+	return Message{
+		Subject:     subject,
+		TextBody:    content,
+		To:          to,
+		Cc:          cc,
+		Bcc:         bcc,
+		AttachFiles: attachFiles,
+	}
 }
 
 type smtpClient interface {
@@ -39,18 +67,12 @@ type GmailSender struct {
 }
 
 func NewGmailSender(name, fromEmailAddress, fromEmailPassword string) (*GmailSender, error) {
-	name = strings.TrimSpace(name)
-	fromEmailAddress = strings.TrimSpace(fromEmailAddress)
-	fromEmailPassword = strings.TrimSpace(fromEmailPassword)
-
-	if name == "" {
-		return nil, fmt.Errorf("sender name cannot be empty")
-	}
-
-	if _, err := stdmail.ParseAddress(fromEmailAddress); err != nil {
-		return nil, fmt.Errorf("invalid sender email address: %w", err)
+	name, fromEmailAddress, err := normalizeSender(name, fromEmailAddress)
+	if err != nil {
+		return nil, err
 	}
 
+	fromEmailPassword = strings.TrimSpace(fromEmailPassword)
 	if fromEmailPassword == "" {
 		return nil, fmt.Errorf("sender password cannot be empty")
 	}
@@ -82,61 +104,95 @@ func (sender *GmailSender) SendEmail(
 	bcc []string,
 	attachFiles []string,
 ) error {
-	if len(to) == 0 {
-		return fmt.Errorf("at least one recipient is required")
+	return sender.SendMessage(plainMessage(subject, content, to, cc, bcc, attachFiles))
+}
+
+func (sender *GmailSender) SendMessage(msg Message) error {
+	e, err := newMessage(sender.name, sender.fromEmailAddress, msg)
+	if err != nil {
+		return err
+	}
+
+	newClient := sender.newClient
+	if newClient == nil {
+		newClient = newGmailClient
+	}
+
+	d, err := newClient(sender.fromEmailAddress, sender.fromEmailPassword)
+	if err != nil {
+		return fmt.Errorf("failed to create smtp client: %w", err)
+	}
+
+	if err := d.DialAndSend(e); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+
+	return nil
+}
+
+// normalizeSender trims and validates the From identity used by every sender.
+func normalizeSender(name, fromEmailAddress string) (string, string, error) {
+	name = strings.TrimSpace(name)
+	fromEmailAddress = strings.TrimSpace(fromEmailAddress)
+
+	if name == "" {
+		return "", "", fmt.Errorf("sender name cannot be empty")
+	}
+
+	if _, err := stdmail.ParseAddress(fromEmailAddress); err != nil {
+		return "", "", fmt.Errorf("invalid sender email address: %w", err)
+	}
+
+	return name, fromEmailAddress, nil
+}
+
+// newMessage builds the gomail message for msg. It is shared by every sender
+// so they all validate recipients and attachments the same way.
+func newMessage(name string, fromEmailAddress string, msg Message) (*gomail.Msg, error) {
+	if len(msg.To) == 0 {
+		return nil, fmt.Errorf("at least one recipient is required")
 	}
 
 	e := gomail.NewMsg()
 
-	if err := e.FromFormat(sender.name, sender.fromEmailAddress); err != nil {
-		return fmt.Errorf("failed to set from address: %w", err)
+	if err := e.FromFormat(name, fromEmailAddress); err != nil {
+		return nil, fmt.Errorf("failed to set from address: %w", err)
 	}
 
-	if err := e.To(to...); err != nil {
-		return fmt.Errorf("failed to set to recipients: %w", err)
+	if err := e.To(msg.To...); err != nil {
+		return nil, fmt.Errorf("failed to set to recipients: %w", err)
 	}
 
-	if len(cc) > 0 {
-		if err := e.Cc(cc...); err != nil {
-			return fmt.Errorf("failed to set cc recipients: %w", err)
+	if len(msg.Cc) > 0 {
+		if err := e.Cc(msg.Cc...); err != nil {
+			return nil, fmt.Errorf("failed to set cc recipients: %w", err)
 		}
 	}
 
-	if len(bcc) > 0 {
-		if err := e.Bcc(bcc...); err != nil {
-			return fmt.Errorf("failed to set bcc recipients: %w", err)
+	if len(msg.Bcc) > 0 {
+		if err := e.Bcc(msg.Bcc...); err != nil {
+			return nil, fmt.Errorf("failed to set bcc recipients: %w", err)
 		}
 	}
 
-	e.Subject(subject)
-	e.SetBodyString(gomail.TypeTextPlain, content)
+	e.Subject(msg.Subject)
+	e.SetBodyString(gomail.TypeTextPlain, msg.TextBody)
+	if msg.HTMLBody != "" {
+		e.AddAlternativeString(gomail.TypeTextHTML, msg.HTMLBody)
+	}
 
-	for _, attachFile := range attachFiles {
+	for _, attachFile := range msg.AttachFiles {
 		attachFile = strings.TrimSpace(attachFile)
 		if attachFile == "" {
-			return fmt.Errorf("attachment file path cannot be empty")
+			return nil, fmt.Errorf("attachment file path cannot be empty")
 		}
 
 		if _, err := os.Stat(attachFile); err != nil {
-			return fmt.Errorf("failed to access attachment file %s: %w", attachFile, err)
+			return nil, fmt.Errorf("failed to access attachment file %s: %w", attachFile, err)
 		}
 
 		e.AttachFile(attachFile)
 	}
 
-	newClient := sender.newClient
-	if newClient == nil {
-		newClient = newGmailClient
-	}
-
-	d, err := newClient(sender.fromEmailAddress, sender.fromEmailPassword)
-	if err != nil {
-		return fmt.Errorf("failed to create smtp client: %w", err)
-	}
-
-	if err := d.DialAndSend(e); err != nil {
-		return fmt.Errorf("failed to send email: %w", err)
-	}
-
-	return nil
+	return e, nil
 }
@@ -0,0 +1,124 @@
+package mail
+
+import (
+	"fmt"
+
+	gomail "github.com/wneessen/go-mail"
+)
+
+// Supported SMTP TLS policies.
+const (
+	TLSPolicyMandatory     = "mandatory"     // STARTTLS required
+	TLSPolicyOpportunistic = "opportunistic" // STARTTLS if the server offers it
+	TLSPolicyImplicit      = "implicit"      // TLS from the first byte (usually port 465)
+	TLSPolicyNone          = "none"          // plain text, e.g. Mailpit/MailHog on localhost
+)
+
+// SMTPConfig describes a generic SMTP relay.
+// Username and Password are optional; leave them empty for relays without auth.
+type SMTPConfig struct {
+	Host      string
+	Port      int
+	Username  string
+	Password  string
+	TLSPolicy string
+}
+
+// SMTPSender sends email through any SMTP server.
+type SMTPSender struct {
+	name             string
+	fromEmailAddress string
+	config           SMTPConfig
+	newClient        func(config SMTPConfig) (smtpClient, error)
+}
+
+func NewSMTPSender(name, fromEmailAddress string, config SMTPConfig) (*SMTPSender, error) {
+	name, fromEmailAddress, err := normalizeSender(name, fromEmailAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Host == "" {
+		return nil, fmt.Errorf("smtp host cannot be empty")
+	}
+
+	if config.Port <= 0 || config.Port > 65535 {
+		return nil, fmt.Errorf("invalid smtp port %d", config.Port)
+	}
+
+	if config.TLSPolicy == "" {
+		config.TLSPolicy = TLSPolicyMandatory
+	}
+
+	switch config.TLSPolicy {
+	case TLSPolicyMandatory, TLSPolicyOpportunistic, TLSPolicyImplicit, TLSPolicyNone:
+	default:
+		return nil, fmt.Errorf("unsupported smtp tls policy %q", config.TLSPolicy)
+	}
+
+	return &SMTPSender{
+		name:             name,
+		fromEmailAddress: fromEmailAddress,
+		config:           config,
+		newClient:        newSMTPClient,
+	}, nil
+}
+
+func newSMTPClient(config SMTPConfig) (smtpClient, error) {
+	opts := []gomail.Option{gomail.WithPort(config.Port)}
+
+	switch config.TLSPolicy {
+	case TLSPolicyMandatory:
+		opts = append(opts, gomail.WithTLSPolicy(gomail.TLSMandatory))
+	case TLSPolicyOpportunistic:
+		opts = append(opts, gomail.WithTLSPolicy(gomail.TLSOpportunistic))
+	case TLSPolicyImplicit:
+		opts = append(opts, gomail.WithSSL())
+	case TLSPolicyNone:
+		opts = append(opts, gomail.WithTLSPolicy(gomail.NoTLS))
+	}
+
+	if config.Username != "" {
+		opts = append(opts,
+			gomail.WithSMTPAuth(gomail.SMTPAuthPlain),
+			gomail.WithUsername(config.Username),
+			gomail.WithPassword(config.Password),
+		)
+	}
+
+	return gomail.NewClient(config.Host, opts...)
+}
+
+func (sender *SMTPSender) SendEmail(
+	subject string,
+	content string,
+	to []string,
+	cc []string,
+	bcc []string,
+	attachFiles []string,
+) error {
+	return sender.SendMessage(plainMessage(subject, content, to, cc, bcc, attachFiles))
+}
+
+func (sender *SMTPSender) SendMessage(msg Message) error {
+	e, err := newMessage(sender.name, sender.fromEmailAddress, msg)
+	if err != nil {
+		return err
+	}
+
+	newClient := sender.newClient
+	if newClient == nil {
+		newClient = newSMTPClient
+	}
+
+	d, err := newClient(sender.config)
+	if err != nil {
+		return fmt.Errorf("failed to create smtp client: %w", err)
+	}
+
+	if err := d.DialAndSend(e); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package mail
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	gomail "github.com/wneessen/go-mail"
+)
+
+func TestNewSMTPSender(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success with default tls policy", func(t *testing.T) {
+		t.Parallel()
+
+		sender, err := NewSMTPSender("Go Bank", "sender@example.com", SMTPConfig{Host: "localhost", Port: 1025})
+		require.NoError(t, err)
+		require.Equal(t, TLSPolicyMandatory, sender.config.TLSPolicy)
+		require.NotNil(t, sender.newClient)
+	})
+
+	t.Run("empty host", func(t *testing.T) {
+		t.Parallel()
+
+		sender, err := NewSMTPSender("Go Bank", "sender@example.com", SMTPConfig{Port: 25})
+		require.Error(t, err)
+		require.Nil(t, sender)
+		require.Contains(t, err.Error(), "smtp host cannot be empty")
+	})
+
+	t.Run("invalid port", func(t *testing.T) {
+		t.Parallel()
+
+		sender, err := NewSMTPSender("Go Bank", "sender@example.com", SMTPConfig{Host: "localhost", Port: 70000})
+		require.Error(t, err)
+		require.Nil(t, sender)
+		require.Contains(t, err.Error(), "invalid smtp port")
+	})
+
+	t.Run("unsupported tls policy", func(t *testing.T) {
+		t.Parallel()
+
+		sender, err := NewSMTPSender("Go Bank", "sender@example.com", SMTPConfig{Host: "localhost", Port: 25, TLSPolicy: "sometimes"})
+		require.Error(t, err)
+		require.Nil(t, sender)
+		require.Contains(t, err.Error(), "unsupported smtp tls policy")
+	})
+
+	t.Run("client options are valid for every policy", func(t *testing.T) {
+		t.Parallel()
+
+		for _, policy := range []string{TLSPolicyMandatory, TLSPolicyOpportunistic, TLSPolicyImplicit, TLSPolicyNone} {
+			client, err := newSMTPClient(SMTPConfig{Host: "localhost", Port: 1025, Username: "user", Password: "pass", TLSPolicy: policy})
+			require.NoError(t, err, policy)
+			require.NotNil(t, client)
+		}
+	})
+}
+
+func TestSMTPSenderSendEmail(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns send error", func(t *testing.T) {
+		t.Parallel()
+
+		client := &mockSMTPClient{err: errors.New("send failed")}
+		sender := &SMTPSender{
+			name:             "Go Bank",
+			fromEmailAddress: "sender@example.com",
+			config:           SMTPConfig{Host: "localhost", Port: 1025},
+			newClient: func(config SMTPConfig) (smtpClient, error) {
+				return client, nil
+			},
+		}
+
+		err := sender.SendEmail("subject", "content", []string{"to@example.com"}, nil, nil, nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to send email")
+		require.Len(t, client.messages, 1)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		client := &mockSMTPClient{}
+		var gotConfig SMTPConfig
+		sender := &SMTPSender{
+			name:             "Go Bank",
+			fromEmailAddress: "sender@example.com",
+			config:           SMTPConfig{Host: "mail.internal", Port: 2525, TLSPolicy: TLSPolicyNone},
+			newClient: func(config SMTPConfig) (smtpClient, error) {
+				gotConfig = config
+				return client, nil
+			},
+		}
+
+		err := sender.SendEmail("subject", "content", []string{"to@example.com"}, nil, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, "mail.internal", gotConfig.Host)
+		require.Len(t, client.messages, 1)
+		require.ElementsMatch(t, []string{"<to@example.com>"}, client.messages[0].GetToString())
+	})
+	t.Run("sends html with text alternative", func(t *testing.T) {
+		t.Parallel()
+
+		client := &mockSMTPClient{}
+		sender := &SMTPSender{
+			name:             "Go Bank",
+			fromEmailAddress: "sender@example.com",
+			config:           SMTPConfig{Host: "localhost", Port: 1025},
+			newClient: func(config SMTPConfig) (smtpClient, error) {
+				return client, nil
+			},
+		}
+
+		err := sender.SendMessage(Message{
+			Subject:  "subject",
+			TextBody: "plain",
+			HTMLBody: "<p>rich</p>",
+			To:       []string{"to@example.com"},
+		})
+		require.NoError(t, err)
+		require.Len(t, client.messages, 1)
+
+		parts := client.messages[0].GetParts()
+		require.Len(t, parts, 2)
+		require.Equal(t, gomail.TypeTextPlain, parts[0].GetContentType())
+		require.Equal(t, gomail.TypeTextHTML, parts[1].GetContentType())
+	})
+}
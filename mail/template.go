@@ -0,0 +1,247 @@
+package mail
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"path"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/a7medalyapany/GoBank.git/util"
+)
+
+//go:embed templates locales
+var templateFS embed.FS
+
+// Template names — one per transactional email. Each needs
+// templates/<name>.html, templates/<name>.txt and a "<name>.subject"
+// entry in every locale file.
+const (
+	TemplateVerifyEmail       = "verify_email"
+	TemplateTransferSent      = "transfer_sent"
+	TemplateTransferReceived  = "transfer_received"
+	TemplateEmailChangeVerify = "email_change_verify"
+	TemplateEmailChangeNotice = "email_change_notice"
+)
+
+var templateNames = []string{
+	TemplateVerifyEmail,
+	TemplateTransferSent,
+	TemplateTransferReceived,
+	TemplateEmailChangeVerify,
+	TemplateEmailChangeNotice,
+}
+
+// VerifyEmailData is the data for TemplateVerifyEmail.
+type VerifyEmailData struct {
+	FullName         string
+	VerifyURL        string
+	ExpiresInMinutes int
+}
+
+// TransferData is the data for TemplateTransferSent and TemplateTransferReceived.
+// Counterparty is the owner of the account on the other side of the transfer.
+// Both "<name>.summary" strings take Amount, AccountID, Counterparty and
+// CounterpartyAccountID in that order.
+type TransferData struct {
+	FullName              string
+	Amount                string
+	AccountID             int64
+	Counterparty          string
+	CounterpartyAccountID int64
+	TransferID            int64
+}
+
+// EmailChangeData is the data for TemplateEmailChangeVerify, sent to the new
+// address with a confirmation link, and TemplateEmailChangeNotice, sent to the
+// old address with a cancellation link.
+type EmailChangeData struct {
+	FullName       string
+	OldEmail       string
+	NewEmail       string
+	URL            string
+	ExpiresInHours int
+}
+
+// rtlLocales are written right-to-left.
+var rtlLocales = map[string]bool{
+	util.LocaleArabic: true,
+}
+
+// Templates renders localized transactional emails from the embedded
+// html/template and text/template files.
+type Templates struct {
+	html     map[string]*htmltemplate.Template
+	text     map[string]*texttemplate.Template
+	catalogs map[string]map[string]string
+}
+
+// LoadTemplates parses every embedded template and locale catalog.
+func LoadTemplates() (*Templates, error) {
+	catalogs, err := loadCatalogs()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := catalogs[util.DefaultLocale]; !ok {
+		return nil, fmt.Errorf("missing catalog for default locale %q", util.DefaultLocale)
+	}
+
+	tpl := &Templates{
+		html:     make(map[string]*htmltemplate.Template, len(templateNames)),
+		text:     make(map[string]*texttemplate.Template, len(templateNames)),
+		catalogs: catalogs,
+	}
+
+	// Placeholders so the templates parse; Render swaps in the localized funcs.
+	funcs := localeFuncs(nil, nil, util.DefaultLocale, "")
+
+	for _, name := range templateNames {
+		html, err := htmltemplate.New(name).Funcs(funcs).ParseFS(templateFS, "templates/layout.html", "templates/"+name+".html")
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s html template: %w", name, err)
+		}
+		tpl.html[name] = html
+
+		text, err := texttemplate.New(name+".txt").Funcs(funcs).ParseFS(templateFS, "templates/"+name+".txt")
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s text template: %w", name, err)
+		}
+		tpl.text[name] = text
+	}
+
+	return tpl, nil
+}
+
+func loadCatalogs() (map[string]map[string]string, error) {
+	files, err := fs.Glob(templateFS, "locales/*.json")
+	if err != nil {
+		return nil, err
+	}
+
+	catalogs := make(map[string]map[string]string, len(files))
+	for _, file := range files {
+		data, err := templateFS.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		var catalog map[string]string
+		if err := json.Unmarshal(data, &catalog); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", file, err)
+		}
+		catalogs[strings.TrimSuffix(path.Base(file), ".json")] = catalog
+	}
+	return catalogs, nil
+}
+
+// Render builds the subject, text and HTML parts of template name in locale.
+// Unsupported locales and missing translations fall back to English.
+// The caller fills in the recipients before sending.
+func (tpl *Templates) Render(name string, locale string, data any) (Message, error) {
+	html, ok := tpl.html[name]
+	if !ok {
+		return Message{}, fmt.Errorf("unknown email template %q", name)
+	}
+
+	catalog, ok := tpl.catalogs[locale]
+	if !ok {
+		locale = util.DefaultLocale
+		catalog = tpl.catalogs[locale]
+	}
+	fallback := tpl.catalogs[util.DefaultLocale]
+
+	subject, err := translate(catalog, fallback, name+".subject")
+	if err != nil {
+		return Message{}, err
+	}
+
+	funcs := localeFuncs(catalog, fallback, locale, subject)
+
+	html, err = html.Clone()
+	if err != nil {
+		return Message{}, err
+	}
+	var htmlBody bytes.Buffer
+	if err := html.Funcs(funcs).ExecuteTemplate(&htmlBody, "layout", data); err != nil {
+		return Message{}, fmt.Errorf("failed to render %s html: %w", name, err)
+	}
+
+	text, err := tpl.text[name].Clone()
+	if err != nil {
+		return Message{}, err
+	}
+	var textBody bytes.Buffer
+	if err := text.Funcs(funcs).Execute(&textBody, data); err != nil {
+		return Message{}, fmt.Errorf("failed to render %s text: %w", name, err)
+	}
+
+	return Message{
+		Subject:  subject,
+		TextBody: textBody.String(),
+		HTMLBody: htmlBody.String(),
+	}, nil
+}
+
+// Translate returns the string for key in locale, formatted with args, using
+// the same catalogs and English fallback as Render. It is used for text that
+// is shown outside of emails, such as in-app notifications.
+func (tpl *Templates) Translate(locale string, key string, args ...any) (string, error) {
+	catalog, ok := tpl.catalogs[locale]
+	if !ok {
+		catalog = tpl.catalogs[util.DefaultLocale]
+	}
+
+	format, err := translate(catalog, tpl.catalogs[util.DefaultLocale], key)
+	if err != nil {
+		return "", err
+	}
+	if len(args) == 0 {
+		return format, nil
+	}
+	return fmt.Sprintf(format, args...), nil
+}
+
+// localeFuncs returns the template functions bound to one locale:
+//
+//	t "key" args...  → translated, fmt-formatted string
+//	lang / dir       → values for <html lang dir>
+//	start            → "left" or "right", for text-align
+//	subject          → the rendered subject line
+func localeFuncs(catalog, fallback map[string]string, locale, subject string) map[string]any {
+	dir, start := "ltr", "left"
+	if rtlLocales[locale] {
+		dir, start = "rtl", "right"
+	}
+
+	return map[string]any{
+		"t": func(key string, args ...any) (string, error) {
+			format, err := translate(catalog, fallback, key)
+			if err != nil {
+				return "", err
+			}
+			if len(args) == 0 {
+				return format, nil
+			}
+			return fmt.Sprintf(format, args...), nil
+		},
+		"lang":    func() string { return locale },
+		"dir":     func() string { return dir },
+		"start":   func() string { return start },
+		"subject": func() string { return subject },
+	}
+}
+
+func translate(catalog, fallback map[string]string, key string) (string, error) {
+	if s, ok := catalog[key]; ok {
+		return s, nil
+	}
+	if s, ok := fallback[key]; ok {
+		return s, nil
+	}
+	return "", fmt.Errorf("missing translation %q", key)
+}
@@ -0,0 +1,112 @@
+package mail
+
+import (
+	"testing"
+
+	"github.com/a7medalyapany/GoBank.git/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderTemplate(t *testing.T) {
+	templates, err := LoadTemplates()
+	require.NoError(t, err)
+
+	data := VerifyEmailData{
+		FullName:         "John <Doe>",
+		VerifyURL:        "https://gobank.example/verify-email?email_id=1&secret_code=abc",
+		ExpiresInMinutes: 15,
+	}
+
+	t.Run("english", func(t *testing.T) {
+		msg, err := templates.Render(TemplateVerifyEmail, util.LocaleEnglish, data)
+		require.NoError(t, err)
+
+		require.Equal(t, "Welcome to GoBank — please verify your email", msg.Subject)
+		require.Contains(t, msg.TextBody, "Hi John <Doe>,")
+		require.Contains(t, msg.TextBody, data.VerifyURL)
+		require.Contains(t, msg.TextBody, "expires in 15 minutes")
+
+		require.Contains(t, msg.HTMLBody, `<html lang="en" dir="ltr">`)
+		require.Contains(t, msg.HTMLBody, "Hi John &lt;Doe&gt;,")
+		require.Contains(t, msg.HTMLBody, `href="https://gobank.example/verify-email?email_id=1&amp;secret_code=abc"`)
+	})
+
+	t.Run("arabic is right-to-left", func(t *testing.T) {
+		msg, err := templates.Render(TemplateVerifyEmail, util.LocaleArabic, data)
+		require.NoError(t, err)
+
+		require.Contains(t, msg.Subject, "تأكيد بريدك الإلكتروني")
+		require.Contains(t, msg.TextBody, "مرحبًا John <Doe>،")
+		require.Contains(t, msg.HTMLBody, `<html lang="ar" dir="rtl">`)
+		require.Contains(t, msg.HTMLBody, "text-align:right")
+	})
+
+	t.Run("unsupported locale falls back to english", func(t *testing.T) {
+		msg, err := templates.Render(TemplateVerifyEmail, "fr", data)
+		require.NoError(t, err)
+		require.Contains(t, msg.HTMLBody, `<html lang="en" dir="ltr">`)
+	})
+
+	t.Run("unknown template", func(t *testing.T) {
+		_, err := templates.Render("does_not_exist", util.LocaleEnglish, data)
+		require.Error(t, err)
+	})
+}
+
+func TestLocaleCatalogsAreComplete(t *testing.T) {
+	catalogs, err := loadCatalogs()
+	require.NoError(t, err)
+
+	english := catalogs[util.DefaultLocale]
+	for _, name := range templateNames {
+		require.Contains(t, english, name+".subject")
+	}
+
+	for locale, catalog := range catalogs {
+		require.True(t, util.IsSupportedLocale(locale), locale)
+		for key := range english {
+			require.Contains(t, catalog, key, "locale %s", locale)
+		}
+	}
+}
+
+func TestRenderTransferTemplates(t *testing.T) {
+	templates, err := LoadTemplates()
+	require.NoError(t, err)
+
+	data := TransferData{
+		FullName:              "Jane Doe",
+		Amount:                "$12.50",
+		AccountID:             7,
+		Counterparty:          "bob",
+		CounterpartyAccountID: 9,
+		TransferID:            42,
+	}
+
+	msg, err := templates.Render(TemplateTransferSent, util.LocaleEnglish, data)
+	require.NoError(t, err)
+	require.Equal(t, "You sent a transfer", msg.Subject)
+	require.Contains(t, msg.TextBody, "You sent $12.50 from account #7 to bob (account #9).")
+	require.Contains(t, msg.TextBody, "Transfer reference: #42")
+
+	msg, err = templates.Render(TemplateTransferReceived, util.LocaleArabic, data)
+	require.NoError(t, err)
+	require.Contains(t, msg.TextBody, "استلمت $12.50 من bob (الحساب #9) في الحساب #7.")
+	require.Contains(t, msg.HTMLBody, `<html lang="ar" dir="rtl">`)
+}
+
+func TestTranslate(t *testing.T) {
+	templates, err := LoadTemplates()
+	require.NoError(t, err)
+
+	s, err := templates.Translate(util.LocaleEnglish, "transfer_received.subject")
+	require.NoError(t, err)
+	require.Equal(t, "You received a transfer", s)
+
+	s, err = templates.Translate("fr", "common.transfer_reference", 3)
+	require.NoError(t, err)
+	require.Equal(t, "Transfer reference: #3", s)
+
+	_, err = templates.Translate(util.LocaleEnglish, "does_not_exist")
+	require.Error(t, err)
+}
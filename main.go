@@ -2,11 +2,17 @@ package main
 
 import (
 	"context"
+	"encoding/hex"
+	"flag"
 	"fmt"
 	"log"
 	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
 	"strings"
+	"syscall"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/hibiken/asynq"
@@ -14,15 +20,25 @@ import (
 	"github.com/a7medalyapany/GoBank.git/api"
 	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
 	"github.com/a7medalyapany/GoBank.git/gapi"
+	"github.com/a7medalyapany/GoBank.git/health"
 	"github.com/a7medalyapany/GoBank.git/logger"
 	"github.com/a7medalyapany/GoBank.git/mail"
+	"github.com/a7medalyapany/GoBank.git/metrics"
 	"github.com/a7medalyapany/GoBank.git/pb"
+	pbv2 "github.com/a7medalyapany/GoBank.git/pb/v2"
+	"github.com/a7medalyapany/GoBank.git/tracing"
 	"github.com/a7medalyapany/GoBank.git/util"
 	"github.com/a7medalyapany/GoBank.git/worker"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/protobuf/encoding/protojson"
 )
@@ -34,18 +50,56 @@ func main() {
 	}
 
 	logCfg := logger.DefaultConfig("go-bank", "1.0.0", config.ENVIRONMENT)
+	logCfg.Level, err = logger.ParseLevel(config.LOG_LEVEL)
+	if err != nil {
+		log.Fatalf("invalid LOG_LEVEL: %v", err)
+	}
 	if err := logger.InitGlobal(logCfg); err != nil {
 		log.Fatalf("cannot init logger: %v", err)
 	}
 	defer logger.G().Sync() // nolint: errcheck
+	go reloadLogLevel()
 
 	l := logger.G()
+
+	currencies, err := util.NewCurrencyRegistry(config.CURRENCIES)
+	if err != nil {
+		l.Fatal("invalid CURRENCIES", zap.Error(err))
+	}
+	util.SetCurrencies(currencies)
+	metrics.InitCurrencies(currencies.EnabledCodes())
+
+	interestRates, err := util.NewInterestRates(config.SAVINGS_INTEREST_RATES)
+	if err != nil {
+		l.Fatal("invalid SAVINGS_INTEREST_RATES", zap.Error(err))
+	}
+	util.SetInterestRates(interestRates)
+
 	l.Info("starting GoBank",
 		zap.String("port", config.PORT),
 		zap.String("grpc_port", config.GRPC_SERVER_PORT),
+		zap.Strings("currencies", currencies.EnabledCodes()),
 	)
 
-	conn, err := pgxpool.New(context.Background(), config.DB_URL)
+	shutdownTracing, err := tracing.Init(context.Background(), tracing.Config{
+		ServiceName:    "go-bank",
+		ServiceVersion: "1.0.0",
+		Environment:    config.ENVIRONMENT,
+		Exporter:       config.TRACING_EXPORTER,
+		SampleRatio:    config.TRACING_SAMPLE_RATIO,
+	})
+	if err != nil {
+		l.Fatal("cannot init tracing", zap.Error(err))
+	}
+	defer shutdownTracing(context.Background()) // nolint: errcheck
+
+	poolConfig, err := pgxpool.ParseConfig(config.DB_URL)
+	if err != nil {
+		l.Fatal("cannot parse db url", zap.Error(err))
+	}
+	poolConfig.ConnConfig.Tracer = tracing.NewQueryTracer()
+
+	conn, err := pgxpool.NewWithConfig(context.Background(), poolConfig)
 	if err != nil {
 		l.Fatal("cannot connect to db", zap.Error(err))
 	}
@@ -53,34 +107,150 @@ func main() {
 
 	store := db.NewStore(conn)
 
+	if len(os.Args) > 1 && os.Args[1] == "verify-ledger" {
+		os.Exit(runVerifyLedger(store, os.Args[2:]))
+	}
+
 	redisOpt := asynq.RedisClientOpt{
 		Addr: config.REDIS_ADDRESS,
 	}
 
 	taskDistributor := worker.NewRedisTaskDistributor(redisOpt)
-	
-	go runGatewayServer(config)
+	taskInspector := worker.NewRedisTaskInspector(redisOpt)
+
+	metrics.MustRegister(
+		metrics.NewPoolCollector(conn),
+		metrics.NewQueueCollector(taskInspector),
+	)
+
+	server, err := gapi.NewServer(store, config, taskDistributor, taskInspector)
+	if err != nil {
+		l.Fatal("cannot create gRPC server", zap.Error(err))
+	}
+
+	redisClient := redis.NewClient(&redis.Options{Addr: config.REDIS_ADDRESS})
+	defer redisClient.Close()
+
+	// The dependencies every request needs. The gRPC health service reports
+	// them and the gateway's /readyz adds a check of the gRPC server itself.
+	dependencies := health.NewChecker(config.HEALTH_CHECK_TIMEOUT)
+	dependencies.Add("postgres", health.Postgres(conn))
+	dependencies.Add("redis", health.Redis(redisClient))
+
+	go runGatewayServer(config, server, dependencies)
+	go runMetricsServer(config)
 	go runTaskProcessor(redisOpt, store, config)
+	go runOutboxRelay(store, taskDistributor, config)
+	go runTaskScheduler(redisOpt, config)
 
-	runGRPCServer(store, config, taskDistributor)
+	runGRPCServer(server, config, dependencies)
 	// runGinServer(store, config) // kept for reference
 }
 
+// reloadLogLevel re-reads LOG_LEVEL from app.env or the environment on every
+// SIGHUP and applies it to the global logger without a restart.
+func reloadLogLevel() {
+	l := logger.G()
 
-func runTaskProcessor(redisOpt asynq.RedisClientOpt, store *db.Store, config util.Config) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+
+	for range hup {
+		config, err := util.LoadConfig(".")
+		if err != nil {
+			l.Error("cannot reload config", zap.Error(err))
+			continue
+		}
+
+		level, err := logger.ParseLevel(config.LOG_LEVEL)
+		if err != nil {
+			l.Error("invalid LOG_LEVEL", zap.String("level", config.LOG_LEVEL), zap.Error(err))
+			continue
+		}
+
+		previous := logger.GlobalLevel()
+		logger.SetGlobalLevel(level)
+		l.Warn("log level reloaded", zap.Stringer("level", level), zap.Stringer("previous", previous))
+	}
+}
+
+// runVerifyLedger walks the ledger hash chain and reports the first broken
+// link. Heads logged by task:anchor_ledger can be passed with -anchor to also
+// catch a chain rebuilt from scratch. It returns the process exit code.
+func runVerifyLedger(store *db.Store, args []string) int {
 	l := logger.G()
 
-	sender, err := mail.NewGmailSender(
-		config.EMAIL_SENDER_NAME,
-		config.EMAIL_SENDER_ADDRESS,
-		config.EMAIL_SENDER_PASSWORD,
+	var anchors ledgerAnchors
+	flags := flag.NewFlagSet("verify-ledger", flag.ExitOnError)
+	flags.Var(&anchors, "anchor", "`seq:hash` logged by task:anchor_ledger (repeatable)")
+	flags.Parse(args) // nolint: errcheck
+
+	report, err := store.VerifyLedgerChain(context.Background(), anchors...)
+	if err != nil {
+		l.Error("cannot verify ledger chain", zap.Error(err))
+		return 2
+	}
+
+	if report.Break != nil {
+		l.Error("ledger chain is broken",
+			zap.Int64("verified_links", report.Links),
+			zap.Int64("seq", report.Break.Seq),
+			zap.Int64("transfer_id", report.Break.TransferID),
+			zap.String("reason", report.Break.Reason),
+		)
+		return 1
+	}
+
+	l.Info("ledger chain verified",
+		zap.Int64("links", report.Links),
+		zap.Int64("head_seq", report.Head.Seq),
+		zap.String("head_hash", hex.EncodeToString(report.Head.Hash)),
 	)
-	
+	return 0
+}
+
+// ledgerAnchors parses repeated -anchor seq:hash flags.
+type ledgerAnchors []db.LedgerAnchor
+
+func (anchors *ledgerAnchors) String() string {
+	parts := make([]string, len(*anchors))
+	for i, anchor := range *anchors {
+		parts[i] = fmt.Sprintf("%d:%x", anchor.Seq, anchor.Hash)
+	}
+	return strings.Join(parts, ",")
+}
+
+func (anchors *ledgerAnchors) Set(value string) error {
+	seqText, hashText, ok := strings.Cut(value, ":")
+	if !ok {
+		return fmt.Errorf("want seq:hash")
+	}
+	seq, err := strconv.ParseInt(seqText, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid seq: %w", err)
+	}
+	hash, err := hex.DecodeString(hashText)
+	if err != nil {
+		return fmt.Errorf("invalid hash: %w", err)
+	}
+	*anchors = append(*anchors, db.LedgerAnchor{Seq: seq, Hash: hash})
+	return nil
+}
+
+func runTaskProcessor(redisOpt asynq.RedisClientOpt, store *db.Store, config util.Config) {
+	l := logger.G()
+
+	sender, err := mail.NewEmailSender(config)
 	if err != nil {
 		l.Fatal("cannot create mailer", zap.Error(err))
 	}
 
-	taskProcessor := worker.NewRedisTaskProcessor(redisOpt, store, sender, config)
+	templates, err := mail.LoadTemplates()
+	if err != nil {
+		l.Fatal("cannot load email templates", zap.Error(err))
+	}
+
+	taskProcessor := worker.NewRedisTaskProcessor(redisOpt, store, sender, templates, config)
 
 	l.Info("start task processor")
 
@@ -89,6 +259,36 @@ func runTaskProcessor(redisOpt asynq.RedisClientOpt, store *db.Store, config uti
 	}
 }
 
+// runTaskScheduler enqueues periodic tasks such as the verify_emails cleanup.
+func runTaskScheduler(redisOpt asynq.RedisClientOpt, config util.Config) {
+	l := logger.G()
+
+	scheduler, err := worker.NewRedisTaskScheduler(redisOpt, config)
+	if err != nil {
+		l.Fatal("cannot create task scheduler", zap.Error(err))
+	}
+
+	l.Info("start task scheduler")
+
+	if err := scheduler.Start(); err != nil {
+		l.Fatal("cannot start task scheduler", zap.Error(err))
+	}
+}
+
+// runOutboxRelay publishes tasks written to the outbox table to Redis.
+func runOutboxRelay(store *db.Store, taskDistributor worker.TaskDistributor, config util.Config) {
+	relay := worker.NewOutboxRelay(store, taskDistributor, worker.OutboxRelayConfig{
+		PollInterval: config.OUTBOX_POLL_INTERVAL,
+		BatchSize:    config.OUTBOX_BATCH_SIZE,
+		Retention:    config.OUTBOX_RETENTION,
+		MaxAttempts:  config.OUTBOX_MAX_ATTEMPTS,
+		RetryBackoff: config.OUTBOX_RETRY_BACKOFF,
+	})
+
+	logger.G().Info("start outbox relay")
+	relay.Run(context.Background())
+}
+
 // runGinServer starts the Gin HTTP REST server (kept for reference).
 func runGinServer(store *db.Store, config util.Config) {
 	server, err := api.NewServer(store, config)
@@ -102,25 +302,26 @@ func runGinServer(store *db.Store, config util.Config) {
 	}
 }
 
-// runGRPCServer starts the gRPC server with the auth + logging interceptors.
-func runGRPCServer(store *db.Store, config util.Config, taskDistributor worker.TaskDistributor) {
+// runGRPCServer starts the gRPC server with the metrics, logging and auth
+// interceptors, and the grpc.health.v1 service backed by dependencies.
+func runGRPCServer(server *gapi.Server, config util.Config, dependencies *health.Checker) {
 	l := logger.G()
 
-	server, err := gapi.NewServer(store, config, taskDistributor)
-	if err != nil {
-		l.Fatal("cannot create gRPC server", zap.Error(err))
-	}
-
 	grpcOpts := logger.GRPCLogOptions{
 		Logger: l,
 		SkipMethods: map[string]bool{
 			"/grpc.health.v1.Health/Check": true,
 		},
-		// LogPayloads: true, // Enable only in development
+		DebugSecret: []byte(config.LOG_DEBUG_SECRET),
+		// Payloads are redacted field by field using the (pb.sensitive) option.
+		LogPayloads:    config.LOG_PAYLOADS,
+		SensitiveField: logger.SensitiveOption(pb.E_Sensitive),
 	}
 
 	grpcServer := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 		grpc.ChainUnaryInterceptor(
+			metrics.UnaryServerInterceptor(),
 			logger.UnaryServerInterceptor(grpcOpts),
 			server.AuthInterceptor(),
 		),
@@ -130,8 +331,13 @@ func runGRPCServer(store *db.Store, config util.Config, taskDistributor worker.T
 	)
 
 	pb.RegisterGoBankServer(grpcServer, server)
+	pbv2.RegisterGoBankServer(grpcServer, gapi.NewServerV2(server))
 	reflection.Register(grpcServer)
 
+	healthServer := grpchealth.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	go health.Watch(context.Background(), dependencies, healthServer, config.HEALTH_CHECK_INTERVAL, pb.GoBank_ServiceDesc.ServiceName)
+
 	address := fmt.Sprintf("%s:%s", config.SERVER_ADDRESS, config.GRPC_SERVER_PORT)
 	listener, err := net.Listen("tcp", address)
 	if err != nil {
@@ -146,7 +352,7 @@ func runGRPCServer(store *db.Store, config util.Config, taskDistributor worker.T
 }
 
 // runGatewayServer starts the gRPC-Gateway HTTP server with the HTTP logger middleware.
-func runGatewayServer(config util.Config) {
+func runGatewayServer(config util.Config, server *gapi.Server, dependencies *health.Checker) {
 	l := logger.G()
 
 	jsonOption := runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
@@ -164,11 +370,13 @@ func runGatewayServer(config util.Config) {
 			lower := strings.ToLower(key)
 			// Pass through auth, correlation, and trace headers.
 			switch lower {
-			case "authorization", "x-request-id", "x-trace-id", "x-span-id":
+			case "authorization", "x-request-id", "x-trace-id", "x-span-id", "x-debug-log":
 				return lower, true
 			}
 			return runtime.DefaultHeaderMatcher(key)
 		}),
+		// Label metrics and name spans after the matched route.
+		runtime.WithMiddlewares(metrics.GatewayMiddleware, routeSpanMiddleware),
 	)
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -177,12 +385,25 @@ func runGatewayServer(config util.Config) {
 	// ← This routes HTTP → actual gRPC server (interceptor runs)
 	// instead of RegisterGoBankHandlerServer which bypasses interceptor
 	grpcEndpoint := fmt.Sprintf("%s:%s", config.SERVER_ADDRESS, config.GRPC_SERVER_PORT)
-	err := pb.RegisterGoBankHandlerFromEndpoint(ctx, grpcMux, grpcEndpoint, []grpc.DialOption{
+	grpcConn, err := grpc.NewClient(grpcEndpoint,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	})
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
 	if err != nil {
+		l.Fatal("cannot create gRPC client", zap.Error(err))
+	}
+	defer grpcConn.Close()
+
+	if err := pb.RegisterGoBankHandler(ctx, grpcMux, grpcConn); err != nil {
 		l.Fatal("cannot register gateway handler", zap.Error(err))
 	}
+	if err := pbv2.RegisterGoBankHandler(ctx, grpcMux, grpcConn); err != nil {
+		l.Fatal("cannot register v2 gateway handler", zap.Error(err))
+	}
+
+	// Readiness checks the dependencies directly and the gRPC server over the
+	// same connection the gateway uses.
+	readiness := dependencies.With("grpc", health.GRPC(grpcConn, pb.GoBank_ServiceDesc.ServiceName))
 
 	httpOpts := logger.HTTPLogOptions{
 		Logger: l,
@@ -190,27 +411,33 @@ func runGatewayServer(config util.Config) {
 			"/healthz",
 			"/readyz",
 		},
-		ObservabilityPaths: []string{
-			"/metrics",
-		},
+		DebugSecret: []byte(config.LOG_DEBUG_SECRET),
 		SkipPathPrefixes: []string{
 			"/swagger/", // already served statically, no need to log each asset
 		},
-		// LogRequestBody: true, // dev only
+		// Bodies are JSON-redacted; see logger.RedactJSON for extra paths.
+		LogRequestBody: config.LOG_PAYLOADS,
 	}
 
 
 	mux := http.NewServeMux()
 	mux.HandleFunc("/swagger/", gapi.SwaggerHandler)
-	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
-		w.WriteHeader(http.StatusOK)
-	})
-	mux.HandleFunc("/readyz", func(w http.ResponseWriter, _ *http.Request) {
-		w.WriteHeader(http.StatusOK)
-	})
+	mux.HandleFunc("/.well-known/jwks.json", server.JWKSHandler)
+	mux.HandleFunc("/healthz", health.LivenessHandler)
+	mux.HandleFunc("/readyz", readiness.ReadinessHandler())
 	mux.Handle("/", grpcMux)
 
-	loggedMux := logger.HTTPMiddleware(httpOpts)(corsMiddleware(mux))
+	loggedMux := logger.HTTPMiddleware(httpOpts)(metrics.HTTPMiddleware(corsMiddleware(mux)))
+
+	// otelhttp goes outermost so the logger sees the request span. It picks up
+	// an incoming W3C traceparent, and the otelgrpc client handler above
+	// forwards it to the gRPC server.
+	tracedMux := otelhttp.NewHandler(loggedMux, "gateway",
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			// API spans are renamed by routeSpanMiddleware once a route matched.
+			return r.Method + " " + metrics.Route(r.URL.Path)
+		}),
+	)
 
 	httpAddress := fmt.Sprintf("%s:%s", config.SERVER_ADDRESS, config.PORT)
 	listener, err := net.Listen("tcp", httpAddress)
@@ -223,11 +450,44 @@ func runGatewayServer(config util.Config) {
 		zap.String("swagger", fmt.Sprintf("http://%s/swagger/", httpAddress)),
 	)
 
-	if err = http.Serve(listener, loggedMux); err != nil {
+	if err = http.Serve(listener, tracedMux); err != nil {
 		l.Fatal("HTTP gateway stopped", zap.Error(err))
 	}
 }
 
+// runMetricsServer serves Prometheus metrics on METRICS_ADDRESS, a listener of
+// its own so the unauthenticated endpoint never shares the public gateway port.
+func runMetricsServer(config util.Config) {
+	l := logger.G()
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+
+	listener, err := net.Listen("tcp", config.METRICS_ADDRESS)
+	if err != nil {
+		l.Fatal("cannot create metrics listener", zap.Error(err))
+	}
+
+	l.Info("metrics server listening",
+		zap.String("addr", fmt.Sprintf("http://%s/metrics", config.METRICS_ADDRESS)),
+	)
+
+	if err = http.Serve(listener, mux); err != nil {
+		l.Fatal("metrics server stopped", zap.Error(err))
+	}
+}
+
+// routeSpanMiddleware names the request span after the gateway route, which
+// otelhttp does not know yet when it starts the span.
+func routeSpanMiddleware(next runtime.HandlerFunc) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		if route, ok := metrics.GatewayRoute(r); ok {
+			trace.SpanFromContext(r.Context()).SetName(r.Method + " " + route)
+		}
+		next(w, r, pathParams)
+	}
+}
+
 func corsMiddleware(next http.Handler) http.Handler {
     return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
         origin := r.Header.Get("Origin")
@@ -0,0 +1,73 @@
+package metrics
+
+import (
+	"github.com/a7medalyapany/GoBank.git/util"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Login failure reasons.
+const (
+	LoginFailureUnknownUser   = "unknown_user"
+	LoginFailureWrongPassword = "wrong_password"
+)
+
+var (
+	transfers = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "transfers_total",
+		Help:      "Completed transfers, by currency.",
+	}, []string{"currency"})
+
+	transferVolume = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "transfer_volume_total",
+		Help:      "Amount moved by completed transfers, in major units, by currency.",
+	}, []string{"currency"})
+
+	logins = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "logins_total",
+		Help:      "Successful password logins.",
+	})
+
+	loginFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "login_failures_total",
+		Help:      "Rejected password logins, by reason.",
+	}, []string{"reason"})
+)
+
+func init() {
+	registry.MustRegister(transfers, transferVolume, logins, loginFailures)
+
+	InitCurrencies(util.Currencies().EnabledCodes())
+	for _, reason := range []string{LoginFailureUnknownUser, LoginFailureWrongPassword} {
+		loginFailures.WithLabelValues(reason)
+	}
+}
+
+// InitCurrencies starts the transfer series of currencies at zero so rate()
+// works from the first scrape. main calls it again once CURRENCIES is loaded.
+func InitCurrencies(currencies []string) {
+	for _, currency := range currencies {
+		transfers.WithLabelValues(currency)
+		transferVolume.WithLabelValues(currency)
+	}
+}
+
+// ObserveTransfer counts a committed transfer of amount, in minor units of
+// currency.
+func ObserveTransfer(currency string, amount int64) {
+	transfers.WithLabelValues(currency).Inc()
+	transferVolume.WithLabelValues(currency).Add(util.FromMinorUnits(amount, currency))
+}
+
+// ObserveLogin counts a successful login.
+func ObserveLogin() {
+	logins.Inc()
+}
+
+// ObserveLoginFailure counts a rejected login.
+func ObserveLoginFailure(reason string) {
+	loginFailures.WithLabelValues(reason).Inc()
+}
@@ -0,0 +1,123 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "HTTP requests served by the gateway, by method, route and status.",
+	}, []string{"method", "route", "status"})
+
+	httpDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Time to serve an HTTP request, by method and route.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route"})
+)
+
+func init() {
+	registry.MustRegister(httpRequests, httpDuration)
+}
+
+// staticRoutes are the non-API paths worth their own label.
+var staticRoutes = map[string]bool{
+	"/healthz":               true,
+	"/readyz":                true,
+	"/.well-known/jwks.json": true,
+}
+
+// routeKey holds the *string GatewayMiddleware writes the matched route to.
+type routeKey struct{}
+
+// HTTPMiddleware records the status and latency of every gateway request.
+// API requests are labelled with the route the gateway matched, so the
+// gateway mux must be created with runtime.WithMiddlewares(GatewayMiddleware).
+func HTTPMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+		matched := new(string)
+		r = r.WithContext(context.WithValue(r.Context(), routeKey{}, matched))
+
+		next.ServeHTTP(rec, r)
+
+		route := *matched
+		if route == "" {
+			route = Route(r.URL.Path)
+		}
+		httpRequests.WithLabelValues(r.Method, route, strconv.Itoa(rec.status)).Inc()
+		httpDuration.WithLabelValues(r.Method, route).Observe(time.Since(start).Seconds())
+	})
+}
+
+// GatewayMiddleware is a runtime.Middleware that tells HTTPMiddleware which
+// route the gateway matched.
+func GatewayMiddleware(next runtime.HandlerFunc) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		if matched, ok := r.Context().Value(routeKey{}).(*string); ok {
+			*matched, _ = GatewayRoute(r)
+		}
+		next(w, r, pathParams)
+	}
+}
+
+// GatewayRoute returns the path template of the gateway route that matched
+// r, such as "/v1/accounts/{id}". It only succeeds inside the gateway mux,
+// e.g. in a runtime.Middleware. runtime.HTTPPathPattern holds the same
+// template but is set later, by the generated handler itself.
+func GatewayRoute(r *http.Request) (string, bool) {
+	pattern, ok := runtime.HTTPPattern(r.Context())
+	if !ok {
+		return "", false
+	}
+	// Pattern.String spells a plain variable as "{id=*}".
+	return strings.ReplaceAll(pattern.String(), "=*}", "}"), true
+}
+
+// Route maps a path the gateway has no route for to a low-cardinality label:
+// the health, metrics and JWKS paths keep their own, swagger assets collapse
+// to "/swagger/", and everything else is "other" so scanners cannot create a
+// series per probed URL.
+func Route(path string) string {
+	switch {
+	case staticRoutes[path]:
+		return path
+	case strings.HasPrefix(path, "/swagger/"):
+		return "/swagger/"
+	default:
+		return "other"
+	}
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	if !r.wroteHeader {
+		r.status = code
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(code)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	return r.ResponseWriter.Write(b)
+}
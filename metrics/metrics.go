@@ -0,0 +1,39 @@
+// Package metrics exposes Prometheus metrics for the gRPC server, the HTTP
+// gateway, the database pool, the task queues and a few business counters.
+//
+// Everything is registered on a private registry served by Handler, so
+// importing a library that registers on the default registry cannot leak
+// metrics into /metrics.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "gobank"
+
+var registry = prometheus.NewRegistry()
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+// MustRegister adds collectors, such as NewPoolCollector, to the registry
+// served by Handler. It panics if a collector is already registered.
+func MustRegister(cs ...prometheus.Collector) {
+	registry.MustRegister(cs...)
+}
+
+// Handler serves the metrics in the Prometheus text format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{
+		Registry: registry,
+	})
+}
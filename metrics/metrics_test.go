@@ -0,0 +1,113 @@
+package metrics
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRoute(t *testing.T) {
+	testCases := map[string]string{
+		"/healthz":            "/healthz",
+		"/swagger/index.html": "/swagger/",
+		"/v1/accounts/42":     "other",
+		"/wp-login.php":       "other",
+	}
+	for path, want := range testCases {
+		require.Equal(t, want, Route(path), path)
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/pb.GoBank/TestMethod"}
+
+	_, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+		return nil, status.Error(codes.NotFound, "not found")
+	})
+	require.Error(t, err)
+
+	require.Equal(t, 1.0, testutil.ToFloat64(grpcHandled.WithLabelValues(info.FullMethod, codes.NotFound.String())))
+	require.Equal(t, 0.0, testutil.ToFloat64(grpcHandled.WithLabelValues(info.FullMethod, codes.OK.String())))
+}
+
+func TestHTTPMiddleware(t *testing.T) {
+	mux := runtime.NewServeMux(runtime.WithMiddlewares(GatewayMiddleware))
+	for _, pattern := range []string{"/v1/accounts/{id}", "/v1/webhooks/{endpoint_id}/deliveries", "/v2/accounts/{id}"} {
+		err := mux.HandlePath(http.MethodGet, pattern, func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+			w.WriteHeader(http.StatusTeapot)
+		})
+		require.NoError(t, err)
+	}
+	handler := HTTPMiddleware(mux)
+
+	testCases := []struct {
+		path  string
+		route string
+		code  string
+	}{
+		{"/v1/accounts/123", "/v1/accounts/{id}", "418"},
+		{"/v1/accounts/GO92000012345678", "/v1/accounts/{id}", "418"},
+		{"/v1/webhooks/7/deliveries", "/v1/webhooks/{endpoint_id}/deliveries", "418"},
+		{"/v2/accounts/123", "/v2/accounts/{id}", "418"},
+		{"/v1/nothing/here", "other", "404"},
+		{"/v2/nothing/here", "other", "404"},
+	}
+	for _, tc := range testCases {
+		before := testutil.ToFloat64(httpRequests.WithLabelValues(http.MethodGet, tc.route, tc.code))
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, tc.path, nil))
+		require.Equal(t, before+1, testutil.ToFloat64(httpRequests.WithLabelValues(http.MethodGet, tc.route, tc.code)), tc.path)
+	}
+}
+
+func TestBusinessCounters(t *testing.T) {
+	before := testutil.ToFloat64(transferVolume.WithLabelValues("USD"))
+	ObserveTransfer("USD", 1050)
+	require.Equal(t, before+10.5, testutil.ToFloat64(transferVolume.WithLabelValues("USD")))
+
+	before = testutil.ToFloat64(loginFailures.WithLabelValues(LoginFailureWrongPassword))
+	ObserveLoginFailure(LoginFailureWrongPassword)
+	require.Equal(t, before+1, testutil.ToFloat64(loginFailures.WithLabelValues(LoginFailureWrongPassword)))
+}
+
+type fakeQueueLister struct {
+	queues []*asynq.QueueInfo
+	err    error
+}
+
+func (f fakeQueueLister) ListQueues() ([]*asynq.QueueInfo, error) {
+	return f.queues, f.err
+}
+
+func TestQueueCollector(t *testing.T) {
+	collector := NewQueueCollector(fakeQueueLister{queues: []*asynq.QueueInfo{
+		{Queue: "critical", Pending: 3, Retry: 1, Latency: 2 * time.Second},
+	}})
+
+	// up, plus six states, latency, processed, failed and paused for one queue
+	require.Equal(t, 11, testutil.CollectAndCount(collector))
+	require.Equal(t, 1, testutil.CollectAndCount(NewQueueCollector(fakeQueueLister{err: io.EOF})))
+}
+
+func TestHandler(t *testing.T) {
+	ObserveLogin()
+
+	recorder := httptest.NewRecorder()
+	Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	body := recorder.Body.String()
+	require.Contains(t, body, "gobank_logins_total")
+	require.Contains(t, body, "go_goroutines")
+}
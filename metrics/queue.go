@@ -0,0 +1,97 @@
+package metrics
+
+import (
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var outboxDead = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Name:      "outbox_dead_messages_total",
+	Help:      "Outbox messages the relay gave up publishing, by task type.",
+}, []string{"task_type"})
+
+func init() {
+	registry.MustRegister(outboxDead)
+}
+
+// ObserveOutboxDead counts an outbox message that ran out of publish attempts.
+func ObserveOutboxDead(taskType string) {
+	outboxDead.WithLabelValues(taskType).Inc()
+}
+
+// QueueLister is the part of worker.TaskInspector the queue collector needs.
+type QueueLister interface {
+	ListQueues() ([]*asynq.QueueInfo, error)
+}
+
+// QueueCollector reports asynq queue sizes and latency at scrape time.
+type QueueCollector struct {
+	queues QueueLister
+
+	tasks     *prometheus.Desc
+	latency   *prometheus.Desc
+	processed *prometheus.Desc
+	failed    *prometheus.Desc
+	paused    *prometheus.Desc
+	up        *prometheus.Desc
+}
+
+// NewQueueCollector returns a collector for the queues. Register it with MustRegister.
+func NewQueueCollector(queues QueueLister) *QueueCollector {
+	name := func(name string) string {
+		return prometheus.BuildFQName(namespace, "queue", name)
+	}
+
+	return &QueueCollector{
+		queues:    queues,
+		tasks:     prometheus.NewDesc(name("tasks"), "Tasks in a queue, by state.", []string{"queue", "state"}, nil),
+		latency:   prometheus.NewDesc(name("latency_seconds"), "Age of the oldest pending task in a queue.", []string{"queue"}, nil),
+		processed: prometheus.NewDesc(name("processed_today"), "Tasks processed today (UTC), including failures.", []string{"queue"}, nil),
+		failed:    prometheus.NewDesc(name("failed_today"), "Tasks that failed today (UTC).", []string{"queue"}, nil),
+		paused:    prometheus.NewDesc(name("paused"), "1 if the queue is paused.", []string{"queue"}, nil),
+		up:        prometheus.NewDesc(name("up"), "1 if queue stats could be read from Redis.", nil, nil),
+	}
+}
+
+func (c *QueueCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.tasks
+	ch <- c.latency
+	ch <- c.processed
+	ch <- c.failed
+	ch <- c.paused
+	ch <- c.up
+}
+
+func (c *QueueCollector) Collect(ch chan<- prometheus.Metric) {
+	queues, err := c.queues.ListQueues()
+	if err != nil {
+		// Report Redis being unreachable instead of failing the whole scrape.
+		ch <- prometheus.MustNewConstMetric(c.up, prometheus.GaugeValue, 0)
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(c.up, prometheus.GaugeValue, 1)
+
+	for _, q := range queues {
+		for state, n := range map[string]int{
+			"pending":   q.Pending,
+			"active":    q.Active,
+			"scheduled": q.Scheduled,
+			"retry":     q.Retry,
+			"archived":  q.Archived,
+			"completed": q.Completed,
+		} {
+			ch <- prometheus.MustNewConstMetric(c.tasks, prometheus.GaugeValue, float64(n), q.Queue, state)
+		}
+
+		paused := 0.0
+		if q.Paused {
+			paused = 1
+		}
+
+		ch <- prometheus.MustNewConstMetric(c.latency, prometheus.GaugeValue, q.Latency.Seconds(), q.Queue)
+		ch <- prometheus.MustNewConstMetric(c.processed, prometheus.GaugeValue, float64(q.Processed), q.Queue)
+		ch <- prometheus.MustNewConstMetric(c.failed, prometheus.GaugeValue, float64(q.Failed), q.Queue)
+		ch <- prometheus.MustNewConstMetric(c.paused, prometheus.GaugeValue, paused, q.Queue)
+	}
+}
@@ -30,6 +30,9 @@ type Account struct {
 	Balance       float64                `protobuf:"fixed64,3,opt,name=balance,proto3" json:"balance,omitempty"`
 	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Type          string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
+	Nickname      string                 `protobuf:"bytes,7,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	AccountNumber string                 `protobuf:"bytes,8,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -99,11 +102,34 @@ func (x *Account) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Account) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Account) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *Account) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
 type AccountLookUp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Owner         string                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
 	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Type          string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Nickname      string                 `protobuf:"bytes,6,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	AccountNumber string                 `protobuf:"bytes,7,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -138,13 +164,6 @@ func (*AccountLookUp) Descriptor() ([]byte, []int) {
 	return file_rpc_account_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *AccountLookUp) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
 func (x *AccountLookUp) GetOwner() string {
 	if x != nil {
 		return x.Owner
@@ -159,11 +178,34 @@ func (x *AccountLookUp) GetCurrency() string {
 	return ""
 }
 
+func (x *AccountLookUp) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AccountLookUp) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *AccountLookUp) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
 type CreateAccountRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// ISO 4217 currency code. Supported: USD, EUR, EGP.
-	// Each user may only hold one account per currency.
+	// ISO 4217 currency code of an enabled currency (USD, EUR, EGP by default).
+	// A user may hold any number of accounts per currency.
 	Currency      string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Type          string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Nickname      string `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -205,6 +247,20 @@ func (x *CreateAccountRequest) GetCurrency() string {
 	return ""
 }
 
+func (x *CreateAccountRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
 type CreateAccountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
@@ -341,6 +397,8 @@ type ListAccountsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PageId        int32                  `protobuf:"varint,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
 	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Currency      *string                `protobuf:"bytes,3,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	Type          *string                `protobuf:"bytes,4,opt,name=type,proto3,oneof" json:"type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -389,6 +447,20 @@ func (x *ListAccountsRequest) GetPageSize() int32 {
 	return 0
 }
 
+func (x *ListAccountsRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *ListAccountsRequest) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
 type ListAccountsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Accounts      []*Account             `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
@@ -529,6 +601,102 @@ func (x *UpdateAccountResponse) GetAccount() *Account {
 	return nil
 }
 
+type UpdateAccountNicknameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAccountNicknameRequest) Reset() {
+	*x = UpdateAccountNicknameRequest{}
+	mi := &file_rpc_account_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAccountNicknameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountNicknameRequest) ProtoMessage() {}
+
+func (x *UpdateAccountNicknameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_account_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccountNicknameRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAccountNicknameRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_account_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateAccountNicknameRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateAccountNicknameRequest) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+type UpdateAccountNicknameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAccountNicknameResponse) Reset() {
+	*x = UpdateAccountNicknameResponse{}
+	mi := &file_rpc_account_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAccountNicknameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountNicknameResponse) ProtoMessage() {}
+
+func (x *UpdateAccountNicknameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_account_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccountNicknameResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAccountNicknameResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_account_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateAccountNicknameResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
 type DeleteAccountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -538,7 +706,7 @@ type DeleteAccountRequest struct {
 
 func (x *DeleteAccountRequest) Reset() {
 	*x = DeleteAccountRequest{}
-	mi := &file_rpc_account_proto_msgTypes[10]
+	mi := &file_rpc_account_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -550,7 +718,7 @@ func (x *DeleteAccountRequest) String() string {
 func (*DeleteAccountRequest) ProtoMessage() {}
 
 func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_account_proto_msgTypes[10]
+	mi := &file_rpc_account_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -563,7 +731,7 @@ func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
 func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_account_proto_rawDescGZIP(), []int{10}
+	return file_rpc_account_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DeleteAccountRequest) GetId() int64 {
@@ -582,7 +750,7 @@ type DeleteAccountResponse struct {
 
 func (x *DeleteAccountResponse) Reset() {
 	*x = DeleteAccountResponse{}
-	mi := &file_rpc_account_proto_msgTypes[11]
+	mi := &file_rpc_account_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -594,7 +762,7 @@ func (x *DeleteAccountResponse) String() string {
 func (*DeleteAccountResponse) ProtoMessage() {}
 
 func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_account_proto_msgTypes[11]
+	mi := &file_rpc_account_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -607,7 +775,7 @@ func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
 func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_account_proto_rawDescGZIP(), []int{11}
+	return file_rpc_account_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DeleteAccountResponse) GetStatus() string {
@@ -619,14 +787,14 @@ func (x *DeleteAccountResponse) GetStatus() string {
 
 type LookUpAccountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountNumber string                 `protobuf:"bytes,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LookUpAccountRequest) Reset() {
 	*x = LookUpAccountRequest{}
-	mi := &file_rpc_account_proto_msgTypes[12]
+	mi := &file_rpc_account_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -638,7 +806,7 @@ func (x *LookUpAccountRequest) String() string {
 func (*LookUpAccountRequest) ProtoMessage() {}
 
 func (x *LookUpAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_account_proto_msgTypes[12]
+	mi := &file_rpc_account_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -651,14 +819,14 @@ func (x *LookUpAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LookUpAccountRequest.ProtoReflect.Descriptor instead.
 func (*LookUpAccountRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_account_proto_rawDescGZIP(), []int{12}
+	return file_rpc_account_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *LookUpAccountRequest) GetId() int64 {
+func (x *LookUpAccountRequest) GetAccountNumber() string {
 	if x != nil {
-		return x.Id
+		return x.AccountNumber
 	}
-	return 0
+	return ""
 }
 
 type LookUpAccountResponse struct {
@@ -670,7 +838,7 @@ type LookUpAccountResponse struct {
 
 func (x *LookUpAccountResponse) Reset() {
 	*x = LookUpAccountResponse{}
-	mi := &file_rpc_account_proto_msgTypes[13]
+	mi := &file_rpc_account_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -682,7 +850,7 @@ func (x *LookUpAccountResponse) String() string {
 func (*LookUpAccountResponse) ProtoMessage() {}
 
 func (x *LookUpAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_account_proto_msgTypes[13]
+	mi := &file_rpc_account_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -695,7 +863,7 @@ func (x *LookUpAccountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LookUpAccountResponse.ProtoReflect.Descriptor instead.
 func (*LookUpAccountResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_account_proto_rawDescGZIP(), []int{13}
+	return file_rpc_account_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *LookUpAccountResponse) GetAccount() *AccountLookUp {
@@ -709,42 +877,60 @@ var File_rpc_account_proto protoreflect.FileDescriptor
 
 const file_rpc_account_proto_rawDesc = "" +
 	"\n" +
-	"\x11rpc_account.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x86\x03\n" +
+	"\x11rpc_account.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x98\x06\n" +
 	"\aAccount\x12'\n" +
 	"\x02id\x18\x01 \x01(\x03B\x17\x92A\x142\x12Unique account ID.R\x02id\x129\n" +
-	"\x05owner\x18\x02 \x01(\tB#\x92A 2\x1eUsername of the account owner.R\x05owner\x12i\n" +
-	"\abalance\x18\x03 \x01(\x01BO\x92AL2ACurrent balance in major currency unit (e.g. dollars, not cents).J\a1050.75R\abalance\x12?\n" +
+	"\x05owner\x18\x02 \x01(\tB#\x92A 2\x1eUsername of the account owner.R\x05owner\x12\x94\x01\n" +
+	"\abalance\x18\x03 \x01(\x01Bz\x92Aw2lCurrent balance in major currency unit (e.g. dollars, not cents), with as many decimals as the currency has.J\a1050.75R\abalance\x12?\n" +
 	"\bcurrency\x18\x04 \x01(\tB#\x92A 2\x17ISO 4217 currency code.J\x05\"USD\"R\bcurrency\x12k\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB0\x92A-2+UTC timestamp when the account was created.R\tcreatedAt\"\xb4\x01\n" +
-	"\rAccountLookUp\x12'\n" +
-	"\x02id\x18\x01 \x01(\x03B\x17\x92A\x142\x12Unique account ID.R\x02id\x129\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB0\x92A-2+UTC timestamp when the account was created.R\tcreatedAt\x12G\n" +
+	"\x04type\x18\x06 \x01(\tB3\x92A02\"Account type: checking or savings.J\n" +
+	"\"checking\"R\x04type\x12k\n" +
+	"\bnickname\x18\a \x01(\tBO\x92AL2BName the owner or a co-owner gave the account. Empty when unnamed.J\x06\"Rent\"R\bnickname\x12\xad\x01\n" +
+	"\x0eaccount_number\x18\b \x01(\tB\x85\x01\x92A\x81\x012kPublic account number: GO, two check digits and 12 digits. Share it instead of the ID to receive transfers.J\x12\"GO92000012345678\"R\raccountNumber\"\xa3\x03\n" +
+	"\rAccountLookUp\x129\n" +
 	"\x05owner\x18\x02 \x01(\tB#\x92A 2\x1eUsername of the account owner.R\x05owner\x12?\n" +
-	"\bcurrency\x18\x04 \x01(\tB#\x92A 2\x17ISO 4217 currency code.J\x05\"USD\"R\bcurrency\"\x9c\x01\n" +
-	"\x14CreateAccountRequest\x12\x83\x01\n" +
-	"\bcurrency\x18\x01 \x01(\tBg\x92Ad2[ISO 4217 currency code. Supported values: USD, EUR, EGP. One account per currency per user.J\x05\"USD\"R\bcurrency\">\n" +
+	"\bcurrency\x18\x04 \x01(\tB#\x92A 2\x17ISO 4217 currency code.J\x05\"USD\"R\bcurrency\x12G\n" +
+	"\x04type\x18\x05 \x01(\tB3\x92A02\"Account type: checking or savings.J\n" +
+	"\"checking\"R\x04type\x12k\n" +
+	"\bnickname\x18\x06 \x01(\tBO\x92AL2BName the owner or a co-owner gave the account. Empty when unnamed.J\x06\"Rent\"R\bnickname\x12V\n" +
+	"\x0eaccount_number\x18\a \x01(\tB/\x92A,2\x16Public account number.J\x12\"GO92000012345678\"R\raccountNumberJ\x04\b\x01\x10\x02R\x02id\"\xd4\x02\n" +
+	"\x14CreateAccountRequest\x12~\n" +
+	"\bcurrency\x18\x01 \x01(\tBb\x92A_2VISO 4217 currency code of a currency enabled by the server (USD, EUR, EGP by default).J\x05\"USD\"R\bcurrency\x12\\\n" +
+	"\x04type\x18\x02 \x01(\tBH\x92AE28Account type: checking or savings. Defaults to checking.J\t\"savings\"R\x04type\x12^\n" +
+	"\bnickname\x18\x03 \x01(\tBB\x92A?25Optional name for the account, at most 40 characters.J\x06\"Rent\"R\bnickname\">\n" +
 	"\x15CreateAccountResponse\x12%\n" +
-	"\aaccount\x18\x01 \x01(\v2\v.pb.AccountR\aaccount\"x\n" +
-	"\x11GetAccountRequest\x12c\n" +
-	"\x02id\x18\x01 \x01(\x03BS\x92AP2EID of the account to retrieve. Must belong to the authenticated user.i\x00\x00\x00\x00\x00\x00\xf0?R\x02id\";\n" +
+	"\aaccount\x18\x01 \x01(\v2\v.pb.AccountR\aaccount\"o\n" +
+	"\x11GetAccountRequest\x12Z\n" +
+	"\x02id\x18\x01 \x01(\x03BJ\x92AG2<ID of the account to retrieve. You must be an active member.i\x00\x00\x00\x00\x00\x00\xf0?R\x02id\";\n" +
 	"\x12GetAccountResponse\x12%\n" +
-	"\aaccount\x18\x01 \x01(\v2\v.pb.AccountR\aaccount\"\xb4\x01\n" +
+	"\aaccount\x18\x01 \x01(\v2\v.pb.AccountR\aaccount\"\x86\x03\n" +
 	"\x13ListAccountsRequest\x12>\n" +
 	"\apage_id\x18\x01 \x01(\x05B%\x92A\"2\x141-based page number.J\x011i\x00\x00\x00\x00\x00\x00\xf0?R\x06pageId\x12]\n" +
-	"\tpage_size\x18\x02 \x01(\x05B@\x92A=2%Number of accounts per page. Max 100.J\x0210Y\x00\x00\x00\x00\x00\x00Y@i\x00\x00\x00\x00\x00\x00\xf0?R\bpageSize\"?\n" +
+	"\tpage_size\x18\x02 \x01(\x05B@\x92A=2%Number of accounts per page. Max 100.J\x0210Y\x00\x00\x00\x00\x00\x00Y@i\x00\x00\x00\x00\x00\x00\xf0?R\bpageSize\x12Z\n" +
+	"\bcurrency\x18\x03 \x01(\tB9\x92A62-Only list accounts in this ISO 4217 currency.J\x05\"USD\"H\x00R\bcurrency\x88\x01\x01\x12^\n" +
+	"\x04type\x18\x04 \x01(\tBE\x92AB25Only list accounts of this type: checking or savings.J\t\"savings\"H\x01R\x04type\x88\x01\x01B\v\n" +
+	"\t_currencyB\a\n" +
+	"\x05_type\"?\n" +
 	"\x14ListAccountsResponse\x12'\n" +
-	"\baccounts\x18\x01 \x03(\v2\v.pb.AccountR\baccounts\"\xe2\x01\n" +
-	"\x14UpdateAccountRequest\x12a\n" +
-	"\x02id\x18\x01 \x01(\x03BQ\x92AN2CID of the account to update. Must belong to the authenticated user.i\x00\x00\x00\x00\x00\x00\xf0?R\x02id\x12g\n" +
+	"\baccounts\x18\x01 \x03(\v2\v.pb.AccountR\baccounts\"\xe0\x01\n" +
+	"\x14UpdateAccountRequest\x12_\n" +
+	"\x02id\x18\x01 \x01(\x03BO\x92AL2AID of the account to update. You must be its owner or a co-owner.i\x00\x00\x00\x00\x00\x00\xf0?R\x02id\x12g\n" +
 	"\abalance\x18\x02 \x01(\x01BM\x92AJ2@New balance in major currency unit (e.g. dollars). Must be >= 0.J\x06500.00R\abalance\">\n" +
 	"\x15UpdateAccountResponse\x12%\n" +
-	"\aaccount\x18\x01 \x01(\v2\v.pb.AccountR\aaccount\"y\n" +
-	"\x14DeleteAccountRequest\x12a\n" +
-	"\x02id\x18\x01 \x01(\x03BQ\x92AN2CID of the account to delete. Must belong to the authenticated user.i\x00\x00\x00\x00\x00\x00\xf0?R\x02id\"V\n" +
+	"\aaccount\x18\x01 \x01(\v2\v.pb.AccountR\aaccount\"\xeb\x01\n" +
+	"\x1cUpdateAccountNicknameRequest\x12_\n" +
+	"\x02id\x18\x01 \x01(\x03BO\x92AL2AID of the account to rename. You must be its owner or a co-owner.i\x00\x00\x00\x00\x00\x00\xf0?R\x02id\x12j\n" +
+	"\bnickname\x18\x02 \x01(\tBN\x92AK2ANew name for the account, at most 40 characters. Empty clears it.J\x06\"Rent\"R\bnickname\"F\n" +
+	"\x1dUpdateAccountNicknameResponse\x12%\n" +
+	"\aaccount\x18\x01 \x01(\v2\v.pb.AccountR\aaccount\"i\n" +
+	"\x14DeleteAccountRequest\x12Q\n" +
+	"\x02id\x18\x01 \x01(\x03BA\x92A>23ID of the account to delete. You must be its owner.i\x00\x00\x00\x00\x00\x00\xf0?R\x02id\"V\n" +
 	"\x15DeleteAccountResponse\x12=\n" +
-	"\x06status\x18\x01 \x01(\tB%\x92A\"2\x15Confirmation message.J\t\"deleted\"R\x06status\"R\n" +
-	"\x14LookUpAccountRequest\x12:\n" +
-	"\x02id\x18\x01 \x01(\x03B*\x92A'2\x1cID of the account to lookup.i\x00\x00\x00\x00\x00\x00\xf0?R\x02id\"D\n" +
+	"\x06status\x18\x01 \x01(\tB%\x92A\"2\x15Confirmation message.J\t\"deleted\"R\x06status\"\xf2\x01\n" +
+	"\x14LookUpAccountRequest\x12\xcf\x01\n" +
+	"\x0eaccount_number\x18\x02 \x01(\tB\xa7\x01\x92A\xa3\x012\x89\x01Account number of the account to look up. Spaces and lowercase letters are ignored; a number whose check digits do not match is rejected.J\x15\"GO92 0000 1234 5678\"R\raccountNumberJ\x04\b\x01\x10\x02R\x02id\"D\n" +
 	"\x15LookUpAccountResponse\x12+\n" +
 	"\aaccount\x18\x01 \x01(\v2\x11.pb.AccountLookUpR\aaccountB(Z&github.com/a7medalyapany/GoBank.git/pbb\x06proto3"
 
@@ -760,36 +946,39 @@ func file_rpc_account_proto_rawDescGZIP() []byte {
 	return file_rpc_account_proto_rawDescData
 }
 
-var file_rpc_account_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_rpc_account_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_rpc_account_proto_goTypes = []any{
-	(*Account)(nil),               // 0: pb.Account
-	(*AccountLookUp)(nil),         // 1: pb.AccountLookUp
-	(*CreateAccountRequest)(nil),  // 2: pb.CreateAccountRequest
-	(*CreateAccountResponse)(nil), // 3: pb.CreateAccountResponse
-	(*GetAccountRequest)(nil),     // 4: pb.GetAccountRequest
-	(*GetAccountResponse)(nil),    // 5: pb.GetAccountResponse
-	(*ListAccountsRequest)(nil),   // 6: pb.ListAccountsRequest
-	(*ListAccountsResponse)(nil),  // 7: pb.ListAccountsResponse
-	(*UpdateAccountRequest)(nil),  // 8: pb.UpdateAccountRequest
-	(*UpdateAccountResponse)(nil), // 9: pb.UpdateAccountResponse
-	(*DeleteAccountRequest)(nil),  // 10: pb.DeleteAccountRequest
-	(*DeleteAccountResponse)(nil), // 11: pb.DeleteAccountResponse
-	(*LookUpAccountRequest)(nil),  // 12: pb.LookUpAccountRequest
-	(*LookUpAccountResponse)(nil), // 13: pb.LookUpAccountResponse
-	(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
+	(*Account)(nil),                       // 0: pb.Account
+	(*AccountLookUp)(nil),                 // 1: pb.AccountLookUp
+	(*CreateAccountRequest)(nil),          // 2: pb.CreateAccountRequest
+	(*CreateAccountResponse)(nil),         // 3: pb.CreateAccountResponse
+	(*GetAccountRequest)(nil),             // 4: pb.GetAccountRequest
+	(*GetAccountResponse)(nil),            // 5: pb.GetAccountResponse
+	(*ListAccountsRequest)(nil),           // 6: pb.ListAccountsRequest
+	(*ListAccountsResponse)(nil),          // 7: pb.ListAccountsResponse
+	(*UpdateAccountRequest)(nil),          // 8: pb.UpdateAccountRequest
+	(*UpdateAccountResponse)(nil),         // 9: pb.UpdateAccountResponse
+	(*UpdateAccountNicknameRequest)(nil),  // 10: pb.UpdateAccountNicknameRequest
+	(*UpdateAccountNicknameResponse)(nil), // 11: pb.UpdateAccountNicknameResponse
+	(*DeleteAccountRequest)(nil),          // 12: pb.DeleteAccountRequest
+	(*DeleteAccountResponse)(nil),         // 13: pb.DeleteAccountResponse
+	(*LookUpAccountRequest)(nil),          // 14: pb.LookUpAccountRequest
+	(*LookUpAccountResponse)(nil),         // 15: pb.LookUpAccountResponse
+	(*timestamppb.Timestamp)(nil),         // 16: google.protobuf.Timestamp
 }
 var file_rpc_account_proto_depIdxs = []int32{
-	14, // 0: pb.Account.created_at:type_name -> google.protobuf.Timestamp
+	16, // 0: pb.Account.created_at:type_name -> google.protobuf.Timestamp
 	0,  // 1: pb.CreateAccountResponse.account:type_name -> pb.Account
 	0,  // 2: pb.GetAccountResponse.account:type_name -> pb.Account
 	0,  // 3: pb.ListAccountsResponse.accounts:type_name -> pb.Account
 	0,  // 4: pb.UpdateAccountResponse.account:type_name -> pb.Account
-	1,  // 5: pb.LookUpAccountResponse.account:type_name -> pb.AccountLookUp
-	6,  // [6:6] is the sub-list for method output_type
-	6,  // [6:6] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	0,  // 5: pb.UpdateAccountNicknameResponse.account:type_name -> pb.Account
+	1,  // 6: pb.LookUpAccountResponse.account:type_name -> pb.AccountLookUp
+	7,  // [7:7] is the sub-list for method output_type
+	7,  // [7:7] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_rpc_account_proto_init() }
@@ -797,13 +986,14 @@ func file_rpc_account_proto_init() {
 	if File_rpc_account_proto != nil {
 		return
 	}
+	file_rpc_account_proto_msgTypes[6].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_account_proto_rawDesc), len(file_rpc_account_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,701 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.0
+// source: rpc_account_member.proto
+
+package pb
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AccountMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	InvitedBy     string                 `protobuf:"bytes,6,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AcceptedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=accepted_at,json=acceptedAt,proto3" json:"accepted_at,omitempty"`
+	SpendLimit    float64                `protobuf:"fixed64,9,opt,name=spend_limit,json=spendLimit,proto3" json:"spend_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountMember) Reset() {
+	*x = AccountMember{}
+	mi := &file_rpc_account_member_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountMember) ProtoMessage() {}
+
+func (x *AccountMember) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_account_member_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountMember.ProtoReflect.Descriptor instead.
+func (*AccountMember) Descriptor() ([]byte, []int) {
+	return file_rpc_account_member_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AccountMember) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *AccountMember) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AccountMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AccountMember) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AccountMember) GetInvitedBy() string {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return ""
+}
+
+func (x *AccountMember) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AccountMember) GetAcceptedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcceptedAt
+	}
+	return nil
+}
+
+func (x *AccountMember) GetSpendLimit() float64 {
+	if x != nil {
+		return x.SpendLimit
+	}
+	return 0
+}
+
+type InviteAccountMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	SpendLimit    float64                `protobuf:"fixed64,5,opt,name=spend_limit,json=spendLimit,proto3" json:"spend_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteAccountMemberRequest) Reset() {
+	*x = InviteAccountMemberRequest{}
+	mi := &file_rpc_account_member_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteAccountMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteAccountMemberRequest) ProtoMessage() {}
+
+func (x *InviteAccountMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_account_member_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteAccountMemberRequest.ProtoReflect.Descriptor instead.
+func (*InviteAccountMemberRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_account_member_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *InviteAccountMemberRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *InviteAccountMemberRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *InviteAccountMemberRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *InviteAccountMemberRequest) GetSpendLimit() float64 {
+	if x != nil {
+		return x.SpendLimit
+	}
+	return 0
+}
+
+type InviteAccountMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *AccountMember         `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteAccountMemberResponse) Reset() {
+	*x = InviteAccountMemberResponse{}
+	mi := &file_rpc_account_member_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteAccountMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteAccountMemberResponse) ProtoMessage() {}
+
+func (x *InviteAccountMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_account_member_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteAccountMemberResponse.ProtoReflect.Descriptor instead.
+func (*InviteAccountMemberResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_account_member_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *InviteAccountMemberResponse) GetMember() *AccountMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type ListAccountMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountMembersRequest) Reset() {
+	*x = ListAccountMembersRequest{}
+	mi := &file_rpc_account_member_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountMembersRequest) ProtoMessage() {}
+
+func (x *ListAccountMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_account_member_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountMembersRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_account_member_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListAccountMembersRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+type ListAccountMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*AccountMember       `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountMembersResponse) Reset() {
+	*x = ListAccountMembersResponse{}
+	mi := &file_rpc_account_member_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountMembersResponse) ProtoMessage() {}
+
+func (x *ListAccountMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_account_member_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountMembersResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_account_member_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListAccountMembersResponse) GetMembers() []*AccountMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type RemoveAccountMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveAccountMemberRequest) Reset() {
+	*x = RemoveAccountMemberRequest{}
+	mi := &file_rpc_account_member_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveAccountMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAccountMemberRequest) ProtoMessage() {}
+
+func (x *RemoveAccountMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_account_member_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAccountMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveAccountMemberRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_account_member_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RemoveAccountMemberRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *RemoveAccountMemberRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type RemoveAccountMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveAccountMemberResponse) Reset() {
+	*x = RemoveAccountMemberResponse{}
+	mi := &file_rpc_account_member_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveAccountMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAccountMemberResponse) ProtoMessage() {}
+
+func (x *RemoveAccountMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_account_member_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAccountMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveAccountMemberResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_account_member_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RemoveAccountMemberResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListAccountInvitationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountInvitationsRequest) Reset() {
+	*x = ListAccountInvitationsRequest{}
+	mi := &file_rpc_account_member_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountInvitationsRequest) ProtoMessage() {}
+
+func (x *ListAccountInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_account_member_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_account_member_proto_rawDescGZIP(), []int{7}
+}
+
+type ListAccountInvitationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitations   []*AccountMember       `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountInvitationsResponse) Reset() {
+	*x = ListAccountInvitationsResponse{}
+	mi := &file_rpc_account_member_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountInvitationsResponse) ProtoMessage() {}
+
+func (x *ListAccountInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_account_member_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_account_member_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListAccountInvitationsResponse) GetInvitations() []*AccountMember {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+type AcceptAccountInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptAccountInvitationRequest) Reset() {
+	*x = AcceptAccountInvitationRequest{}
+	mi := &file_rpc_account_member_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptAccountInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptAccountInvitationRequest) ProtoMessage() {}
+
+func (x *AcceptAccountInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_account_member_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptAccountInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptAccountInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_account_member_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AcceptAccountInvitationRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+type AcceptAccountInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *AccountMember         `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptAccountInvitationResponse) Reset() {
+	*x = AcceptAccountInvitationResponse{}
+	mi := &file_rpc_account_member_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptAccountInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptAccountInvitationResponse) ProtoMessage() {}
+
+func (x *AcceptAccountInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_account_member_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptAccountInvitationResponse.ProtoReflect.Descriptor instead.
+func (*AcceptAccountInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_account_member_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AcceptAccountInvitationResponse) GetMember() *AccountMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+var File_rpc_account_member_proto protoreflect.FileDescriptor
+
+const file_rpc_account_member_proto_rawDesc = "" +
+	"\n" +
+	"\x18rpc_account_member.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x9e\x06\n" +
+	"\rAccountMember\x12=\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03B\x1e\x92A\x1b2\x19ID of the shared account.R\taccountId\x128\n" +
+	"\busername\x18\x02 \x01(\tB\x1c\x92A\x192\x17Username of the member.R\busername\x12G\n" +
+	"\x04role\x18\x03 \x01(\tB3\x92A02#owner, co_owner, viewer or spender.J\t\"spender\"R\x04role\x12V\n" +
+	"\x06status\x18\x05 \x01(\tB>\x92A;2.invited until the member accepts, then active.J\t\"invited\"R\x06status\x12S\n" +
+	"\n" +
+	"invited_by\x18\x06 \x01(\tB4\x92A12/Username of the member who sent the invitation.R\tinvitedBy\x12j\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampB/\x92A,2*UTC timestamp when the member was invited.R\tcreatedAt\x12\x85\x01\n" +
+	"\vaccepted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampBH\x92AE2CUTC timestamp when the invitation was accepted; null while invited.R\n" +
+	"acceptedAt\x12\xa3\x01\n" +
+	"\vspend_limit\x18\t \x01(\x01B\x81\x01\x92A~2xMost a spender may transfer from the account in any 24 hours, in major units of the account currency. 0 for other roles.J\x0250R\n" +
+	"spendLimitJ\x04\b\x04\x10\x05\"\xe9\x04\n" +
+	"\x1aInviteAccountMemberRequest\x12m\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03BN\x92AK2@ID of the account to share. You must be its owner or a co-owner.i\x00\x00\x00\x00\x00\x00\xf0?R\taccountId\x12G\n" +
+	"\busername\x18\x02 \x01(\tB+\x92A(2\x1fUsername of the user to invite.J\x05\"bob\"R\busername\x12\xaf\x01\n" +
+	"\x04role\x18\x03 \x01(\tB\x9a\x01\x92A\x96\x012\x88\x01co_owner (full access except deleting the account), viewer (read only) or spender (read and transfer up to spend_limit in any 24 hours).J\t\"spender\"R\x04role\x12\xda\x01\n" +
+	"\vspend_limit\x18\x05 \x01(\x01B\xb8\x01\x92A\xb4\x012\xad\x01Required for spenders, and only for them: most they may transfer from the account in any 24 hours, in major units of the account currency, with no more decimals than it has.J\x0250R\n" +
+	"spendLimitJ\x04\b\x04\x10\x05\"H\n" +
+	"\x1bInviteAccountMemberResponse\x12)\n" +
+	"\x06member\x18\x01 \x01(\v2\x11.pb.AccountMemberR\x06member\"z\n" +
+	"\x19ListAccountMembersRequest\x12]\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03B>\x92A;20ID of the account. You must be an active member.i\x00\x00\x00\x00\x00\x00\xf0?R\taccountId\"I\n" +
+	"\x1aListAccountMembersResponse\x12+\n" +
+	"\amembers\x18\x01 \x03(\v2\x11.pb.AccountMemberR\amembers\"\xa5\x02\n" +
+	"\x1aRemoveAccountMemberRequest\x12?\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03B \x92A\x1d2\x12ID of the account.i\x00\x00\x00\x00\x00\x00\xf0?R\taccountId\x12\xc5\x01\n" +
+	"\busername\x18\x02 \x01(\tB\xa8\x01\x92A\xa4\x012\x9a\x01Member to remove or invitation to revoke. Owners and co-owners can remove anyone but the owner; any member can remove themselves or decline an invitation.J\x05\"bob\"R\busername\"\\\n" +
+	"\x1bRemoveAccountMemberResponse\x12=\n" +
+	"\x06status\x18\x01 \x01(\tB%\x92A\"2\x15Confirmation message.J\t\"removed\"R\x06status\"\x1f\n" +
+	"\x1dListAccountInvitationsRequest\"U\n" +
+	"\x1eListAccountInvitationsResponse\x123\n" +
+	"\vinvitations\x18\x01 \x03(\v2\x11.pb.AccountMemberR\vinvitations\"u\n" +
+	"\x1eAcceptAccountInvitationRequest\x12S\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03B4\x92A12&ID of the account you were invited to.i\x00\x00\x00\x00\x00\x00\xf0?R\taccountId\"L\n" +
+	"\x1fAcceptAccountInvitationResponse\x12)\n" +
+	"\x06member\x18\x01 \x01(\v2\x11.pb.AccountMemberR\x06memberB(Z&github.com/a7medalyapany/GoBank.git/pbb\x06proto3"
+
+var (
+	file_rpc_account_member_proto_rawDescOnce sync.Once
+	file_rpc_account_member_proto_rawDescData []byte
+)
+
+func file_rpc_account_member_proto_rawDescGZIP() []byte {
+	file_rpc_account_member_proto_rawDescOnce.Do(func() {
+		file_rpc_account_member_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_account_member_proto_rawDesc), len(file_rpc_account_member_proto_rawDesc)))
+	})
+	return file_rpc_account_member_proto_rawDescData
+}
+
+var file_rpc_account_member_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_rpc_account_member_proto_goTypes = []any{
+	(*AccountMember)(nil),                   // 0: pb.AccountMember
+	(*InviteAccountMemberRequest)(nil),      // 1: pb.InviteAccountMemberRequest
+	(*InviteAccountMemberResponse)(nil),     // 2: pb.InviteAccountMemberResponse
+	(*ListAccountMembersRequest)(nil),       // 3: pb.ListAccountMembersRequest
+	(*ListAccountMembersResponse)(nil),      // 4: pb.ListAccountMembersResponse
+	(*RemoveAccountMemberRequest)(nil),      // 5: pb.RemoveAccountMemberRequest
+	(*RemoveAccountMemberResponse)(nil),     // 6: pb.RemoveAccountMemberResponse
+	(*ListAccountInvitationsRequest)(nil),   // 7: pb.ListAccountInvitationsRequest
+	(*ListAccountInvitationsResponse)(nil),  // 8: pb.ListAccountInvitationsResponse
+	(*AcceptAccountInvitationRequest)(nil),  // 9: pb.AcceptAccountInvitationRequest
+	(*AcceptAccountInvitationResponse)(nil), // 10: pb.AcceptAccountInvitationResponse
+	(*timestamppb.Timestamp)(nil),           // 11: google.protobuf.Timestamp
+}
+var file_rpc_account_member_proto_depIdxs = []int32{
+	11, // 0: pb.AccountMember.created_at:type_name -> google.protobuf.Timestamp
+	11, // 1: pb.AccountMember.accepted_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: pb.InviteAccountMemberResponse.member:type_name -> pb.AccountMember
+	0,  // 3: pb.ListAccountMembersResponse.members:type_name -> pb.AccountMember
+	0,  // 4: pb.ListAccountInvitationsResponse.invitations:type_name -> pb.AccountMember
+	0,  // 5: pb.AcceptAccountInvitationResponse.member:type_name -> pb.AccountMember
+	6,  // [6:6] is the sub-list for method output_type
+	6,  // [6:6] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_rpc_account_member_proto_init() }
+func file_rpc_account_member_proto_init() {
+	if File_rpc_account_member_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_account_member_proto_rawDesc), len(file_rpc_account_member_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_account_member_proto_goTypes,
+		DependencyIndexes: file_rpc_account_member_proto_depIdxs,
+		MessageInfos:      file_rpc_account_member_proto_msgTypes,
+	}.Build()
+	File_rpc_account_member_proto = out.File
+	file_rpc_account_member_proto_goTypes = nil
+	file_rpc_account_member_proto_depIdxs = nil
+}
@@ -0,0 +1,1082 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.0
+// source: rpc_admin.proto
+
+package pb
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Queue is a snapshot of one task queue. Counters under "today" reset daily.
+type Queue struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Size           int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Pending        int32                  `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`
+	Active         int32                  `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	Scheduled      int32                  `protobuf:"varint,5,opt,name=scheduled,proto3" json:"scheduled,omitempty"`
+	Retry          int32                  `protobuf:"varint,6,opt,name=retry,proto3" json:"retry,omitempty"`
+	Archived       int32                  `protobuf:"varint,7,opt,name=archived,proto3" json:"archived,omitempty"`
+	Completed      int32                  `protobuf:"varint,8,opt,name=completed,proto3" json:"completed,omitempty"`
+	ProcessedToday int32                  `protobuf:"varint,9,opt,name=processed_today,json=processedToday,proto3" json:"processed_today,omitempty"`
+	FailedToday    int32                  `protobuf:"varint,10,opt,name=failed_today,json=failedToday,proto3" json:"failed_today,omitempty"`
+	Latency        *durationpb.Duration   `protobuf:"bytes,11,opt,name=latency,proto3" json:"latency,omitempty"`
+	Paused         bool                   `protobuf:"varint,12,opt,name=paused,proto3" json:"paused,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Queue) Reset() {
+	*x = Queue{}
+	mi := &file_rpc_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Queue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Queue) ProtoMessage() {}
+
+func (x *Queue) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Queue.ProtoReflect.Descriptor instead.
+func (*Queue) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Queue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Queue) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Queue) GetPending() int32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *Queue) GetActive() int32 {
+	if x != nil {
+		return x.Active
+	}
+	return 0
+}
+
+func (x *Queue) GetScheduled() int32 {
+	if x != nil {
+		return x.Scheduled
+	}
+	return 0
+}
+
+func (x *Queue) GetRetry() int32 {
+	if x != nil {
+		return x.Retry
+	}
+	return 0
+}
+
+func (x *Queue) GetArchived() int32 {
+	if x != nil {
+		return x.Archived
+	}
+	return 0
+}
+
+func (x *Queue) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *Queue) GetProcessedToday() int32 {
+	if x != nil {
+		return x.ProcessedToday
+	}
+	return 0
+}
+
+func (x *Queue) GetFailedToday() int32 {
+	if x != nil {
+		return x.FailedToday
+	}
+	return 0
+}
+
+func (x *Queue) GetLatency() *durationpb.Duration {
+	if x != nil {
+		return x.Latency
+	}
+	return nil
+}
+
+func (x *Queue) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+// QueueTask is one task in a queue.
+type QueueTask struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Queue         string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Payload       string                 `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	State         string                 `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	MaxRetry      int32                  `protobuf:"varint,6,opt,name=max_retry,json=maxRetry,proto3" json:"max_retry,omitempty"`
+	Retried       int32                  `protobuf:"varint,7,opt,name=retried,proto3" json:"retried,omitempty"`
+	LastError     string                 `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastFailedAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_failed_at,json=lastFailedAt,proto3" json:"last_failed_at,omitempty"`
+	NextProcessAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=next_process_at,json=nextProcessAt,proto3" json:"next_process_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueTask) Reset() {
+	*x = QueueTask{}
+	mi := &file_rpc_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueTask) ProtoMessage() {}
+
+func (x *QueueTask) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueTask.ProtoReflect.Descriptor instead.
+func (*QueueTask) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueueTask) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QueueTask) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *QueueTask) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *QueueTask) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *QueueTask) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *QueueTask) GetMaxRetry() int32 {
+	if x != nil {
+		return x.MaxRetry
+	}
+	return 0
+}
+
+func (x *QueueTask) GetRetried() int32 {
+	if x != nil {
+		return x.Retried
+	}
+	return 0
+}
+
+func (x *QueueTask) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *QueueTask) GetLastFailedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastFailedAt
+	}
+	return nil
+}
+
+func (x *QueueTask) GetNextProcessAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextProcessAt
+	}
+	return nil
+}
+
+type ListQueuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueuesRequest) Reset() {
+	*x = ListQueuesRequest{}
+	mi := &file_rpc_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueuesRequest) ProtoMessage() {}
+
+func (x *ListQueuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueuesRequest.ProtoReflect.Descriptor instead.
+func (*ListQueuesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_proto_rawDescGZIP(), []int{2}
+}
+
+type ListQueuesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queues        []*Queue               `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueuesResponse) Reset() {
+	*x = ListQueuesResponse{}
+	mi := &file_rpc_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueuesResponse) ProtoMessage() {}
+
+func (x *ListQueuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueuesResponse.ProtoReflect.Descriptor instead.
+func (*ListQueuesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListQueuesResponse) GetQueues() []*Queue {
+	if x != nil {
+		return x.Queues
+	}
+	return nil
+}
+
+type ListQueueTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queue         string                 `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	PageId        int32                  `protobuf:"varint,3,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueueTasksRequest) Reset() {
+	*x = ListQueueTasksRequest{}
+	mi := &file_rpc_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueueTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueueTasksRequest) ProtoMessage() {}
+
+func (x *ListQueueTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueueTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListQueueTasksRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListQueueTasksRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *ListQueueTasksRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ListQueueTasksRequest) GetPageId() int32 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *ListQueueTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListQueueTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*QueueTask           `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueueTasksResponse) Reset() {
+	*x = ListQueueTasksResponse{}
+	mi := &file_rpc_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueueTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueueTasksResponse) ProtoMessage() {}
+
+func (x *ListQueueTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueueTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListQueueTasksResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListQueueTasksResponse) GetTasks() []*QueueTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type RetryQueueTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queue         string                 `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryQueueTaskRequest) Reset() {
+	*x = RetryQueueTaskRequest{}
+	mi := &file_rpc_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryQueueTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryQueueTaskRequest) ProtoMessage() {}
+
+func (x *RetryQueueTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryQueueTaskRequest.ProtoReflect.Descriptor instead.
+func (*RetryQueueTaskRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RetryQueueTaskRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *RetryQueueTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+type RetryQueueTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryQueueTaskResponse) Reset() {
+	*x = RetryQueueTaskResponse{}
+	mi := &file_rpc_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryQueueTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryQueueTaskResponse) ProtoMessage() {}
+
+func (x *RetryQueueTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryQueueTaskResponse.ProtoReflect.Descriptor instead.
+func (*RetryQueueTaskResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RetryQueueTaskResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type DeleteQueueTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queue         string                 `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteQueueTaskRequest) Reset() {
+	*x = DeleteQueueTaskRequest{}
+	mi := &file_rpc_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteQueueTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQueueTaskRequest) ProtoMessage() {}
+
+func (x *DeleteQueueTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQueueTaskRequest.ProtoReflect.Descriptor instead.
+func (*DeleteQueueTaskRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteQueueTaskRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *DeleteQueueTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+type DeleteQueueTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteQueueTaskResponse) Reset() {
+	*x = DeleteQueueTaskResponse{}
+	mi := &file_rpc_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteQueueTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQueueTaskResponse) ProtoMessage() {}
+
+func (x *DeleteQueueTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQueueTaskResponse.ProtoReflect.Descriptor instead.
+func (*DeleteQueueTaskResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteQueueTaskResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetLogLevelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLogLevelRequest) Reset() {
+	*x = GetLogLevelRequest{}
+	mi := &file_rpc_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLogLevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogLevelRequest) ProtoMessage() {}
+
+func (x *GetLogLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogLevelRequest.ProtoReflect.Descriptor instead.
+func (*GetLogLevelRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_proto_rawDescGZIP(), []int{10}
+}
+
+type GetLogLevelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Level         string                 `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLogLevelResponse) Reset() {
+	*x = GetLogLevelResponse{}
+	mi := &file_rpc_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLogLevelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogLevelResponse) ProtoMessage() {}
+
+func (x *GetLogLevelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogLevelResponse.ProtoReflect.Descriptor instead.
+func (*GetLogLevelResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetLogLevelResponse) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+type SetLogLevelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Level         string                 `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLogLevelRequest) Reset() {
+	*x = SetLogLevelRequest{}
+	mi := &file_rpc_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLogLevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevelRequest) ProtoMessage() {}
+
+func (x *SetLogLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogLevelRequest.ProtoReflect.Descriptor instead.
+func (*SetLogLevelRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetLogLevelRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+type SetLogLevelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Level         string                 `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	PreviousLevel string                 `protobuf:"bytes,2,opt,name=previous_level,json=previousLevel,proto3" json:"previous_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLogLevelResponse) Reset() {
+	*x = SetLogLevelResponse{}
+	mi := &file_rpc_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLogLevelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevelResponse) ProtoMessage() {}
+
+func (x *SetLogLevelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogLevelResponse.ProtoReflect.Descriptor instead.
+func (*SetLogLevelResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SetLogLevelResponse) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *SetLogLevelResponse) GetPreviousLevel() string {
+	if x != nil {
+		return x.PreviousLevel
+	}
+	return ""
+}
+
+type CreateDebugLogTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ttl           *durationpb.Duration   `protobuf:"bytes,1,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDebugLogTokenRequest) Reset() {
+	*x = CreateDebugLogTokenRequest{}
+	mi := &file_rpc_admin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDebugLogTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDebugLogTokenRequest) ProtoMessage() {}
+
+func (x *CreateDebugLogTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDebugLogTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateDebugLogTokenRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateDebugLogTokenRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+type CreateDebugLogTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Header        string                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDebugLogTokenResponse) Reset() {
+	*x = CreateDebugLogTokenResponse{}
+	mi := &file_rpc_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDebugLogTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDebugLogTokenResponse) ProtoMessage() {}
+
+func (x *CreateDebugLogTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDebugLogTokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateDebugLogTokenResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateDebugLogTokenResponse) GetHeader() string {
+	if x != nil {
+		return x.Header
+	}
+	return ""
+}
+
+func (x *CreateDebugLogTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateDebugLogTokenResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+var File_rpc_admin_proto protoreflect.FileDescriptor
+
+const file_rpc_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x0frpc_admin.proto\x12\x02pb\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\roptions.proto\"\xe7\x06\n" +
+	"\x05Queue\x12/\n" +
+	"\x04name\x18\x01 \x01(\tB\x1b\x92A\x182\vQueue name.J\t\"default\"R\x04name\x12c\n" +
+	"\x04size\x18\x02 \x01(\x05BO\x92AL2JTotal tasks in the queue (pending, active, scheduled, retry and archived).R\x04size\x12:\n" +
+	"\apending\x18\x03 \x01(\x05B \x92A\x1d2\x1bTasks waiting for a worker.R\apending\x123\n" +
+	"\x06active\x18\x04 \x01(\x05B\x1b\x92A\x182\x16Tasks being processed.R\x06active\x12=\n" +
+	"\tscheduled\x18\x05 \x01(\x05B\x1f\x92A\x1c2\x1aTasks scheduled for later.R\tscheduled\x12G\n" +
+	"\x05retry\x18\x06 \x01(\x05B1\x92A.2,Failed tasks waiting for their next attempt.R\x05retry\x12?\n" +
+	"\barchived\x18\a \x01(\x05B#\x92A 2\x1eTasks that ran out of retries.R\barchived\x12M\n" +
+	"\tcompleted\x18\b \x01(\x05B/\x92A,2*Completed tasks still kept for inspection.R\tcompleted\x12Y\n" +
+	"\x0fprocessed_today\x18\t \x01(\x05B0\x92A-2+Tasks processed today, succeeded or failed.R\x0eprocessedToday\x12@\n" +
+	"\ffailed_today\x18\n" +
+	" \x01(\x05B\x1d\x92A\x1a2\x18Tasks that failed today.R\vfailedToday\x12Y\n" +
+	"\alatency\x18\v \x01(\v2\x19.google.protobuf.DurationB$\x92A!2\x1fAge of the oldest pending task.R\alatency\x12G\n" +
+	"\x06paused\x18\f \x01(\bB/\x92A,2*Whether processing of the queue is paused.R\x06paused\"\xae\x06\n" +
+	"\tQueueTask\x12_\n" +
+	"\x02id\x18\x01 \x01(\tBO\x92AL2=Task ID. Tasks published from the outbox use outbox:<row id>.J\v\"outbox:42\"R\x02id\x120\n" +
+	"\x05queue\x18\x02 \x01(\tB\x1a\x92A\x172\x15Queue the task is in.R\x05queue\x12=\n" +
+	"\x04type\x18\x03 \x01(\tB)\x92A&2\n" +
+	"Task type.J\x18\"task:send_verify_email\"R\x04type\x121\n" +
+	"\apayload\x18\x04 \x01(\tB\x17\x92A\x142\x12JSON task payload.R\apayload\x12T\n" +
+	"\x05state\x18\x05 \x01(\tB>\x92A;29pending, active, scheduled, retry, archived or completed.R\x05state\x12N\n" +
+	"\tmax_retry\x18\x06 \x01(\x05B1\x92A.2,Retries allowed before the task is archived.R\bmaxRetry\x12.\n" +
+	"\aretried\x18\a \x01(\x05B\x14\x92A\x112\x0fRetries so far.R\aretried\x12G\n" +
+	"\n" +
+	"last_error\x18\b \x01(\tB(\x92A%2#Error from the last failed attempt.R\tlastError\x12p\n" +
+	"\x0elast_failed_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampB.\x92A+2)UTC timestamp of the last failed attempt.R\flastFailedAt\x12\x8a\x01\n" +
+	"\x0fnext_process_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampBF\x92AC2AUTC timestamp of the next attempt, for scheduled and retry tasks.R\rnextProcessAt\"\x13\n" +
+	"\x11ListQueuesRequest\"7\n" +
+	"\x12ListQueuesResponse\x12!\n" +
+	"\x06queues\x18\x01 \x03(\v2\t.pb.QueueR\x06queues\"\xc2\x02\n" +
+	"\x15ListQueueTasksRequest\x121\n" +
+	"\x05queue\x18\x01 \x01(\tB\x1b\x92A\x182\vQueue name.J\t\"default\"R\x05queue\x12l\n" +
+	"\x05state\x18\x02 \x01(\tBV\x92AS2ETask state: pending, active, scheduled, retry, archived or completed.J\n" +
+	"\"archived\"R\x05state\x12>\n" +
+	"\apage_id\x18\x03 \x01(\x05B%\x92A\"2\x141-based page number.J\x011i\x00\x00\x00\x00\x00\x00\xf0?R\x06pageId\x12H\n" +
+	"\tpage_size\x18\x04 \x01(\x05B+\x92A(2\x19Number of tasks per page.J\x0210i\x00\x00\x00\x00\x00\x00\xf0?R\bpageSize\"=\n" +
+	"\x16ListQueueTasksResponse\x12#\n" +
+	"\x05tasks\x18\x01 \x03(\v2\r.pb.QueueTaskR\x05tasks\"\x95\x01\n" +
+	"\x15RetryQueueTaskRequest\x121\n" +
+	"\x05queue\x18\x01 \x01(\tB\x1b\x92A\x182\vQueue name.J\t\"default\"R\x05queue\x12I\n" +
+	"\atask_id\x18\x02 \x01(\tB0\x92A-2+ID of an archived, retry or scheduled task.R\x06taskId\"@\n" +
+	"\x16RetryQueueTaskResponse\x12&\n" +
+	"\x06status\x18\x01 \x01(\tB\x0e\x92A\vJ\t\"pending\"R\x06status\"\x94\x01\n" +
+	"\x16DeleteQueueTaskRequest\x121\n" +
+	"\x05queue\x18\x01 \x01(\tB\x1b\x92A\x182\vQueue name.J\t\"default\"R\x05queue\x12G\n" +
+	"\atask_id\x18\x02 \x01(\tB.\x92A+2)ID of a task that is not being processed.R\x06taskId\"A\n" +
+	"\x17DeleteQueueTaskResponse\x12&\n" +
+	"\x06status\x18\x01 \x01(\tB\x0e\x92A\vJ\t\"deleted\"R\x06status\"\x14\n" +
+	"\x12GetLogLevelRequest\"w\n" +
+	"\x13GetLogLevelResponse\x12`\n" +
+	"\x05level\x18\x01 \x01(\tBJ\x92AG2=Current minimum log level: debug, info, warn, error or fatal.J\x06\"info\"R\x05level\"s\n" +
+	"\x12SetLogLevelRequest\x12]\n" +
+	"\x05level\x18\x01 \x01(\tBG\x92AD29New minimum log level: debug, info, warn, error or fatal.J\a\"debug\"R\x05level\"\x9d\x01\n" +
+	"\x13SetLogLevelResponse\x128\n" +
+	"\x05level\x18\x01 \x01(\tB\"\x92A\x1f2\x14Level now in effect.J\a\"debug\"R\x05level\x12L\n" +
+	"\x0eprevious_level\x18\x02 \x01(\tB%\x92A\"2\x18Level before the change.J\x06\"info\"R\rpreviousLevel\"\x83\x01\n" +
+	"\x1aCreateDebugLogTokenRequest\x12e\n" +
+	"\x03ttl\x18\x01 \x01(\v2\x19.google.protobuf.DurationB8\x92A52+How long the token stays valid, at most 1h.J\x06\"900s\"R\x03ttl\"\xc7\x02\n" +
+	"\x1bCreateDebugLogTokenResponse\x12H\n" +
+	"\x06header\x18\x01 \x01(\tB0\x92A-2\x1cHeader to send the token in.J\r\"X-Debug-Log\"R\x06header\x12m\n" +
+	"\x05token\x18\x02 \x01(\tBW\x92AP2NHeader value. Requests carrying it are logged at debug level until it expires.\x80\xb5\x18\x01R\x05token\x12o\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB4\x92A12/UTC timestamp after which the token is ignored.R\texpiresAtB(Z&github.com/a7medalyapany/GoBank.git/pbb\x06proto3"
+
+var (
+	file_rpc_admin_proto_rawDescOnce sync.Once
+	file_rpc_admin_proto_rawDescData []byte
+)
+
+func file_rpc_admin_proto_rawDescGZIP() []byte {
+	file_rpc_admin_proto_rawDescOnce.Do(func() {
+		file_rpc_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_admin_proto_rawDesc), len(file_rpc_admin_proto_rawDesc)))
+	})
+	return file_rpc_admin_proto_rawDescData
+}
+
+var file_rpc_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_rpc_admin_proto_goTypes = []any{
+	(*Queue)(nil),                       // 0: pb.Queue
+	(*QueueTask)(nil),                   // 1: pb.QueueTask
+	(*ListQueuesRequest)(nil),           // 2: pb.ListQueuesRequest
+	(*ListQueuesResponse)(nil),          // 3: pb.ListQueuesResponse
+	(*ListQueueTasksRequest)(nil),       // 4: pb.ListQueueTasksRequest
+	(*ListQueueTasksResponse)(nil),      // 5: pb.ListQueueTasksResponse
+	(*RetryQueueTaskRequest)(nil),       // 6: pb.RetryQueueTaskRequest
+	(*RetryQueueTaskResponse)(nil),      // 7: pb.RetryQueueTaskResponse
+	(*DeleteQueueTaskRequest)(nil),      // 8: pb.DeleteQueueTaskRequest
+	(*DeleteQueueTaskResponse)(nil),     // 9: pb.DeleteQueueTaskResponse
+	(*GetLogLevelRequest)(nil),          // 10: pb.GetLogLevelRequest
+	(*GetLogLevelResponse)(nil),         // 11: pb.GetLogLevelResponse
+	(*SetLogLevelRequest)(nil),          // 12: pb.SetLogLevelRequest
+	(*SetLogLevelResponse)(nil),         // 13: pb.SetLogLevelResponse
+	(*CreateDebugLogTokenRequest)(nil),  // 14: pb.CreateDebugLogTokenRequest
+	(*CreateDebugLogTokenResponse)(nil), // 15: pb.CreateDebugLogTokenResponse
+	(*durationpb.Duration)(nil),         // 16: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),       // 17: google.protobuf.Timestamp
+}
+var file_rpc_admin_proto_depIdxs = []int32{
+	16, // 0: pb.Queue.latency:type_name -> google.protobuf.Duration
+	17, // 1: pb.QueueTask.last_failed_at:type_name -> google.protobuf.Timestamp
+	17, // 2: pb.QueueTask.next_process_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: pb.ListQueuesResponse.queues:type_name -> pb.Queue
+	1,  // 4: pb.ListQueueTasksResponse.tasks:type_name -> pb.QueueTask
+	16, // 5: pb.CreateDebugLogTokenRequest.ttl:type_name -> google.protobuf.Duration
+	17, // 6: pb.CreateDebugLogTokenResponse.expires_at:type_name -> google.protobuf.Timestamp
+	7,  // [7:7] is the sub-list for method output_type
+	7,  // [7:7] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_rpc_admin_proto_init() }
+func file_rpc_admin_proto_init() {
+	if File_rpc_admin_proto != nil {
+		return
+	}
+	file_options_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_admin_proto_rawDesc), len(file_rpc_admin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_admin_proto_goTypes,
+		DependencyIndexes: file_rpc_admin_proto_depIdxs,
+		MessageInfos:      file_rpc_admin_proto_msgTypes,
+	}.Build()
+	File_rpc_admin_proto = out.File
+	file_rpc_admin_proto_goTypes = nil
+	file_rpc_admin_proto_depIdxs = nil
+}
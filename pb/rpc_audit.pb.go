@@ -0,0 +1,385 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.0
+// source: rpc_audit.proto
+
+package pb
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AuditEvent records who did what to which resource. Events are append-only.
+type AuditEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Actor         string                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	TargetType    string                 `protobuf:"bytes,4,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	TargetId      string                 `protobuf:"bytes,5,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Before        string                 `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	After         string                 `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,8,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,9,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	RequestId     string                 `protobuf:"bytes,10,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_rpc_audit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_audit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_rpc_audit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuditEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type QueryAuditLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Actor         string                 `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	TargetType    string                 `protobuf:"bytes,3,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	TargetId      string                 `protobuf:"bytes,4,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	PageId        int32                  `protobuf:"varint,7,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,8,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryAuditLogRequest) Reset() {
+	*x = QueryAuditLogRequest{}
+	mi := &file_rpc_audit_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAuditLogRequest) ProtoMessage() {}
+
+func (x *QueryAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_audit_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*QueryAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_audit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryAuditLogRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *QueryAuditLogRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *QueryAuditLogRequest) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *QueryAuditLogRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *QueryAuditLogRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *QueryAuditLogRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *QueryAuditLogRequest) GetPageId() int32 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *QueryAuditLogRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type QueryAuditLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryAuditLogResponse) Reset() {
+	*x = QueryAuditLogResponse{}
+	mi := &file_rpc_audit_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAuditLogResponse) ProtoMessage() {}
+
+func (x *QueryAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_audit_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*QueryAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_audit_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryAuditLogResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+var File_rpc_audit_proto protoreflect.FileDescriptor
+
+const file_rpc_audit_proto_rawDesc = "" +
+	"\n" +
+	"\x0frpc_audit.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xa4\a\n" +
+	"\n" +
+	"AuditEvent\x12R\n" +
+	"\x02id\x18\x01 \x01(\x03BB\x92A?2=Unique event ID, increasing in the order events were written.R\x02id\x12N\n" +
+	"\x05actor\x18\x02 \x01(\tB8\x92A52#Username that performed the action.J\x0e\"john_doe_123\"R\x05actor\x12>\n" +
+	"\x06action\x18\x03 \x01(\tB&\x92A#2\x0eWhat happened.J\x11\"account.updated\"R\x06action\x12o\n" +
+	"\vtarget_type\x18\x04 \x01(\tBN\x92AK2>Kind of resource acted on: user, session, account or transfer.J\t\"account\"R\n" +
+	"targetType\x12D\n" +
+	"\ttarget_id\x18\x05 \x01(\tB'\x92A$2\x1cID of the resource acted on.J\x04\"42\"R\btargetId\x12g\n" +
+	"\x06before\x18\x06 \x01(\tBO\x92AL2JJSON state of the resource before the action. Empty when it did not exist.R\x06before\x12b\n" +
+	"\x05after\x18\a \x01(\tBL\x92AI2GJSON state of the resource after the action. Empty when it was deleted.R\x05after\x124\n" +
+	"\tclient_ip\x18\b \x01(\tB\x17\x92A\x142\x12Client IP address.R\bclientIp\x126\n" +
+	"\n" +
+	"user_agent\x18\t \x01(\tB\x17\x92A\x142\x12Client user agent.R\tuserAgent\x12b\n" +
+	"\n" +
+	"request_id\x18\n" +
+	" \x01(\tBC\x92A@2>X-Request-Id of the request, matching the request's log lines.R\trequestId\x12\\\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampB!\x92A\x1e2\x1cUTC timestamp of the action.R\tcreatedAt\"\xad\x05\n" +
+	"\x14QueryAuditLogRequest\x128\n" +
+	"\x05actor\x18\x01 \x01(\tB\"\x92A\x1f2\x1dOnly events by this username.R\x05actor\x12N\n" +
+	"\x06action\x18\x02 \x01(\tB6\x92A32\x1dOnly events with this action.J\x12\"transfer.created\"R\x06action\x12V\n" +
+	"\vtarget_type\x18\x03 \x01(\tB5\x92A22%Only events on this kind of resource.J\t\"account\"R\n" +
+	"targetType\x12a\n" +
+	"\ttarget_id\x18\x04 \x01(\tBD\x92AA2?Only events on the resource with this ID. Use with target_type.R\btargetId\x12f\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB+\x92A(2&Only events at or after this UTC time.R\tstartTime\x12]\n" +
+	"\bend_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB&\x92A#2!Only events before this UTC time.R\aendTime\x12>\n" +
+	"\apage_id\x18\a \x01(\x05B%\x92A\"2\x141-based page number.J\x011i\x00\x00\x00\x00\x00\x00\xf0?R\x06pageId\x12I\n" +
+	"\tpage_size\x18\b \x01(\x05B,\x92A)2\x1aNumber of events per page.J\x0210i\x00\x00\x00\x00\x00\x00\xf0?R\bpageSize\"?\n" +
+	"\x15QueryAuditLogResponse\x12&\n" +
+	"\x06events\x18\x01 \x03(\v2\x0e.pb.AuditEventR\x06eventsB(Z&github.com/a7medalyapany/GoBank.git/pbb\x06proto3"
+
+var (
+	file_rpc_audit_proto_rawDescOnce sync.Once
+	file_rpc_audit_proto_rawDescData []byte
+)
+
+func file_rpc_audit_proto_rawDescGZIP() []byte {
+	file_rpc_audit_proto_rawDescOnce.Do(func() {
+		file_rpc_audit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_audit_proto_rawDesc), len(file_rpc_audit_proto_rawDesc)))
+	})
+	return file_rpc_audit_proto_rawDescData
+}
+
+var file_rpc_audit_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_rpc_audit_proto_goTypes = []any{
+	(*AuditEvent)(nil),            // 0: pb.AuditEvent
+	(*QueryAuditLogRequest)(nil),  // 1: pb.QueryAuditLogRequest
+	(*QueryAuditLogResponse)(nil), // 2: pb.QueryAuditLogResponse
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_rpc_audit_proto_depIdxs = []int32{
+	3, // 0: pb.AuditEvent.created_at:type_name -> google.protobuf.Timestamp
+	3, // 1: pb.QueryAuditLogRequest.start_time:type_name -> google.protobuf.Timestamp
+	3, // 2: pb.QueryAuditLogRequest.end_time:type_name -> google.protobuf.Timestamp
+	0, // 3: pb.QueryAuditLogResponse.events:type_name -> pb.AuditEvent
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_rpc_audit_proto_init() }
+func file_rpc_audit_proto_init() {
+	if File_rpc_audit_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_audit_proto_rawDesc), len(file_rpc_audit_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_audit_proto_goTypes,
+		DependencyIndexes: file_rpc_audit_proto_depIdxs,
+		MessageInfos:      file_rpc_audit_proto_msgTypes,
+	}.Build()
+	File_rpc_audit_proto = out.File
+	file_rpc_audit_proto_goTypes = nil
+	file_rpc_audit_proto_depIdxs = nil
+}
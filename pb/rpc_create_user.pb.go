@@ -36,7 +36,10 @@ type CreateUserRequest struct {
 	// Password for the account. Minimum 8 characters.
 	// Stored as a bcrypt hash — never in plain text.
 	// example: "supersecret123"
-	Password      string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	// Preferred language for emails. Supported: en, ar.
+	// example: "ar"
+	Locale        *string `protobuf:"bytes,5,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -99,6 +102,13 @@ func (x *CreateUserRequest) GetPassword() string {
 	return ""
 }
 
+func (x *CreateUserRequest) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
 type CreateUserResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The newly created user (password excluded).
@@ -149,13 +159,15 @@ var File_rpc_create_user_proto protoreflect.FileDescriptor
 const file_rpc_create_user_proto_rawDesc = "" +
 	"\n" +
 	"\x15rpc_create_user.proto\x12\x02pb\x1a\n" +
-	"user.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xe6\x03\n" +
+	"user.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\roptions.proto\"\xde\x04\n" +
 	"\x11CreateUserRequest\x12\x93\x01\n" +
 	"\busername\x18\x01 \x01(\tBw\x92At2NUnique alphanumeric username. Lowercase letters, digits, and underscores only.J\x0e\"john_doe_123\"x2\x80\x01\x03\x8a\x01\f^[a-z0-9_]+$R\busername\x12Q\n" +
 	"\tfull_name\x18\x02 \x01(\tB4\x92A12\x1eFull display name of the user.J\n" +
 	"\"John Doe\"xd\x80\x01\x02R\bfullName\x12g\n" +
-	"\x05email\x18\x03 \x01(\tBQ\x92AN28Valid email address. Must be unique across all accounts.J\x12\"john@example.com\"R\x05email\x12\x7f\n" +
-	"\bpassword\x18\x04 \x01(\tBc\x92A`2>Account password. Minimum 8 characters. Stored as bcrypt hash.J\x10\"supersecret123\"\x80\x01\b\xa2\x02\bpasswordR\bpassword\"2\n" +
+	"\x05email\x18\x03 \x01(\tBQ\x92AN28Valid email address. Must be unique across all accounts.J\x12\"john@example.com\"R\x05email\x12\x83\x01\n" +
+	"\bpassword\x18\x04 \x01(\tBg\x92A`2>Account password. Minimum 8 characters. Stored as bcrypt hash.J\x10\"supersecret123\"\x80\x01\b\xa2\x02\bpassword\x80\xb5\x18\x01R\bpassword\x12f\n" +
+	"\x06locale\x18\x05 \x01(\tBI\x92AF2>Preferred language for emails. One of: en, ar. Defaults to en.J\x04\"ar\"H\x00R\x06locale\x88\x01\x01B\t\n" +
+	"\a_locale\"2\n" +
 	"\x12CreateUserResponse\x12\x1c\n" +
 	"\x04user\x18\x01 \x01(\v2\b.pb.UserR\x04userB(Z&github.com/a7medalyapany/GoBank.git/pbb\x06proto3"
 
@@ -192,6 +204,8 @@ func file_rpc_create_user_proto_init() {
 		return
 	}
 	file_user_proto_init()
+	file_options_proto_init()
+	file_rpc_create_user_proto_msgTypes[0].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -0,0 +1,287 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.0
+// source: rpc_email_change.proto
+
+package pb
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ConfirmEmailChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmEmailChangeRequest) Reset() {
+	*x = ConfirmEmailChangeRequest{}
+	mi := &file_rpc_email_change_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmEmailChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmEmailChangeRequest) ProtoMessage() {}
+
+func (x *ConfirmEmailChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_email_change_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmEmailChangeRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmEmailChangeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_email_change_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConfirmEmailChangeRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ConfirmEmailChangeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ConfirmEmailChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmEmailChangeResponse) Reset() {
+	*x = ConfirmEmailChangeResponse{}
+	mi := &file_rpc_email_change_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmEmailChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmEmailChangeResponse) ProtoMessage() {}
+
+func (x *ConfirmEmailChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_email_change_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmEmailChangeResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmEmailChangeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_email_change_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConfirmEmailChangeResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type CancelEmailChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelEmailChangeRequest) Reset() {
+	*x = CancelEmailChangeRequest{}
+	mi := &file_rpc_email_change_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelEmailChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelEmailChangeRequest) ProtoMessage() {}
+
+func (x *CancelEmailChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_email_change_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelEmailChangeRequest.ProtoReflect.Descriptor instead.
+func (*CancelEmailChangeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_email_change_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CancelEmailChangeRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CancelEmailChangeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type CancelEmailChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelEmailChangeResponse) Reset() {
+	*x = CancelEmailChangeResponse{}
+	mi := &file_rpc_email_change_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelEmailChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelEmailChangeResponse) ProtoMessage() {}
+
+func (x *CancelEmailChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_email_change_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelEmailChangeResponse.ProtoReflect.Descriptor instead.
+func (*CancelEmailChangeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_email_change_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CancelEmailChangeResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+var File_rpc_email_change_proto protoreflect.FileDescriptor
+
+const file_rpc_email_change_proto_rawDesc = "" +
+	"\n" +
+	"\x16rpc_email_change.proto\x12\x02pb\x1a\n" +
+	"user.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\roptions.proto\"\xcc\x01\n" +
+	"\x19ConfirmEmailChangeRequest\x12Q\n" +
+	"\x02id\x18\x01 \x01(\x03BA\x92A>23ID of the email change, from the confirmation link.i\x00\x00\x00\x00\x00\x00\xf0?R\x02id\x12\\\n" +
+	"\x04code\x18\x02 \x01(\tBH\x92AA2?Secret code from the confirmation link sent to the new address.\x80\xb5\x18\x01R\x04code\"o\n" +
+	"\x1aConfirmEmailChangeResponse\x12Q\n" +
+	"\x04user\x18\x01 \x01(\v2\b.pb.UserB3\x92A02.The user with the new, verified email address.R\x04user\"\xc6\x01\n" +
+	"\x18CancelEmailChangeRequest\x12S\n" +
+	"\x02id\x18\x01 \x01(\x03BC\x92A@2>ID of the email change, from the link sent to the old address.R\x02id\x12U\n" +
+	"\x04code\x18\x02 \x01(\tBA\x92A:28Cancellation code from the link sent to the old address.\x80\xb5\x18\x01R\x04code\"~\n" +
+	"\x19CancelEmailChangeResponse\x12a\n" +
+	"\x05email\x18\x01 \x01(\tBK\x92AH22The email address on the account after cancelling.J\x12\"john@example.com\"R\x05emailB(Z&github.com/a7medalyapany/GoBank.git/pbb\x06proto3"
+
+var (
+	file_rpc_email_change_proto_rawDescOnce sync.Once
+	file_rpc_email_change_proto_rawDescData []byte
+)
+
+func file_rpc_email_change_proto_rawDescGZIP() []byte {
+	file_rpc_email_change_proto_rawDescOnce.Do(func() {
+		file_rpc_email_change_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_email_change_proto_rawDesc), len(file_rpc_email_change_proto_rawDesc)))
+	})
+	return file_rpc_email_change_proto_rawDescData
+}
+
+var file_rpc_email_change_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_rpc_email_change_proto_goTypes = []any{
+	(*ConfirmEmailChangeRequest)(nil),  // 0: pb.ConfirmEmailChangeRequest
+	(*ConfirmEmailChangeResponse)(nil), // 1: pb.ConfirmEmailChangeResponse
+	(*CancelEmailChangeRequest)(nil),   // 2: pb.CancelEmailChangeRequest
+	(*CancelEmailChangeResponse)(nil),  // 3: pb.CancelEmailChangeResponse
+	(*User)(nil),                       // 4: pb.User
+}
+var file_rpc_email_change_proto_depIdxs = []int32{
+	4, // 0: pb.ConfirmEmailChangeResponse.user:type_name -> pb.User
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_email_change_proto_init() }
+func file_rpc_email_change_proto_init() {
+	if File_rpc_email_change_proto != nil {
+		return
+	}
+	file_user_proto_init()
+	file_options_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_email_change_proto_rawDesc), len(file_rpc_email_change_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_email_change_proto_goTypes,
+		DependencyIndexes: file_rpc_email_change_proto_depIdxs,
+		MessageInfos:      file_rpc_email_change_proto_msgTypes,
+	}.Build()
+	File_rpc_email_change_proto = out.File
+	file_rpc_email_change_proto_goTypes = nil
+	file_rpc_email_change_proto_depIdxs = nil
+}
@@ -28,7 +28,9 @@ type LoginUserRequest struct {
 	// Username of the account to authenticate.
 	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	// Account password (plain text — sent over HTTPS only).
-	Password      string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// Optional subset of scopes to grant. Empty means every scope.
+	Scopes        []string `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -77,6 +79,13 @@ func (x *LoginUserRequest) GetPassword() string {
 	return ""
 }
 
+func (x *LoginUserRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
 type LoginUserResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// UUID of the session created for this login.
@@ -172,16 +181,17 @@ var File_rpc_login_user_proto protoreflect.FileDescriptor
 const file_rpc_login_user_proto_rawDesc = "" +
 	"\n" +
 	"\x14rpc_login_user.proto\x12\x02pb\x1a\n" +
-	"user.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdf\x01\n" +
+	"user.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\roptions.proto\"\x94\x03\n" +
 	"\x10LoginUserRequest\x12Y\n" +
-	"\busername\x18\x01 \x01(\tB=\x92A:2(Username of the account to authenticate.J\x0e\"john_doe_123\"R\busername\x12p\n" +
-	"\bpassword\x18\x02 \x01(\tBT\x92AQ22Account password. Transmitted securely over HTTPS.J\x10\"supersecret123\"\xa2\x02\bpasswordR\bpassword\"\x86\x06\n" +
+	"\busername\x18\x01 \x01(\tB=\x92A:2(Username of the account to authenticate.J\x0e\"john_doe_123\"R\busername\x12t\n" +
+	"\bpassword\x18\x02 \x01(\tBX\x92AQ22Account password. Transmitted securely over HTTPS.J\x10\"supersecret123\"\xa2\x02\bpassword\x80\xb5\x18\x01R\bpassword\x12\xae\x01\n" +
+	"\x06scopes\x18\x03 \x03(\tB\x95\x01\x92A\x91\x012lOptional subset of scopes to grant, e.g. for a third-party integration. Leave empty for a full-access token.J![\"accounts:read\", \"entries:read\"]R\x06scopes\"\x8e\x06\n" +
 	"\x11LoginUserResponse\x12O\n" +
 	"\n" +
-	"session_id\x18\x01 \x01(\tB0\x92A-2+UUID of the session created for this login.R\tsessionId\x12t\n" +
-	"\faccess_token\x18\x02 \x01(\tBQ\x92AN2LShort-lived PASETO access token. Include as: `Authorization: Bearer <token>`R\vaccessToken\x12\x84\x01\n" +
-	"\x17access_token_expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB1\x92A.2,UTC timestamp when the access token expires.R\x14accessTokenExpiresAt\x12\x8b\x01\n" +
-	"\rrefresh_token\x18\x04 \x01(\tBf\x92Ac2aLong-lived refresh token. Store securely. Use at /v1/auth/renew_access to get a new access token.R\frefreshToken\x12\xaf\x01\n" +
+	"session_id\x18\x01 \x01(\tB0\x92A-2+UUID of the session created for this login.R\tsessionId\x12x\n" +
+	"\faccess_token\x18\x02 \x01(\tBU\x92AN2LShort-lived PASETO access token. Include as: `Authorization: Bearer <token>`\x80\xb5\x18\x01R\vaccessToken\x12\x84\x01\n" +
+	"\x17access_token_expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB1\x92A.2,UTC timestamp when the access token expires.R\x14accessTokenExpiresAt\x12\x8f\x01\n" +
+	"\rrefresh_token\x18\x04 \x01(\tBj\x92Ac2aLong-lived refresh token. Store securely. Use at /v1/auth/renew_access to get a new access token.\x80\xb5\x18\x01R\frefreshToken\x12\xaf\x01\n" +
 	"\x18refresh_token_expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampBZ\x92AW2UUTC timestamp when the refresh token expires. After this, the user must log in again.R\x15refreshTokenExpiresAt\x12c\n" +
 	"\x04user\x18\x06 \x01(\v2\b.pb.UserBE\x92AB2@Authenticated user's public profile. Password is never returned.R\x04userB(Z&github.com/a7medalyapany/GoBank.git/pbb\x06proto3"
 
@@ -221,6 +231,7 @@ func file_rpc_login_user_proto_init() {
 		return
 	}
 	file_user_proto_init()
+	file_options_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -0,0 +1,646 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.0
+// source: rpc_notification.proto
+
+package pb
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Notification is an in-app message for the authenticated user.
+type Notification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	TransferId    *wrapperspb.Int64Value `protobuf:"bytes,5,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	ReadAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=read_at,json=readAt,proto3" json:"read_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_rpc_notification_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_notification_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_rpc_notification_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Notification) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Notification) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Notification) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Notification) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Notification) GetTransferId() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.TransferId
+	}
+	return nil
+}
+
+func (x *Notification) GetReadAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReadAt
+	}
+	return nil
+}
+
+func (x *Notification) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// NotificationPreferences controls how the user is told about transfers.
+type NotificationPreferences struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransferEmail bool                   `protobuf:"varint,1,opt,name=transfer_email,json=transferEmail,proto3" json:"transfer_email,omitempty"`
+	TransferInApp bool                   `protobuf:"varint,2,opt,name=transfer_in_app,json=transferInApp,proto3" json:"transfer_in_app,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationPreferences) Reset() {
+	*x = NotificationPreferences{}
+	mi := &file_rpc_notification_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreferences) ProtoMessage() {}
+
+func (x *NotificationPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_notification_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreferences.ProtoReflect.Descriptor instead.
+func (*NotificationPreferences) Descriptor() ([]byte, []int) {
+	return file_rpc_notification_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NotificationPreferences) GetTransferEmail() bool {
+	if x != nil {
+		return x.TransferEmail
+	}
+	return false
+}
+
+func (x *NotificationPreferences) GetTransferInApp() bool {
+	if x != nil {
+		return x.TransferInApp
+	}
+	return false
+}
+
+type ListNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        int32                  `protobuf:"varint,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	UnreadOnly    bool                   `protobuf:"varint,3,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsRequest) Reset() {
+	*x = ListNotificationsRequest{}
+	mi := &file_rpc_notification_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsRequest) ProtoMessage() {}
+
+func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_notification_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_notification_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListNotificationsRequest) GetPageId() int32 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+type ListNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsResponse) Reset() {
+	*x = ListNotificationsResponse{}
+	mi := &file_rpc_notification_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsResponse) ProtoMessage() {}
+
+func (x *ListNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_notification_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_notification_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListNotificationsResponse) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+type MarkNotificationReadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkNotificationReadRequest) Reset() {
+	*x = MarkNotificationReadRequest{}
+	mi := &file_rpc_notification_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNotificationReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationReadRequest) ProtoMessage() {}
+
+func (x *MarkNotificationReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_notification_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkNotificationReadRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_notification_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MarkNotificationReadRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type MarkNotificationReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notification  *Notification          `protobuf:"bytes,1,opt,name=notification,proto3" json:"notification,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkNotificationReadResponse) Reset() {
+	*x = MarkNotificationReadResponse{}
+	mi := &file_rpc_notification_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNotificationReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationReadResponse) ProtoMessage() {}
+
+func (x *MarkNotificationReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_notification_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkNotificationReadResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_notification_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MarkNotificationReadResponse) GetNotification() *Notification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+type GetNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesRequest) Reset() {
+	*x = GetNotificationPreferencesRequest{}
+	mi := &file_rpc_notification_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_notification_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_notification_proto_rawDescGZIP(), []int{6}
+}
+
+type GetNotificationPreferencesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Preferences   *NotificationPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesResponse) Reset() {
+	*x = GetNotificationPreferencesResponse{}
+	mi := &file_rpc_notification_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesResponse) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_notification_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_notification_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetNotificationPreferencesResponse) GetPreferences() *NotificationPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type UpdateNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransferEmail *bool                  `protobuf:"varint,1,opt,name=transfer_email,json=transferEmail,proto3,oneof" json:"transfer_email,omitempty"`
+	TransferInApp *bool                  `protobuf:"varint,2,opt,name=transfer_in_app,json=transferInApp,proto3,oneof" json:"transfer_in_app,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationPreferencesRequest) Reset() {
+	*x = UpdateNotificationPreferencesRequest{}
+	mi := &file_rpc_notification_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_notification_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_notification_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetTransferEmail() bool {
+	if x != nil && x.TransferEmail != nil {
+		return *x.TransferEmail
+	}
+	return false
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetTransferInApp() bool {
+	if x != nil && x.TransferInApp != nil {
+		return *x.TransferInApp
+	}
+	return false
+}
+
+type UpdateNotificationPreferencesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Preferences   *NotificationPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationPreferencesResponse) Reset() {
+	*x = UpdateNotificationPreferencesResponse{}
+	mi := &file_rpc_notification_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferencesResponse) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_notification_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_notification_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateNotificationPreferencesResponse) GetPreferences() *NotificationPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+var File_rpc_notification_proto protoreflect.FileDescriptor
+
+const file_rpc_notification_proto_rawDesc = "" +
+	"\n" +
+	"\x16rpc_notification.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xf9\x04\n" +
+	"\fNotification\x12,\n" +
+	"\x02id\x18\x01 \x01(\x03B\x1c\x92A\x192\x17Unique notification ID.R\x02id\x12@\n" +
+	"\x04type\x18\x02 \x01(\tB,\x92A)2\x12Notification type.J\x13\"transfer_received\"R\x04type\x12Z\n" +
+	"\x05title\x18\x03 \x01(\tBD\x92AA2$Short title, localized for the user.J\x19\"You received a transfer\"R\x05title\x12>\n" +
+	"\x04body\x18\x04 \x01(\tB*\x92A'2%Message text, localized for the user.R\x04body\x12n\n" +
+	"\vtransfer_id\x18\x05 \x01(\v2\x1b.google.protobuf.Int64ValueB0\x92A-2+Transfer the notification is about, if any.R\n" +
+	"transferId\x12{\n" +
+	"\aread_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampBF\x92AC2AUTC timestamp when the notification was read. Unset while unread.R\x06readAt\x12p\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampB5\x92A220UTC timestamp when the notification was created.R\tcreatedAt\"\xe9\x01\n" +
+	"\x17NotificationPreferences\x12s\n" +
+	"\x0etransfer_email\x18\x01 \x01(\bBL\x92AI2GEmail the user when money is sent from or received into their accounts.R\rtransferEmail\x12Y\n" +
+	"\x0ftransfer_in_app\x18\x02 \x01(\bB1\x92A.2,Create an in-app notification for transfers.R\rtransferInApp\"\x86\x02\n" +
+	"\x18ListNotificationsRequest\x12>\n" +
+	"\apage_id\x18\x01 \x01(\x05B%\x92A\"2\x141-based page number.J\x011i\x00\x00\x00\x00\x00\x00\xf0?R\x06pageId\x12P\n" +
+	"\tpage_size\x18\x02 \x01(\x05B3\x92A02!Number of notifications per page.J\x0210i\x00\x00\x00\x00\x00\x00\xf0?R\bpageSize\x12X\n" +
+	"\vunread_only\x18\x03 \x01(\bB7\x92A422Only return notifications that have not been read.R\n" +
+	"unreadOnly\"S\n" +
+	"\x19ListNotificationsResponse\x126\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x10.pb.NotificationR\rnotifications\"{\n" +
+	"\x1bMarkNotificationReadRequest\x12\\\n" +
+	"\x02id\x18\x01 \x01(\x03BL\x92AI2>ID of the notification. Must belong to the authenticated user.i\x00\x00\x00\x00\x00\x00\xf0?R\x02id\"T\n" +
+	"\x1cMarkNotificationReadResponse\x124\n" +
+	"\fnotification\x18\x01 \x01(\v2\x10.pb.NotificationR\fnotification\"#\n" +
+	"!GetNotificationPreferencesRequest\"c\n" +
+	"\"GetNotificationPreferencesResponse\x12=\n" +
+	"\vpreferences\x18\x01 \x01(\v2\x1b.pb.NotificationPreferencesR\vpreferences\"\x91\x02\n" +
+	"$UpdateNotificationPreferencesRequest\x12Z\n" +
+	"\x0etransfer_email\x18\x01 \x01(\bB.\x92A+2)Optional. Email the user about transfers.H\x00R\rtransferEmail\x88\x01\x01\x12f\n" +
+	"\x0ftransfer_in_app\x18\x02 \x01(\bB9\x92A624Optional. Create in-app notifications for transfers.H\x01R\rtransferInApp\x88\x01\x01B\x11\n" +
+	"\x0f_transfer_emailB\x12\n" +
+	"\x10_transfer_in_app\"f\n" +
+	"%UpdateNotificationPreferencesResponse\x12=\n" +
+	"\vpreferences\x18\x01 \x01(\v2\x1b.pb.NotificationPreferencesR\vpreferencesB(Z&github.com/a7medalyapany/GoBank.git/pbb\x06proto3"
+
+var (
+	file_rpc_notification_proto_rawDescOnce sync.Once
+	file_rpc_notification_proto_rawDescData []byte
+)
+
+func file_rpc_notification_proto_rawDescGZIP() []byte {
+	file_rpc_notification_proto_rawDescOnce.Do(func() {
+		file_rpc_notification_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_notification_proto_rawDesc), len(file_rpc_notification_proto_rawDesc)))
+	})
+	return file_rpc_notification_proto_rawDescData
+}
+
+var file_rpc_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_rpc_notification_proto_goTypes = []any{
+	(*Notification)(nil),                          // 0: pb.Notification
+	(*NotificationPreferences)(nil),               // 1: pb.NotificationPreferences
+	(*ListNotificationsRequest)(nil),              // 2: pb.ListNotificationsRequest
+	(*ListNotificationsResponse)(nil),             // 3: pb.ListNotificationsResponse
+	(*MarkNotificationReadRequest)(nil),           // 4: pb.MarkNotificationReadRequest
+	(*MarkNotificationReadResponse)(nil),          // 5: pb.MarkNotificationReadResponse
+	(*GetNotificationPreferencesRequest)(nil),     // 6: pb.GetNotificationPreferencesRequest
+	(*GetNotificationPreferencesResponse)(nil),    // 7: pb.GetNotificationPreferencesResponse
+	(*UpdateNotificationPreferencesRequest)(nil),  // 8: pb.UpdateNotificationPreferencesRequest
+	(*UpdateNotificationPreferencesResponse)(nil), // 9: pb.UpdateNotificationPreferencesResponse
+	(*wrapperspb.Int64Value)(nil),                 // 10: google.protobuf.Int64Value
+	(*timestamppb.Timestamp)(nil),                 // 11: google.protobuf.Timestamp
+}
+var file_rpc_notification_proto_depIdxs = []int32{
+	10, // 0: pb.Notification.transfer_id:type_name -> google.protobuf.Int64Value
+	11, // 1: pb.Notification.read_at:type_name -> google.protobuf.Timestamp
+	11, // 2: pb.Notification.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: pb.ListNotificationsResponse.notifications:type_name -> pb.Notification
+	0,  // 4: pb.MarkNotificationReadResponse.notification:type_name -> pb.Notification
+	1,  // 5: pb.GetNotificationPreferencesResponse.preferences:type_name -> pb.NotificationPreferences
+	1,  // 6: pb.UpdateNotificationPreferencesResponse.preferences:type_name -> pb.NotificationPreferences
+	7,  // [7:7] is the sub-list for method output_type
+	7,  // [7:7] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_rpc_notification_proto_init() }
+func file_rpc_notification_proto_init() {
+	if File_rpc_notification_proto != nil {
+		return
+	}
+	file_rpc_notification_proto_msgTypes[8].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_notification_proto_rawDesc), len(file_rpc_notification_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_notification_proto_goTypes,
+		DependencyIndexes: file_rpc_notification_proto_depIdxs,
+		MessageInfos:      file_rpc_notification_proto_msgTypes,
+	}.Build()
+	File_rpc_notification_proto = out.File
+	file_rpc_notification_proto_goTypes = nil
+	file_rpc_notification_proto_depIdxs = nil
+}
@@ -123,11 +123,11 @@ var File_rpc_token_proto protoreflect.FileDescriptor
 
 const file_rpc_token_proto_rawDesc = "" +
 	"\n" +
-	"\x0frpc_token.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x87\x01\n" +
-	"\x17RenewAccessTokenRequest\x12l\n" +
-	"\rrefresh_token\x18\x01 \x01(\tBG\x92AD2BValid, non-expired refresh token obtained from the login response.R\frefreshToken\"\x99\x02\n" +
-	"\x18RenewAccessTokenResponse\x12r\n" +
-	"\faccess_token\x18\x01 \x01(\tBO\x92AL2JNew short-lived PASETO access token. Use as: Authorization: Bearer <token>R\vaccessToken\x12\x88\x01\n" +
+	"\x0frpc_token.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\roptions.proto\"\x8b\x01\n" +
+	"\x17RenewAccessTokenRequest\x12p\n" +
+	"\rrefresh_token\x18\x01 \x01(\tBK\x92AD2BValid, non-expired refresh token obtained from the login response.\x80\xb5\x18\x01R\frefreshToken\"\x9d\x02\n" +
+	"\x18RenewAccessTokenResponse\x12v\n" +
+	"\faccess_token\x18\x01 \x01(\tBS\x92AL2JNew short-lived PASETO access token. Use as: Authorization: Bearer <token>\x80\xb5\x18\x01R\vaccessToken\x12\x88\x01\n" +
 	"\x17access_token_expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB5\x92A220UTC timestamp when the new access token expires.R\x14accessTokenExpiresAtB(Z&github.com/a7medalyapany/GoBank.git/pbb\x06proto3"
 
 var (
@@ -162,6 +162,7 @@ func file_rpc_token_proto_init() {
 	if File_rpc_token_proto != nil {
 		return
 	}
+	file_options_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -168,13 +168,14 @@ func (x *TransferRecord) GetCreatedAt() *timestamppb.Timestamp {
 }
 
 type CreateTransferRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FromAccountId int64                  `protobuf:"varint,1,opt,name=from_account_id,json=fromAccountId,proto3" json:"from_account_id,omitempty"`
-	ToAccountId   int64                  `protobuf:"varint,2,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`
-	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	FromAccountId   int64                  `protobuf:"varint,1,opt,name=from_account_id,json=fromAccountId,proto3" json:"from_account_id,omitempty"`
+	ToAccountId     int64                  `protobuf:"varint,2,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`
+	Amount          float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency        string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	ToAccountNumber string                 `protobuf:"bytes,5,opt,name=to_account_number,json=toAccountNumber,proto3" json:"to_account_number,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CreateTransferRequest) Reset() {
@@ -235,6 +236,13 @@ func (x *CreateTransferRequest) GetCurrency() string {
 	return ""
 }
 
+func (x *CreateTransferRequest) GetToAccountNumber() string {
+	if x != nil {
+		return x.ToAccountNumber
+	}
+	return ""
+}
+
 type CreateTransferResponse struct {
 	state         protoimpl.MessageState                  `protogen:"open.v1"`
 	Transfer      *TransferRecord                         `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
@@ -399,12 +407,13 @@ const file_rpc_transfer_proto_rawDesc = "" +
 	"\rto_account_id\x18\x03 \x01(\x03R\vtoAccountId\x12X\n" +
 	"\x06amount\x18\x04 \x01(\x01B@\x92A=2;Transferred amount in major currency unit. Always positive.R\x06amount\x129\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xcf\x03\n" +
-	"\x15CreateTransferRequest\x12x\n" +
-	"\x0ffrom_account_id\x18\x01 \x01(\x03BP\x92AM2BID of the account to debit. Must belong to the authenticated user.i\x00\x00\x00\x00\x00\x00\xf0?R\rfromAccountId\x12f\n" +
-	"\rto_account_id\x18\x02 \x01(\x03BB\x92A?24ID of the account to credit. Can belong to any user.i\x00\x00\x00\x00\x00\x00\xf0?R\vtoAccountId\x12j\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc2\x05\n" +
+	"\x15CreateTransferRequest\x12\x81\x01\n" +
+	"\x0ffrom_account_id\x18\x01 \x01(\x03BY\x92AV2KID of the account to debit. You must be its owner, a co-owner or a spender.i\x00\x00\x00\x00\x00\x00\xf0?R\rfromAccountId\x12\x95\x01\n" +
+	"\rto_account_id\x18\x02 \x01(\x03Bq\x92An2cID of the account to credit. Can belong to any user. Set either to_account_id or to_account_number.i\x00\x00\x00\x00\x00\x00\xf0?R\vtoAccountId\x12j\n" +
 	"\x06amount\x18\x03 \x01(\x01BR\x92AO2FAmount to transfer in major currency unit (e.g. dollars). Must be > 0.J\x0510.50R\x06amount\x12h\n" +
-	"\bcurrency\x18\x04 \x01(\tBL\x92AI2@Currency of the transfer. Both accounts must hold this currency.J\x05\"USD\"R\bcurrency\"\xb1\x03\n" +
+	"\bcurrency\x18\x04 \x01(\tBL\x92AI2@Currency of the transfer. Both accounts must hold this currency.J\x05\"USD\"R\bcurrency\x12\xb6\x01\n" +
+	"\x11to_account_number\x18\x05 \x01(\tB\x89\x01\x92A\x85\x012lAccount number of the account to credit, instead of to_account_id. Spaces and lowercase letters are ignored.J\x15\"GO92 0000 1234 5678\"R\x0ftoAccountNumber\"\xb1\x03\n" +
 	"\x16CreateTransferResponse\x12.\n" +
 	"\btransfer\x18\x01 \x01(\v2\x12.pb.TransferRecordR\btransfer\x120\n" +
 	"\n" +
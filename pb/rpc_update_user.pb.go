@@ -30,13 +30,17 @@ type UpdateUserRequest struct {
 	// Full display name of the user.
 	// example: "John Doe"
 	FullName *string `protobuf:"bytes,2,opt,name=full_name,json=fullName,proto3,oneof" json:"full_name,omitempty"`
-	// Valid email address. Must be unique across all accounts.
+	// New email address. Not applied until it is confirmed through the link
+	// sent to it; the current address is notified and can cancel the change.
 	// example: "john@example.com"
 	Email *string `protobuf:"bytes,3,opt,name=email,proto3,oneof" json:"email,omitempty"`
 	// Password for the account. Minimum 8 characters.
 	// Stored as a bcrypt hash — never in plain text.
 	// example: "supersecret123"
-	Password      *string `protobuf:"bytes,4,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	Password *string `protobuf:"bytes,4,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	// Preferred language for emails. Supported: en, ar.
+	// example: "ar"
+	Locale        *string `protobuf:"bytes,5,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -99,10 +103,19 @@ func (x *UpdateUserRequest) GetPassword() string {
 	return ""
 }
 
+func (x *UpdateUserRequest) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
 type UpdateUserResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The updated user (password excluded).
-	User          *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// The requested address awaiting confirmation, if this update changed the email.
+	PendingEmail  string `protobuf:"bytes,2,opt,name=pending_email,json=pendingEmail,proto3" json:"pending_email,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -144,24 +157,34 @@ func (x *UpdateUserResponse) GetUser() *User {
 	return nil
 }
 
+func (x *UpdateUserResponse) GetPendingEmail() string {
+	if x != nil {
+		return x.PendingEmail
+	}
+	return ""
+}
+
 var File_rpc_update_user_proto protoreflect.FileDescriptor
 
 const file_rpc_update_user_proto_rawDesc = "" +
 	"\n" +
 	"\x15rpc_update_user.proto\x12\x02pb\x1a\n" +
-	"user.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x9b\x04\n" +
+	"user.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\roptions.proto\"\xde\x05\n" +
 	"\x11UpdateUserRequest\x12\x93\x01\n" +
 	"\busername\x18\x01 \x01(\tBw\x92At2NUnique alphanumeric username. Lowercase letters, digits, and underscores only.J\x0e\"john_doe_123\"x2\x80\x01\x03\x8a\x01\f^[a-z0-9_]+$R\busername\x12V\n" +
 	"\tfull_name\x18\x02 \x01(\tB4\x92A12\x1eFull display name of the user.J\n" +
-	"\"John Doe\"xd\x80\x01\x02H\x00R\bfullName\x88\x01\x01\x12l\n" +
-	"\x05email\x18\x03 \x01(\tBQ\x92AN28Valid email address. Must be unique across all accounts.J\x12\"john@example.com\"H\x01R\x05email\x88\x01\x01\x12\x84\x01\n" +
-	"\bpassword\x18\x04 \x01(\tBc\x92A`2>Account password. Minimum 8 characters. Stored as bcrypt hash.J\x10\"supersecret123\"\x80\x01\b\xa2\x02\bpasswordH\x02R\bpassword\x88\x01\x01B\f\n" +
+	"\"John Doe\"xd\x80\x01\x02H\x00R\bfullName\x88\x01\x01\x12\xc7\x01\n" +
+	"\x05email\x18\x03 \x01(\tB\xab\x01\x92A\xa7\x012\x90\x01New email address. Takes effect only after it is confirmed through the link sent to it. The current address gets a notice with a link to cancel.J\x12\"john@example.com\"H\x01R\x05email\x88\x01\x01\x12\x88\x01\n" +
+	"\bpassword\x18\x04 \x01(\tBg\x92A`2>Account password. Minimum 8 characters. Stored as bcrypt hash.J\x10\"supersecret123\"\x80\x01\b\xa2\x02\bpassword\x80\xb5\x18\x01H\x02R\bpassword\x88\x01\x01\x12V\n" +
+	"\x06locale\x18\x05 \x01(\tB9\x92A62.Preferred language for emails. One of: en, ar.J\x04\"ar\"H\x03R\x06locale\x88\x01\x01B\f\n" +
 	"\n" +
 	"_full_nameB\b\n" +
 	"\x06_emailB\v\n" +
-	"\t_password\"2\n" +
+	"\t_passwordB\t\n" +
+	"\a_locale\"\xcb\x01\n" +
 	"\x12UpdateUserResponse\x12\x1c\n" +
-	"\x04user\x18\x01 \x01(\v2\b.pb.UserR\x04userB(Z&github.com/a7medalyapany/GoBank.git/pbb\x06proto3"
+	"\x04user\x18\x01 \x01(\v2\b.pb.UserR\x04user\x12\x96\x01\n" +
+	"\rpending_email\x18\x02 \x01(\tBq\x92An2TRequested email address awaiting confirmation. Empty when the email was not changed.J\x16\"john.new@example.com\"R\fpendingEmailB(Z&github.com/a7medalyapany/GoBank.git/pbb\x06proto3"
 
 var (
 	file_rpc_update_user_proto_rawDescOnce sync.Once
@@ -196,6 +219,7 @@ func file_rpc_update_user_proto_init() {
 		return
 	}
 	file_user_proto_init()
+	file_options_proto_init()
 	file_rpc_update_user_proto_msgTypes[0].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
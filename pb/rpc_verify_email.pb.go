@@ -10,6 +10,7 @@ import (
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -118,18 +119,102 @@ func (x *VerifyEmailResponse) GetIsVerified() bool {
 	return false
 }
 
+type ResendVerifyEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendVerifyEmailRequest) Reset() {
+	*x = ResendVerifyEmailRequest{}
+	mi := &file_rpc_verify_email_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendVerifyEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendVerifyEmailRequest) ProtoMessage() {}
+
+func (x *ResendVerifyEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_verify_email_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendVerifyEmailRequest.ProtoReflect.Descriptor instead.
+func (*ResendVerifyEmailRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_verify_email_proto_rawDescGZIP(), []int{2}
+}
+
+type ResendVerifyEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendVerifyEmailResponse) Reset() {
+	*x = ResendVerifyEmailResponse{}
+	mi := &file_rpc_verify_email_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendVerifyEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendVerifyEmailResponse) ProtoMessage() {}
+
+func (x *ResendVerifyEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_verify_email_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendVerifyEmailResponse.ProtoReflect.Descriptor instead.
+func (*ResendVerifyEmailResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_verify_email_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ResendVerifyEmailResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 var File_rpc_verify_email_proto protoreflect.FileDescriptor
 
 const file_rpc_verify_email_proto_rawDesc = "" +
 	"\n" +
-	"\x16rpc_verify_email.proto\x12\x02pb\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xb2\x01\n" +
+	"\x16rpc_verify_email.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\roptions.proto\"\xb6\x01\n" +
 	"\x12VerifyEmailRequest\x12G\n" +
-	"\bemail_id\x18\x01 \x01(\x03B,\x92A)2\x1eID of the verify_email record.i\x00\x00\x00\x00\x00\x00\xf0?R\aemailId\x12S\n" +
-	"\vsecret_code\x18\x02 \x01(\tB2\x92A/2-Secret code sent to the user's email address.R\n" +
+	"\bemail_id\x18\x01 \x01(\x03B,\x92A)2\x1eID of the verify_email record.i\x00\x00\x00\x00\x00\x00\xf0?R\aemailId\x12W\n" +
+	"\vsecret_code\x18\x02 \x01(\tB6\x92A/2-Secret code sent to the user's email address.\x80\xb5\x18\x01R\n" +
 	"secretCode\"i\n" +
 	"\x13VerifyEmailResponse\x12R\n" +
 	"\vis_verified\x18\x01 \x01(\bB1\x92A.2,True if the email was successfully verified.R\n" +
-	"isVerifiedB(Z&github.com/a7medalyapany/GoBank.git/pbb\x06proto3"
+	"isVerified\"\x1a\n" +
+	"\x18ResendVerifyEmailRequest\"\xb1\x01\n" +
+	"\x19ResendVerifyEmailResponse\x12\x93\x01\n" +
+	"\n" +
+	"expires_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampBX\x92AU2SUTC timestamp when the new verification link expires. Earlier links no longer work.R\texpiresAtB(Z&github.com/a7medalyapany/GoBank.git/pbb\x06proto3"
 
 var (
 	file_rpc_verify_email_proto_rawDescOnce sync.Once
@@ -143,17 +228,21 @@ func file_rpc_verify_email_proto_rawDescGZIP() []byte {
 	return file_rpc_verify_email_proto_rawDescData
 }
 
-var file_rpc_verify_email_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_verify_email_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_rpc_verify_email_proto_goTypes = []any{
-	(*VerifyEmailRequest)(nil),  // 0: pb.VerifyEmailRequest
-	(*VerifyEmailResponse)(nil), // 1: pb.VerifyEmailResponse
+	(*VerifyEmailRequest)(nil),        // 0: pb.VerifyEmailRequest
+	(*VerifyEmailResponse)(nil),       // 1: pb.VerifyEmailResponse
+	(*ResendVerifyEmailRequest)(nil),  // 2: pb.ResendVerifyEmailRequest
+	(*ResendVerifyEmailResponse)(nil), // 3: pb.ResendVerifyEmailResponse
+	(*timestamppb.Timestamp)(nil),     // 4: google.protobuf.Timestamp
 }
 var file_rpc_verify_email_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	4, // 0: pb.ResendVerifyEmailResponse.expires_at:type_name -> google.protobuf.Timestamp
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_rpc_verify_email_proto_init() }
@@ -161,13 +250,14 @@ func file_rpc_verify_email_proto_init() {
 	if File_rpc_verify_email_proto != nil {
 		return
 	}
+	file_options_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_verify_email_proto_rawDesc), len(file_rpc_verify_email_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
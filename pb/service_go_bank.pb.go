@@ -27,7 +27,7 @@ var File_service_go_bank_proto protoreflect.FileDescriptor
 const file_service_go_bank_proto_rawDesc = "" +
 	"\n" +
 	"\x15service_go_bank.proto\x12\x02pb\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\n" +
-	"user.proto\x1a\x15rpc_create_user.proto\x1a\x14rpc_login_user.proto\x1a\x0frpc_token.proto\x1a\x11rpc_account.proto\x1a\x12rpc_transfer.proto\x1a\x0frpc_entry.proto\x1a\x15rpc_update_user.proto\x1a\x16rpc_verify_email.proto2\x81(\n" +
+	"user.proto\x1a\x15rpc_create_user.proto\x1a\x14rpc_login_user.proto\x1a\x0frpc_token.proto\x1a\x11rpc_account.proto\x1a\x12rpc_transfer.proto\x1a\x0frpc_entry.proto\x1a\x15rpc_update_user.proto\x1a\x16rpc_verify_email.proto\x1a\x11rpc_api_key.proto\x1a\x16rpc_notification.proto\x1a\x11rpc_webhook.proto\x1a\x0frpc_admin.proto\x1a\x16rpc_email_change.proto\x1a\x0frpc_audit.proto\x1a\x18rpc_account_member.proto\x1a\x12rpc_interest.proto2\x81\x8d\x01\n" +
 	"\x06GoBank\x12\xba\x02\n" +
 	"\n" +
 	"CreateUser\x12\x15.pb.CreateUserRequest\x1a\x16.pb.CreateUserResponse\"\xfc\x01\x92A\xe4\x01\n" +
@@ -60,10 +60,24 @@ const file_service_go_bank_proto_rawDesc = "" +
 	"\x03400\x12'\n" +
 	"%Invalid or expired verification link.J'\n" +
 	"\x03404\x12 \n" +
-	"\x1eVerification record not found.b\x00\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/verify_email\x12\xcc\x04\n" +
+	"\x1eVerification record not found.b\x00\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/verify_email\x12\x83\x04\n" +
+	"\x12ConfirmEmailChange\x12\x1d.pb.ConfirmEmailChangeRequest\x1a\x1e.pb.ConfirmEmailChangeResponse\"\xad\x03\x92A\x86\x03\n" +
+	"\x04Auth\x12\x14Confirm email change\x1a\xc6\x01Switches the account to the new email address using the id and code from the link sent to that address. The new address is marked verified. The link expires after 24 hours and can only be used once.*\x12ConfirmEmailChangeJ\x17\n" +
+	"\x03200\x12\x10\n" +
+	"\x0eEmail changed.J2\n" +
+	"\x03400\x12+\n" +
+	")Invalid, used, cancelled or expired link.J<\n" +
+	"\x03409\x125\n" +
+	"3The new address is already used by another account.b\x00\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/email_change/confirm\x12\xaa\x03\n" +
+	"\x11CancelEmailChange\x12\x1c.pb.CancelEmailChangeRequest\x1a\x1d.pb.CancelEmailChangeResponse\"\xd7\x02\x92A\xb1\x02\n" +
+	"\x04Auth\x12\x13Cancel email change\x1a\xb3\x01Cancels an email change using the link sent to the old address. If the change was already confirmed, the account goes back to the old address, as long as the link has not expired.*\x11CancelEmailChangeJ \n" +
+	"\x03200\x12\x19\n" +
+	"\x17Email change cancelled.J'\n" +
+	"\x03400\x12 \n" +
+	"\x1eInvalid, used or expired link.b\x00\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/email_change/cancel\x12\xff\x04\n" +
 	"\n" +
-	"UpdateUser\x12\x15.pb.UpdateUserRequest\x1a\x16.pb.UpdateUserResponse\"\x8e\x04\x92A\xf6\x03\n" +
-	"\x05Users\x12\x13Update current user\x1a\x91\x01Updates the authenticated user's profile. All fields are optional — only provided fields are updated. Password is bcrypt-hashed before storage.*\n" +
+	"UpdateUser\x12\x15.pb.UpdateUserRequest\x1a\x16.pb.UpdateUserResponse\"\xc1\x04\x92A\xa9\x04\n" +
+	"\x05Users\x12\x13Update current user\x1a\xf7\x01Updates the authenticated user's profile. All fields are optional — only provided fields are updated. Password is bcrypt-hashed before storage. A new email is returned as pending_email and applied only once confirmed through the link sent to it.*\n" +
 	"UpdateUserJ#\n" +
 	"\x03200\x12\x1c\n" +
 	"\x1aUser updated successfully.J:\n" +
@@ -74,45 +88,56 @@ const file_service_go_bank_proto_rawDesc = "" +
 	"\x03403\x12%\n" +
 	"#Not authorized to update this user.J\x18\n" +
 	"\x03404\x12\x11\n" +
-	"\x0fUser not found.J1\n" +
-	"\x03409\x12*\n" +
-	"(Email already in use by another account.J\x1f\n" +
+	"\x0fUser not found.J\x1f\n" +
 	"\x03500\x12\x18\n" +
 	"\x16Internal server error.b\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x0e:\x01*2\t/v1/users\x12\xed\x02\n" +
-	"\rCreateAccount\x12\x18.pb.CreateAccountRequest\x1a\x19.pb.CreateAccountResponse\"\xa6\x02\x92A\x8b\x02\n" +
-	"\bAccounts\x12\x11Create an account\x1aoCreates a new currency account for the authenticated user. Each user may hold at most one account per currency.*\rCreateAccountJ&\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x0e:\x01*2\t/v1/users\x12\xb1\x04\n" +
+	"\x11ResendVerifyEmail\x12\x1c.pb.ResendVerifyEmailRequest\x1a\x1d.pb.ResendVerifyEmailResponse\"\xde\x03\x92A\xb8\x03\n" +
+	"\x05Users\x12\x19Resend verification email\x1a\xaf\x01Sends a new verification link to the authenticated user's email address and invalidates any earlier unused links. Can be called once per cooldown period (1 minute by default).*\x11ResendVerifyEmailJ#\n" +
+	"\x03200\x12\x1c\n" +
+	"\x1aVerification email queued.J)\n" +
+	"\x03401\x12\"\n" +
+	" Missing or invalid Bearer token.J#\n" +
+	"\x03412\x12\x1c\n" +
+	"\x1aEmail is already verified.JH\n" +
+	"\x03429\x12A\n" +
+	"?A link was sent recently. The error carries a RetryInfo detail.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/verify_email/resend\x12\xc1\x03\n" +
+	"\rCreateAccount\x12\x18.pb.CreateAccountRequest\x1a\x19.pb.CreateAccountResponse\"\xfa\x02\x92A\xdf\x02\n" +
+	"\bAccounts\x12\x11Create an account\x1a\x9d\x01Creates a new checking or savings account for the authenticated user. A user may hold several accounts in the same currency, told apart by type and nickname.*\rCreateAccountJ&\n" +
 	"\x03200\x12\x1f\n" +
-	"\x1dAccount created successfully.J2\n" +
-	"\x03403\x12+\n" +
-	")Account for this currency already exists.b\x10\n" +
+	"\x1dAccount created successfully.JW\n" +
+	"\x03412\x12P\n" +
+	"NEmail address is not verified. The error carries a PreconditionFailure detail.b\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/accounts\x12\xdf\x02\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/accounts\x12\xf9\x02\n" +
 	"\n" +
-	"GetAccount\x12\x15.pb.GetAccountRequest\x1a\x16.pb.GetAccountResponse\"\xa1\x02\x92A\x84\x02\n" +
-	"\bAccounts\x12\x0eGet an account\x1aTRetrieves a single account by ID. The account must belong to the authenticated user.*\n" +
+	"GetAccount\x12\x15.pb.GetAccountRequest\x1a\x16.pb.GetAccountResponse\"\xbb\x02\x92A\x9e\x02\n" +
+	"\bAccounts\x12\x0eGet an account\x1aaRetrieves a single account by ID. The caller must be an active member of the account in any role.*\n" +
 	"GetAccountJ(\n" +
 	"\x03200\x12!\n" +
-	"\x1fAccount retrieved successfully.J-\n" +
-	"\x03403\x12&\n" +
-	"$Account belongs to a different user.J\x1b\n" +
+	"\x1fAccount retrieved successfully.J:\n" +
+	"\x03403\x123\n" +
+	"1Caller's role on the account does not allow this.J\x1b\n" +
 	"\x03404\x12\x14\n" +
 	"\x12Account not found.b\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/accounts/{id}\x12\x86\x02\n" +
-	"\fListAccounts\x12\x17.pb.ListAccountsRequest\x1a\x18.pb.ListAccountsResponse\"\xc2\x01\x92A\xaa\x01\n" +
-	"\bAccounts\x12\rList accounts\x1aIReturns a paginated list of all accounts owned by the authenticated user.*\fListAccountsJ$\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/accounts/{id}\x12\xc7\x02\n" +
+	"\fListAccounts\x12\x17.pb.ListAccountsRequest\x1a\x18.pb.ListAccountsResponse\"\x83\x02\x92A\xeb\x01\n" +
+	"\bAccounts\x12\rList accounts\x1a\x89\x01Returns a paginated list of the accounts the authenticated user owns or is an active member of, optionally filtered by currency and type.*\fListAccountsJ$\n" +
 	"\x03200\x12\x1d\n" +
 	"\x1bPaginated list of accounts.b\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/accounts\x12\x84\x03\n" +
-	"\vListEntries\x12\x16.pb.ListEntriesRequest\x1a\x17.pb.ListEntriesResponse\"\xc3\x02\x92A\xac\x02\n" +
-	"\aEntries\x12\x15List activity entries\x1a\x7fReturns a paginated list of activity entries across all accounts owned by the authenticated user, ordered by most recent first.*\vListEntriesJ?\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/accounts\x12\x93\x03\n" +
+	"\vListEntries\x12\x16.pb.ListEntriesRequest\x1a\x17.pb.ListEntriesResponse\"\xd2\x02\x92A\xbb\x02\n" +
+	"\aEntries\x12\x15List activity entries\x1a\x8d\x01Returns a paginated list of activity entries across all accounts the authenticated user is an active member of, ordered by most recent first.*\vListEntriesJ?\n" +
 	"\x03200\x128\n" +
 	"6Paginated list of authenticated user activity entries.J)\n" +
 	"\x03401\x12\"\n" +
@@ -121,49 +146,312 @@ const file_service_go_bank_proto_rawDesc = "" +
 	"\n" +
 	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\r\x12\v/v1/entries\x12\x88\x03\n" +
 	"\rUpdateAccount\x12\x18.pb.UpdateAccountRequest\x1a\x19.pb.UpdateAccountResponse\"\xc1\x02\x92A\xa1\x02\n" +
-	"\bAccounts\x12\x16Update account balance\x1ahSets the balance of an account. The account must belong to the authenticated user. Balance must be >= 0.*\rUpdateAccountJ&\n" +
+	"\bAccounts\x12\x16Update account balance\x1a[Sets the balance of an account. Only its owner may; co-owners cannot. Balance must be >= 0.*\rUpdateAccountJ&\n" +
+	"\x03200\x12\x1f\n" +
+	"\x1dAccount updated successfully.J:\n" +
+	"\x03403\x123\n" +
+	"1Caller's role on the account does not allow this.J\x1b\n" +
+	"\x03404\x12\x14\n" +
+	"\x12Account not found.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x16:\x01*\x1a\x11/v1/accounts/{id}\x12\x9e\x03\n" +
+	"\x15UpdateAccountNickname\x12 .pb.UpdateAccountNicknameRequest\x1a!.pb.UpdateAccountNicknameResponse\"\xbf\x02\x92A\x9f\x02\n" +
+	"\bAccounts\x12\x11Rename an account\x1aVSets or clears the nickname of an account. The caller must be its owner or a co-owner.*\x15UpdateAccountNicknameJ&\n" +
 	"\x03200\x12\x1f\n" +
-	"\x1dAccount updated successfully.J-\n" +
-	"\x03403\x12&\n" +
-	"$Account belongs to a different user.J\x1b\n" +
+	"\x1dAccount renamed successfully.J:\n" +
+	"\x03403\x123\n" +
+	"1Caller's role on the account does not allow this.J\x1b\n" +
 	"\x03404\x12\x14\n" +
 	"\x12Account not found.b\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x16:\x01*\x1a\x11/v1/accounts/{id}\x12\xdd\x02\n" +
-	"\rDeleteAccount\x12\x18.pb.DeleteAccountRequest\x1a\x19.pb.DeleteAccountResponse\"\x96\x02\x92A\xf9\x01\n" +
-	"\bAccounts\x12\x11Delete an account\x1aRPermanently deletes an account. The account must belong to the authenticated user.*\rDeleteAccountJ\x19\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x16:\x01*2\x11/v1/accounts/{id}\x12\xd5\x02\n" +
+	"\rDeleteAccount\x12\x18.pb.DeleteAccountRequest\x1a\x19.pb.DeleteAccountResponse\"\x8e\x02\x92A\xf1\x01\n" +
+	"\bAccounts\x12\x11Delete an account\x1a=Permanently deletes an account. Only its owner can delete it.*\rDeleteAccountJ\x19\n" +
 	"\x03200\x12\x12\n" +
-	"\x10Account deleted.J-\n" +
-	"\x03403\x12&\n" +
-	"$Account belongs to a different user.J\x1b\n" +
+	"\x10Account deleted.J:\n" +
+	"\x03403\x123\n" +
+	"1Caller's role on the account does not allow this.J\x1b\n" +
 	"\x03404\x12\x14\n" +
 	"\x12Account not found.b\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x13*\x11/v1/accounts/{id}\x12\x8f\x02\n" +
-	"\rLookUpAccount\x12\x18.pb.LookUpAccountRequest\x1a\x19.pb.LookUpAccountResponse\"\xc8\x01\x92A\xa9\x01\n" +
-	"\bAccounts\x12\x12Look up an account\x1a!Retrieves a single account by ID.*\rLookUpAccountJ(\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x13*\x11/v1/accounts/{id}\x12\xb9\x03\n" +
+	"\rLookUpAccount\x12\x18.pb.LookUpAccountRequest\x1a\x19.pb.LookUpAccountResponse\"\xf2\x02\x92A\xd3\x02\n" +
+	"\bAccounts\x12\x12Look up an account\x1a\x83\x01Retrieves the owner, currency, type and nickname of any account by its account number, e.g. to confirm the recipient of a transfer.*\rLookUpAccountJ(\n" +
 	"\x03200\x12!\n" +
-	"\x1fAccount retrieved successfully.J\x1b\n" +
+	"\x1fAccount retrieved successfully.JE\n" +
+	"\x03400\x12>\n" +
+	"<account_number is missing, or its check digits do not match.J\x1b\n" +
 	"\x03404\x12\x14\n" +
 	"\x12Account not found.b\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/accounts/lookup\x12\xf7\x04\n" +
-	"\x0eCreateTransfer\x12\x19.pb.CreateTransferRequest\x1a\x1a.pb.CreateTransferResponse\"\xad\x04\x92A\x91\x04\n" +
-	"\tTransfers\x12\x11Create a transfer\x1a\xc3\x01Atomically transfers funds between two accounts. The source account must belong to the authenticated user. Both accounts must hold the specified currency. Uses deadlock-safe transaction ordering.*\x0eCreateTransferJ]\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/accounts/lookup\x12\xa6\x04\n" +
+	"\x13InviteAccountMember\x12\x1e.pb.InviteAccountMemberRequest\x1a\x1f.pb.InviteAccountMemberResponse\"\xcd\x03\x92A\x9d\x03\n" +
+	"\x0fAccount members\x12\x18Invite an account member\x1a\x9b\x01Invites a user to share an account as a co_owner, viewer or spender. The caller must be the owner or a co-owner. The user gets no access until they accept.*\x13InviteAccountMemberJ\x1c\n" +
+	"\x03200\x12\x15\n" +
+	"\x13Invitation created.J5\n" +
+	"\x03403\x12.\n" +
+	",Caller may not manage the account's members.J#\n" +
+	"\x03404\x12\x1c\n" +
+	"\x1aAccount or user not found.J1\n" +
+	"\x03409\x12*\n" +
+	"(The user is already a member or invited.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/accounts/{account_id}/members\x12\xb0\x03\n" +
+	"\x12ListAccountMembers\x12\x1d.pb.ListAccountMembersRequest\x1a\x1e.pb.ListAccountMembersResponse\"\xda\x02\x92A\xad\x02\n" +
+	"\x0fAccount members\x12\x14List account members\x1a]Lists the members and pending invitations of an account. The caller must be an active member.*\x12ListAccountMembersJ1\n" +
+	"\x03200\x12*\n" +
+	"(Members of the account, the owner first.J/\n" +
+	"\x03403\x12(\n" +
+	"&Caller is not a member of the account.J\x1b\n" +
+	"\x03404\x12\x14\n" +
+	"\x12Account not found.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02#\x12!/v1/accounts/{account_id}/members\x12\xf1\x03\n" +
+	"\x13RemoveAccountMember\x12\x1e.pb.RemoveAccountMemberRequest\x1a\x1f.pb.RemoveAccountMemberResponse\"\x98\x03\x92A\xe0\x02\n" +
+	"\x0fAccount members\x12\x18Remove an account member\x1a\x9d\x01Removes a member or revokes an invitation. The owner and co-owners can remove anyone except the owner; any member can leave, and an invited user can decline.*\x13RemoveAccountMemberJ\x18\n" +
+	"\x03200\x12\x11\n" +
+	"\x0fMember removed.J+\n" +
+	"\x03403\x12$\n" +
+	"\"Caller may not remove this member.J%\n" +
+	"\x03404\x12\x1e\n" +
+	"\x1cAccount or member not found.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02.*,/v1/accounts/{account_id}/members/{username}\x12\xcb\x02\n" +
+	"\x16ListAccountInvitations\x12!.pb.ListAccountInvitationsRequest\x1a\".pb.ListAccountInvitationsResponse\"\xe9\x01\x92A\xc6\x01\n" +
+	"\x0fAccount members\x12\x1dList your account invitations\x1aKLists the pending invitations to share other users' accounts, newest first.*\x16ListAccountInvitationsJ\x1d\n" +
+	"\x03200\x12\x16\n" +
+	"\x14Pending invitations.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/account_invitations\x12\x99\x03\n" +
+	"\x17AcceptAccountInvitation\x12\".pb.AcceptAccountInvitationRequest\x1a#.pb.AcceptAccountInvitationResponse\"\xb4\x02\x92A\xfa\x01\n" +
+	"\x0fAccount members\x12\x1cAccept an account invitation\x1aNAccepts an invitation to share an account, giving the caller the invited role.*\x17AcceptAccountInvitationJ\x1d\n" +
+	"\x03200\x12\x16\n" +
+	"\x14Invitation accepted.J/\n" +
+	"\x03404\x12(\n" +
+	"&No pending invitation to this account.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x020:\x01*\"+/v1/account_invitations/{account_id}/accept\x12\xdf\x03\n" +
+	"\x12GetAccountInterest\x12\x1d.pb.GetAccountInterestRequest\x1a\x1e.pb.GetAccountInterestResponse\"\x89\x03\x92A\xdb\x02\n" +
+	"\bInterest\x12\x14Get account interest\x1a\xa1\x01Returns the annual rate of a savings account, the interest accrued since the last posting and the monthly interest postings. The caller must be an active member.*\x12GetAccountInterestJ!\n" +
+	"\x03200\x12\x1a\n" +
+	"\x18Interest of the account.J/\n" +
+	"\x03403\x12(\n" +
+	"&Caller is not a member of the account.J\x1b\n" +
+	"\x03404\x12\x14\n" +
+	"\x12Account not found.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02$\x12\"/v1/accounts/{account_id}/interest\x12\xb2\a\n" +
+	"\x0eCreateTransfer\x12\x19.pb.CreateTransferRequest\x1a\x1a.pb.CreateTransferResponse\"\xe8\x06\x92A\xcc\x06\n" +
+	"\tTransfers\x12\x11Create a transfer\x1a\xfb\x02Atomically transfers funds between two accounts. The destination is given by ID or by account number. The caller must be the owner, a co-owner or a spender of the source account; spenders are held to their spend limit, which covers everything they transfer from the account in any 24 hours. Both accounts must hold the specified currency. Uses deadlock-safe transaction ordering.*\x0eCreateTransferJ]\n" +
 	"\x03200\x12V\n" +
 	"TTransfer completed. Returns transfer record, entries, and updated account snapshots.J3\n" +
 	"\x03400\x12,\n" +
-	"*Insufficient balance or currency mismatch.JB\n" +
-	"\x03401\x12;\n" +
-	"9Source account does not belong to the authenticated user.J1\n" +
+	"*Insufficient balance or currency mismatch.Jl\n" +
+	"\x03403\x12e\n" +
+	"cCaller may not spend from the source account, or the amount would take them over their spend limit.J1\n" +
 	"\x03404\x12*\n" +
-	"(Source or destination account not found.b\x10\n" +
+	"(Source or destination account not found.JW\n" +
+	"\x03412\x12P\n" +
+	"NEmail address is not verified. The error carries a PreconditionFailure detail.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/transfers\x12\xad\x03\n" +
+	"\fCreateApiKey\x12\x17.pb.CreateApiKeyRequest\x1a\x18.pb.CreateApiKeyResponse\"\xe9\x02\x92A\xce\x02\n" +
+	"\bAPI Keys\x12\x11Create an API key\x1arCreates a scoped API key for machine-to-machine access. The full key is returned once and only its hash is stored.*\fCreateApiKeyJ:\n" +
+	"\x03200\x123\n" +
+	"1API key created. Store the returned key securely.J(\n" +
+	"\x03400\x12!\n" +
+	"\x1fInvalid name, scopes or expiry.J5\n" +
+	"\x03403\x12.\n" +
+	",Requested scopes exceed the caller's scopes.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/api_keys\x12\xab\x02\n" +
+	"\vListApiKeys\x12\x16.pb.ListApiKeysRequest\x1a\x17.pb.ListApiKeysResponse\"\xea\x01\x92A\xd2\x01\n" +
+	"\bAPI Keys\x12\rList API keys\x1arReturns a paginated list of the authenticated user's API keys, including revoked ones. Secrets are never returned.*\vListApiKeysJ$\n" +
+	"\x03200\x12\x1d\n" +
+	"\x1bPaginated list of API keys.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/api_keys\x12\xb3\x02\n" +
+	"\fRevokeApiKey\x12\x17.pb.RevokeApiKeyRequest\x1a\x18.pb.RevokeApiKeyResponse\"\xef\x01\x92A\xd2\x01\n" +
+	"\bAPI Keys\x12\x11Revoke an API key\x1aHRevokes an API key immediately. Revoked keys can no longer authenticate.*\fRevokeApiKeyJ\x19\n" +
+	"\x03200\x12\x12\n" +
+	"\x10API key revoked.J.\n" +
+	"\x03404\x12'\n" +
+	"%API key not found or already revoked.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x13*\x11/v1/api_keys/{id}\x12\xbd\x02\n" +
+	"\x11ListNotifications\x12\x1c.pb.ListNotificationsRequest\x1a\x1d.pb.ListNotificationsResponse\"\xea\x01\x92A\xcd\x01\n" +
+	"\rNotifications\x12\x12List notifications\x1aXReturns a paginated list of the authenticated user's in-app notifications, newest first.*\x11ListNotificationsJ)\n" +
+	"\x03200\x12\"\n" +
+	" Paginated list of notifications.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/notifications\x12\x82\x03\n" +
+	"\x14MarkNotificationRead\x12\x1f.pb.MarkNotificationReadRequest\x1a .pb.MarkNotificationReadResponse\"\xa6\x02\x92A\xff\x01\n" +
+	"\rNotifications\x12\x1bMark a notification as read\x1a`Marks a notification as read. Marking an already read notification keeps its original read time.*\x14MarkNotificationReadJ%\n" +
+	"\x03200\x12\x1e\n" +
+	"\x1cNotification marked as read.J \n" +
+	"\x03404\x12\x19\n" +
+	"\x17Notification not found.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1d\"\x1b/v1/notifications/{id}/read\x12\x80\x03\n" +
+	"\x1aGetNotificationPreferences\x12%.pb.GetNotificationPreferencesRequest\x1a&.pb.GetNotificationPreferencesResponse\"\x92\x02\x92A\xea\x01\n" +
+	"\rNotifications\x12\x1cGet notification preferences\x1aaReturns how the authenticated user is notified about transfers. Everything is enabled by default.*\x1aGetNotificationPreferencesJ*\n" +
+	"\x03200\x12#\n" +
+	"!Current notification preferences.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/notification_preferences\x12\xfe\x02\n" +
+	"\x1dUpdateNotificationPreferences\x12(.pb.UpdateNotificationPreferencesRequest\x1a).pb.UpdateNotificationPreferencesResponse\"\x87\x02\x92A\xdc\x01\n" +
+	"\rNotifications\x12\x1fUpdate notification preferences\x1aMPartially updates notification preferences. Only provided fields are changed.*\x1dUpdateNotificationPreferencesJ*\n" +
+	"\x03200\x12#\n" +
+	"!Updated notification preferences.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02!:\x01*2\x1c/v1/notification_preferences\x12\xbb\x03\n" +
+	"\x15CreateWebhookEndpoint\x12 .pb.CreateWebhookEndpointRequest\x1a!.pb.CreateWebhookEndpointResponse\"\xdc\x02\x92A\xc1\x02\n" +
+	"\bWebhooks\x12\x19Create a webhook endpoint\x1a\x8a\x01Registers a URL that receives HMAC-signed events for the authenticated user's accounts and transfers. The signing secret is returned once.*\x15CreateWebhookEndpointJ>\n" +
+	"\x03200\x127\n" +
+	"5Endpoint created. Store the returned secret securely.J$\n" +
+	"\x03400\x12\x1d\n" +
+	"\x1bInvalid URL or event types.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/webhooks\x12\xd2\x02\n" +
+	"\x14ListWebhookEndpoints\x12\x1f.pb.ListWebhookEndpointsRequest\x1a .pb.ListWebhookEndpointsResponse\"\xf6\x01\x92A\xde\x01\n" +
+	"\bWebhooks\x12\x16List webhook endpoints\x1acReturns a paginated list of the authenticated user's webhook endpoints. Secrets are never returned.*\x14ListWebhookEndpointsJ-\n" +
+	"\x03200\x12&\n" +
+	"$Paginated list of webhook endpoints.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/webhooks\x12\xd9\x02\n" +
+	"\x15DeleteWebhookEndpoint\x12 .pb.DeleteWebhookEndpointRequest\x1a!.pb.DeleteWebhookEndpointResponse\"\xfa\x01\x92A\xdd\x01\n" +
+	"\bWebhooks\x12\x19Delete a webhook endpoint\x1aSDeletes an endpoint together with its delivery log. Pending deliveries are dropped.*\x15DeleteWebhookEndpointJ\x1a\n" +
+	"\x03200\x12\x13\n" +
+	"\x11Endpoint deleted.J\x1c\n" +
+	"\x03404\x12\x15\n" +
+	"\x13Endpoint not found.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x13*\x11/v1/webhooks/{id}\x12\x99\x03\n" +
+	"\x15ListWebhookDeliveries\x12 .pb.ListWebhookDeliveriesRequest\x1a!.pb.ListWebhookDeliveriesResponse\"\xba\x02\x92A\x89\x02\n" +
+	"\bWebhooks\x12\x17List webhook deliveries\x1auReturns the delivery log of an endpoint, newest first. Filter by status=dead to see deliveries whose retries ran out.*\x15ListWebhookDeliveriesJ&\n" +
+	"\x03200\x12\x1f\n" +
+	"\x1dPaginated list of deliveries.J\x1c\n" +
+	"\x03404\x12\x15\n" +
+	"\x13Endpoint not found.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02'\x12%/v1/webhooks/{endpoint_id}/deliveries\x12\xed\x02\n" +
+	"\x15ReplayWebhookDelivery\x12 .pb.ReplayWebhookDeliveryRequest\x1a!.pb.ReplayWebhookDeliveryResponse\"\x8e\x02\x92A\xe0\x01\n" +
+	"\bWebhooks\x12\x19Replay a webhook delivery\x1aWSends a delivery again with the same event ID and payload, with a fresh set of retries.*\x15ReplayWebhookDeliveryJ\x19\n" +
+	"\x03200\x12\x12\n" +
+	"\x10Delivery queued.J\x1c\n" +
+	"\x03404\x12\x15\n" +
+	"\x13Delivery not found.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02$\"\"/v1/webhook_deliveries/{id}/replay\x12\xc1\x02\n" +
+	"\n" +
+	"ListQueues\x12\x15.pb.ListQueuesRequest\x1a\x16.pb.ListQueuesResponse\"\x83\x02\x92A\xe7\x01\n" +
+	"\x05Admin\x12\x10List task queues\x1aqReturns the depth of every background task queue by state, plus today's processed and failed counts. Admins only.*\n" +
+	"ListQueuesJ\x19\n" +
+	"\x03200\x12\x12\n" +
+	"\x10Queue snapshots.J \n" +
+	"\x03403\x12\x19\n" +
+	"\x17Caller is not an admin.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/admin/queues\x12\xa1\x03\n" +
+	"\x0eListQueueTasks\x12\x19.pb.ListQueueTasksRequest\x1a\x1a.pb.ListQueueTasksResponse\"\xd7\x02\x92A\xad\x02\n" +
+	"\x05Admin\x12\x15List tasks in a queue\x1a\x8a\x01Returns a page of the tasks in a queue that are in the given state. Use state=archived to find tasks that ran out of retries. Admins only.*\x0eListQueueTasksJ!\n" +
+	"\x03200\x12\x1a\n" +
+	"\x18Paginated list of tasks.J \n" +
+	"\x03403\x12\x19\n" +
+	"\x17Caller is not an admin.J\x19\n" +
+	"\x03404\x12\x12\n" +
+	"\x10Queue not found.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02 \x12\x1e/v1/admin/queues/{queue}/tasks\x12\xf4\x02\n" +
+	"\x0eRetryQueueTask\x12\x19.pb.RetryQueueTaskRequest\x1a\x1a.pb.RetryQueueTaskResponse\"\xaa\x02\x92A\xf0\x01\n" +
+	"\x05Admin\x12\fRetry a task\x1aWMoves an archived, retry or scheduled task back to pending so it runs now. Admins only.*\x0eRetryQueueTaskJ\x19\n" +
+	"\x03200\x12\x12\n" +
+	"\x10Task is pending.J \n" +
+	"\x03403\x12\x19\n" +
+	"\x17Caller is not an admin.J!\n" +
+	"\x03404\x12\x1a\n" +
+	"\x18Queue or task not found.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x020\"./v1/admin/queues/{queue}/tasks/{task_id}/retry\x12\xd1\x02\n" +
+	"\x0fDeleteQueueTask\x12\x1a.pb.DeleteQueueTaskRequest\x1a\x1b.pb.DeleteQueueTaskResponse\"\x84\x02\x92A\xd0\x01\n" +
+	"\x05Admin\x12\rDelete a task\x1a8Deletes a task that is not being processed. Admins only.*\x0fDeleteQueueTaskJ\x16\n" +
+	"\x03200\x12\x0f\n" +
+	"\rTask deleted.J \n" +
+	"\x03403\x12\x19\n" +
+	"\x17Caller is not an admin.J!\n" +
+	"\x03404\x12\x1a\n" +
+	"\x18Queue or task not found.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02**(/v1/admin/queues/{queue}/tasks/{task_id}\x12\x9e\x02\n" +
+	"\vGetLogLevel\x12\x16.pb.GetLogLevelRequest\x1a\x17.pb.GetLogLevelResponse\"\xdd\x01\x92A\xbe\x01\n" +
+	"\x05Admin\x12\x11Get the log level\x1aDReturns the minimum level the server currently logs at. Admins only.*\vGetLogLevelJ\x1b\n" +
+	"\x03200\x12\x14\n" +
+	"\x12Current log level.J \n" +
+	"\x03403\x12\x19\n" +
+	"\x17Caller is not an admin.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/admin/log_level\x12\x90\x03\n" +
+	"\vSetLogLevel\x12\x16.pb.SetLogLevelRequest\x1a\x17.pb.SetLogLevelResponse\"\xcf\x02\x92A\xad\x02\n" +
+	"\x05Admin\x12\x11Set the log level\x1a\x9d\x01Changes the minimum log level of this server process without a restart. The change is not persisted: a restart or SIGHUP goes back to LOG_LEVEL. Admins only.*\vSetLogLevelJ\x17\n" +
+	"\x03200\x12\x10\n" +
+	"\x0eLevel changed.J\x17\n" +
+	"\x03400\x12\x10\n" +
+	"\x0eUnknown level.J \n" +
+	"\x03403\x12\x19\n" +
+	"\x17Caller is not an admin.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/v1/admin/log_level\x12\x87\x04\n" +
+	"\x13CreateDebugLogToken\x12\x1e.pb.CreateDebugLogTokenRequest\x1a\x1f.pb.CreateDebugLogTokenResponse\"\xae\x03\x92A\xff\x02\n" +
+	"\x05Admin\x12\x18Create a debug log token\x1a\xa2\x01Returns a signed X-Debug-Log header value. Any request that carries it is logged at debug level, whatever the current level, until the token expires. Admins only.*\x13CreateDebugLogTokenJ\x17\n" +
+	"\x03200\x12\x10\n" +
+	"\x0eToken created.J'\n" +
+	"\x03400\x12 \n" +
+	"\x1eTTL missing or longer than 1h.J \n" +
+	"\x03403\x12\x19\n" +
+	"\x17Caller is not an admin.J,\n" +
+	"\x03412\x12%\n" +
+	"#LOG_DEBUG_SECRET is not configured.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02%:\x01*\" /v1/admin/log_level/debug_tokens\x12\xc2\x03\n" +
+	"\rQueryAuditLog\x12\x18.pb.QueryAuditLogRequest\x1a\x19.pb.QueryAuditLogResponse\"\xfb\x02\x92A\xd9\x02\n" +
+	"\x05Admin\x12\x13Query the audit log\x1a\xcd\x01Returns audit events, newest first, filtered by actor, action, target and time range. Logins, transfers, account changes and profile updates are recorded in the same transaction as the change. Admins only.*\rQueryAuditLogJ(\n" +
+	"\x03200\x12!\n" +
+	"\x1fPaginated list of audit events.J \n" +
+	"\x03403\x12\x19\n" +
+	"\x17Caller is not an admin.b\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/transfersB\xff\x06\x92A\xd3\x06\x12\x82\x03\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/admin/audit_eventsB\xa2\a\x92A\xf6\x06\x12\x82\x03\n" +
 	"\n" +
 	"GoBank API\x12\xeb\x01A production-grade banking API built with Go, gRPC, and gRPC-Gateway.\n" +
 	"\n" +
@@ -179,71 +467,191 @@ const file_service_go_bank_proto_rawDesc = "" +
 	"\x03404\x126\n" +
 	"4Not Found — the requested resource does not exist.R\x1f\n" +
 	"\x03500\x12\x18\n" +
-	"\x16Internal Server Error.ZP\n" +
-	"N\n" +
+	"\x16Internal Server Error.Zs\n" +
+	"q\n" +
 	"\n" +
-	"BearerAuth\x12@\b\x02\x12+Enter: **Bearer &lt;your_access_token&gt;**\x1a\rAuthorization \x02b\x10\n" +
+	"BearerAuth\x12c\b\x02\x12NEnter: **Bearer &lt;your_access_token&gt;** or **ApiKey &lt;your_api_key&gt;**\x1a\rAuthorization \x02b\x10\n" +
 	"\x0e\n" +
 	"\n" +
 	"BearerAuth\x12\x00Z&github.com/a7medalyapany/GoBank.git/pbb\x06proto3"
 
 var file_service_go_bank_proto_goTypes = []any{
-	(*CreateUserRequest)(nil),        // 0: pb.CreateUserRequest
-	(*LoginUserRequest)(nil),         // 1: pb.LoginUserRequest
-	(*RenewAccessTokenRequest)(nil),  // 2: pb.RenewAccessTokenRequest
-	(*VerifyEmailRequest)(nil),       // 3: pb.VerifyEmailRequest
-	(*UpdateUserRequest)(nil),        // 4: pb.UpdateUserRequest
-	(*CreateAccountRequest)(nil),     // 5: pb.CreateAccountRequest
-	(*GetAccountRequest)(nil),        // 6: pb.GetAccountRequest
-	(*ListAccountsRequest)(nil),      // 7: pb.ListAccountsRequest
-	(*ListEntriesRequest)(nil),       // 8: pb.ListEntriesRequest
-	(*UpdateAccountRequest)(nil),     // 9: pb.UpdateAccountRequest
-	(*DeleteAccountRequest)(nil),     // 10: pb.DeleteAccountRequest
-	(*LookUpAccountRequest)(nil),     // 11: pb.LookUpAccountRequest
-	(*CreateTransferRequest)(nil),    // 12: pb.CreateTransferRequest
-	(*CreateUserResponse)(nil),       // 13: pb.CreateUserResponse
-	(*LoginUserResponse)(nil),        // 14: pb.LoginUserResponse
-	(*RenewAccessTokenResponse)(nil), // 15: pb.RenewAccessTokenResponse
-	(*VerifyEmailResponse)(nil),      // 16: pb.VerifyEmailResponse
-	(*UpdateUserResponse)(nil),       // 17: pb.UpdateUserResponse
-	(*CreateAccountResponse)(nil),    // 18: pb.CreateAccountResponse
-	(*GetAccountResponse)(nil),       // 19: pb.GetAccountResponse
-	(*ListAccountsResponse)(nil),     // 20: pb.ListAccountsResponse
-	(*ListEntriesResponse)(nil),      // 21: pb.ListEntriesResponse
-	(*UpdateAccountResponse)(nil),    // 22: pb.UpdateAccountResponse
-	(*DeleteAccountResponse)(nil),    // 23: pb.DeleteAccountResponse
-	(*LookUpAccountResponse)(nil),    // 24: pb.LookUpAccountResponse
-	(*CreateTransferResponse)(nil),   // 25: pb.CreateTransferResponse
+	(*CreateUserRequest)(nil),                     // 0: pb.CreateUserRequest
+	(*LoginUserRequest)(nil),                      // 1: pb.LoginUserRequest
+	(*RenewAccessTokenRequest)(nil),               // 2: pb.RenewAccessTokenRequest
+	(*VerifyEmailRequest)(nil),                    // 3: pb.VerifyEmailRequest
+	(*ConfirmEmailChangeRequest)(nil),             // 4: pb.ConfirmEmailChangeRequest
+	(*CancelEmailChangeRequest)(nil),              // 5: pb.CancelEmailChangeRequest
+	(*UpdateUserRequest)(nil),                     // 6: pb.UpdateUserRequest
+	(*ResendVerifyEmailRequest)(nil),              // 7: pb.ResendVerifyEmailRequest
+	(*CreateAccountRequest)(nil),                  // 8: pb.CreateAccountRequest
+	(*GetAccountRequest)(nil),                     // 9: pb.GetAccountRequest
+	(*ListAccountsRequest)(nil),                   // 10: pb.ListAccountsRequest
+	(*ListEntriesRequest)(nil),                    // 11: pb.ListEntriesRequest
+	(*UpdateAccountRequest)(nil),                  // 12: pb.UpdateAccountRequest
+	(*UpdateAccountNicknameRequest)(nil),          // 13: pb.UpdateAccountNicknameRequest
+	(*DeleteAccountRequest)(nil),                  // 14: pb.DeleteAccountRequest
+	(*LookUpAccountRequest)(nil),                  // 15: pb.LookUpAccountRequest
+	(*InviteAccountMemberRequest)(nil),            // 16: pb.InviteAccountMemberRequest
+	(*ListAccountMembersRequest)(nil),             // 17: pb.ListAccountMembersRequest
+	(*RemoveAccountMemberRequest)(nil),            // 18: pb.RemoveAccountMemberRequest
+	(*ListAccountInvitationsRequest)(nil),         // 19: pb.ListAccountInvitationsRequest
+	(*AcceptAccountInvitationRequest)(nil),        // 20: pb.AcceptAccountInvitationRequest
+	(*GetAccountInterestRequest)(nil),             // 21: pb.GetAccountInterestRequest
+	(*CreateTransferRequest)(nil),                 // 22: pb.CreateTransferRequest
+	(*CreateApiKeyRequest)(nil),                   // 23: pb.CreateApiKeyRequest
+	(*ListApiKeysRequest)(nil),                    // 24: pb.ListApiKeysRequest
+	(*RevokeApiKeyRequest)(nil),                   // 25: pb.RevokeApiKeyRequest
+	(*ListNotificationsRequest)(nil),              // 26: pb.ListNotificationsRequest
+	(*MarkNotificationReadRequest)(nil),           // 27: pb.MarkNotificationReadRequest
+	(*GetNotificationPreferencesRequest)(nil),     // 28: pb.GetNotificationPreferencesRequest
+	(*UpdateNotificationPreferencesRequest)(nil),  // 29: pb.UpdateNotificationPreferencesRequest
+	(*CreateWebhookEndpointRequest)(nil),          // 30: pb.CreateWebhookEndpointRequest
+	(*ListWebhookEndpointsRequest)(nil),           // 31: pb.ListWebhookEndpointsRequest
+	(*DeleteWebhookEndpointRequest)(nil),          // 32: pb.DeleteWebhookEndpointRequest
+	(*ListWebhookDeliveriesRequest)(nil),          // 33: pb.ListWebhookDeliveriesRequest
+	(*ReplayWebhookDeliveryRequest)(nil),          // 34: pb.ReplayWebhookDeliveryRequest
+	(*ListQueuesRequest)(nil),                     // 35: pb.ListQueuesRequest
+	(*ListQueueTasksRequest)(nil),                 // 36: pb.ListQueueTasksRequest
+	(*RetryQueueTaskRequest)(nil),                 // 37: pb.RetryQueueTaskRequest
+	(*DeleteQueueTaskRequest)(nil),                // 38: pb.DeleteQueueTaskRequest
+	(*GetLogLevelRequest)(nil),                    // 39: pb.GetLogLevelRequest
+	(*SetLogLevelRequest)(nil),                    // 40: pb.SetLogLevelRequest
+	(*CreateDebugLogTokenRequest)(nil),            // 41: pb.CreateDebugLogTokenRequest
+	(*QueryAuditLogRequest)(nil),                  // 42: pb.QueryAuditLogRequest
+	(*CreateUserResponse)(nil),                    // 43: pb.CreateUserResponse
+	(*LoginUserResponse)(nil),                     // 44: pb.LoginUserResponse
+	(*RenewAccessTokenResponse)(nil),              // 45: pb.RenewAccessTokenResponse
+	(*VerifyEmailResponse)(nil),                   // 46: pb.VerifyEmailResponse
+	(*ConfirmEmailChangeResponse)(nil),            // 47: pb.ConfirmEmailChangeResponse
+	(*CancelEmailChangeResponse)(nil),             // 48: pb.CancelEmailChangeResponse
+	(*UpdateUserResponse)(nil),                    // 49: pb.UpdateUserResponse
+	(*ResendVerifyEmailResponse)(nil),             // 50: pb.ResendVerifyEmailResponse
+	(*CreateAccountResponse)(nil),                 // 51: pb.CreateAccountResponse
+	(*GetAccountResponse)(nil),                    // 52: pb.GetAccountResponse
+	(*ListAccountsResponse)(nil),                  // 53: pb.ListAccountsResponse
+	(*ListEntriesResponse)(nil),                   // 54: pb.ListEntriesResponse
+	(*UpdateAccountResponse)(nil),                 // 55: pb.UpdateAccountResponse
+	(*UpdateAccountNicknameResponse)(nil),         // 56: pb.UpdateAccountNicknameResponse
+	(*DeleteAccountResponse)(nil),                 // 57: pb.DeleteAccountResponse
+	(*LookUpAccountResponse)(nil),                 // 58: pb.LookUpAccountResponse
+	(*InviteAccountMemberResponse)(nil),           // 59: pb.InviteAccountMemberResponse
+	(*ListAccountMembersResponse)(nil),            // 60: pb.ListAccountMembersResponse
+	(*RemoveAccountMemberResponse)(nil),           // 61: pb.RemoveAccountMemberResponse
+	(*ListAccountInvitationsResponse)(nil),        // 62: pb.ListAccountInvitationsResponse
+	(*AcceptAccountInvitationResponse)(nil),       // 63: pb.AcceptAccountInvitationResponse
+	(*GetAccountInterestResponse)(nil),            // 64: pb.GetAccountInterestResponse
+	(*CreateTransferResponse)(nil),                // 65: pb.CreateTransferResponse
+	(*CreateApiKeyResponse)(nil),                  // 66: pb.CreateApiKeyResponse
+	(*ListApiKeysResponse)(nil),                   // 67: pb.ListApiKeysResponse
+	(*RevokeApiKeyResponse)(nil),                  // 68: pb.RevokeApiKeyResponse
+	(*ListNotificationsResponse)(nil),             // 69: pb.ListNotificationsResponse
+	(*MarkNotificationReadResponse)(nil),          // 70: pb.MarkNotificationReadResponse
+	(*GetNotificationPreferencesResponse)(nil),    // 71: pb.GetNotificationPreferencesResponse
+	(*UpdateNotificationPreferencesResponse)(nil), // 72: pb.UpdateNotificationPreferencesResponse
+	(*CreateWebhookEndpointResponse)(nil),         // 73: pb.CreateWebhookEndpointResponse
+	(*ListWebhookEndpointsResponse)(nil),          // 74: pb.ListWebhookEndpointsResponse
+	(*DeleteWebhookEndpointResponse)(nil),         // 75: pb.DeleteWebhookEndpointResponse
+	(*ListWebhookDeliveriesResponse)(nil),         // 76: pb.ListWebhookDeliveriesResponse
+	(*ReplayWebhookDeliveryResponse)(nil),         // 77: pb.ReplayWebhookDeliveryResponse
+	(*ListQueuesResponse)(nil),                    // 78: pb.ListQueuesResponse
+	(*ListQueueTasksResponse)(nil),                // 79: pb.ListQueueTasksResponse
+	(*RetryQueueTaskResponse)(nil),                // 80: pb.RetryQueueTaskResponse
+	(*DeleteQueueTaskResponse)(nil),               // 81: pb.DeleteQueueTaskResponse
+	(*GetLogLevelResponse)(nil),                   // 82: pb.GetLogLevelResponse
+	(*SetLogLevelResponse)(nil),                   // 83: pb.SetLogLevelResponse
+	(*CreateDebugLogTokenResponse)(nil),           // 84: pb.CreateDebugLogTokenResponse
+	(*QueryAuditLogResponse)(nil),                 // 85: pb.QueryAuditLogResponse
 }
 var file_service_go_bank_proto_depIdxs = []int32{
 	0,  // 0: pb.GoBank.CreateUser:input_type -> pb.CreateUserRequest
 	1,  // 1: pb.GoBank.LoginUser:input_type -> pb.LoginUserRequest
 	2,  // 2: pb.GoBank.RenewAccessToken:input_type -> pb.RenewAccessTokenRequest
 	3,  // 3: pb.GoBank.VerifyEmail:input_type -> pb.VerifyEmailRequest
-	4,  // 4: pb.GoBank.UpdateUser:input_type -> pb.UpdateUserRequest
-	5,  // 5: pb.GoBank.CreateAccount:input_type -> pb.CreateAccountRequest
-	6,  // 6: pb.GoBank.GetAccount:input_type -> pb.GetAccountRequest
-	7,  // 7: pb.GoBank.ListAccounts:input_type -> pb.ListAccountsRequest
-	8,  // 8: pb.GoBank.ListEntries:input_type -> pb.ListEntriesRequest
-	9,  // 9: pb.GoBank.UpdateAccount:input_type -> pb.UpdateAccountRequest
-	10, // 10: pb.GoBank.DeleteAccount:input_type -> pb.DeleteAccountRequest
-	11, // 11: pb.GoBank.LookUpAccount:input_type -> pb.LookUpAccountRequest
-	12, // 12: pb.GoBank.CreateTransfer:input_type -> pb.CreateTransferRequest
-	13, // 13: pb.GoBank.CreateUser:output_type -> pb.CreateUserResponse
-	14, // 14: pb.GoBank.LoginUser:output_type -> pb.LoginUserResponse
-	15, // 15: pb.GoBank.RenewAccessToken:output_type -> pb.RenewAccessTokenResponse
-	16, // 16: pb.GoBank.VerifyEmail:output_type -> pb.VerifyEmailResponse
-	17, // 17: pb.GoBank.UpdateUser:output_type -> pb.UpdateUserResponse
-	18, // 18: pb.GoBank.CreateAccount:output_type -> pb.CreateAccountResponse
-	19, // 19: pb.GoBank.GetAccount:output_type -> pb.GetAccountResponse
-	20, // 20: pb.GoBank.ListAccounts:output_type -> pb.ListAccountsResponse
-	21, // 21: pb.GoBank.ListEntries:output_type -> pb.ListEntriesResponse
-	22, // 22: pb.GoBank.UpdateAccount:output_type -> pb.UpdateAccountResponse
-	23, // 23: pb.GoBank.DeleteAccount:output_type -> pb.DeleteAccountResponse
-	24, // 24: pb.GoBank.LookUpAccount:output_type -> pb.LookUpAccountResponse
-	25, // 25: pb.GoBank.CreateTransfer:output_type -> pb.CreateTransferResponse
-	13, // [13:26] is the sub-list for method output_type
-	0,  // [0:13] is the sub-list for method input_type
+	4,  // 4: pb.GoBank.ConfirmEmailChange:input_type -> pb.ConfirmEmailChangeRequest
+	5,  // 5: pb.GoBank.CancelEmailChange:input_type -> pb.CancelEmailChangeRequest
+	6,  // 6: pb.GoBank.UpdateUser:input_type -> pb.UpdateUserRequest
+	7,  // 7: pb.GoBank.ResendVerifyEmail:input_type -> pb.ResendVerifyEmailRequest
+	8,  // 8: pb.GoBank.CreateAccount:input_type -> pb.CreateAccountRequest
+	9,  // 9: pb.GoBank.GetAccount:input_type -> pb.GetAccountRequest
+	10, // 10: pb.GoBank.ListAccounts:input_type -> pb.ListAccountsRequest
+	11, // 11: pb.GoBank.ListEntries:input_type -> pb.ListEntriesRequest
+	12, // 12: pb.GoBank.UpdateAccount:input_type -> pb.UpdateAccountRequest
+	13, // 13: pb.GoBank.UpdateAccountNickname:input_type -> pb.UpdateAccountNicknameRequest
+	14, // 14: pb.GoBank.DeleteAccount:input_type -> pb.DeleteAccountRequest
+	15, // 15: pb.GoBank.LookUpAccount:input_type -> pb.LookUpAccountRequest
+	16, // 16: pb.GoBank.InviteAccountMember:input_type -> pb.InviteAccountMemberRequest
+	17, // 17: pb.GoBank.ListAccountMembers:input_type -> pb.ListAccountMembersRequest
+	18, // 18: pb.GoBank.RemoveAccountMember:input_type -> pb.RemoveAccountMemberRequest
+	19, // 19: pb.GoBank.ListAccountInvitations:input_type -> pb.ListAccountInvitationsRequest
+	20, // 20: pb.GoBank.AcceptAccountInvitation:input_type -> pb.AcceptAccountInvitationRequest
+	21, // 21: pb.GoBank.GetAccountInterest:input_type -> pb.GetAccountInterestRequest
+	22, // 22: pb.GoBank.CreateTransfer:input_type -> pb.CreateTransferRequest
+	23, // 23: pb.GoBank.CreateApiKey:input_type -> pb.CreateApiKeyRequest
+	24, // 24: pb.GoBank.ListApiKeys:input_type -> pb.ListApiKeysRequest
+	25, // 25: pb.GoBank.RevokeApiKey:input_type -> pb.RevokeApiKeyRequest
+	26, // 26: pb.GoBank.ListNotifications:input_type -> pb.ListNotificationsRequest
+	27, // 27: pb.GoBank.MarkNotificationRead:input_type -> pb.MarkNotificationReadRequest
+	28, // 28: pb.GoBank.GetNotificationPreferences:input_type -> pb.GetNotificationPreferencesRequest
+	29, // 29: pb.GoBank.UpdateNotificationPreferences:input_type -> pb.UpdateNotificationPreferencesRequest
+	30, // 30: pb.GoBank.CreateWebhookEndpoint:input_type -> pb.CreateWebhookEndpointRequest
+	31, // 31: pb.GoBank.ListWebhookEndpoints:input_type -> pb.ListWebhookEndpointsRequest
+	32, // 32: pb.GoBank.DeleteWebhookEndpoint:input_type -> pb.DeleteWebhookEndpointRequest
+	33, // 33: pb.GoBank.ListWebhookDeliveries:input_type -> pb.ListWebhookDeliveriesRequest
+	34, // 34: pb.GoBank.ReplayWebhookDelivery:input_type -> pb.ReplayWebhookDeliveryRequest
+	35, // 35: pb.GoBank.ListQueues:input_type -> pb.ListQueuesRequest
+	36, // 36: pb.GoBank.ListQueueTasks:input_type -> pb.ListQueueTasksRequest
+	37, // 37: pb.GoBank.RetryQueueTask:input_type -> pb.RetryQueueTaskRequest
+	38, // 38: pb.GoBank.DeleteQueueTask:input_type -> pb.DeleteQueueTaskRequest
+	39, // 39: pb.GoBank.GetLogLevel:input_type -> pb.GetLogLevelRequest
+	40, // 40: pb.GoBank.SetLogLevel:input_type -> pb.SetLogLevelRequest
+	41, // 41: pb.GoBank.CreateDebugLogToken:input_type -> pb.CreateDebugLogTokenRequest
+	42, // 42: pb.GoBank.QueryAuditLog:input_type -> pb.QueryAuditLogRequest
+	43, // 43: pb.GoBank.CreateUser:output_type -> pb.CreateUserResponse
+	44, // 44: pb.GoBank.LoginUser:output_type -> pb.LoginUserResponse
+	45, // 45: pb.GoBank.RenewAccessToken:output_type -> pb.RenewAccessTokenResponse
+	46, // 46: pb.GoBank.VerifyEmail:output_type -> pb.VerifyEmailResponse
+	47, // 47: pb.GoBank.ConfirmEmailChange:output_type -> pb.ConfirmEmailChangeResponse
+	48, // 48: pb.GoBank.CancelEmailChange:output_type -> pb.CancelEmailChangeResponse
+	49, // 49: pb.GoBank.UpdateUser:output_type -> pb.UpdateUserResponse
+	50, // 50: pb.GoBank.ResendVerifyEmail:output_type -> pb.ResendVerifyEmailResponse
+	51, // 51: pb.GoBank.CreateAccount:output_type -> pb.CreateAccountResponse
+	52, // 52: pb.GoBank.GetAccount:output_type -> pb.GetAccountResponse
+	53, // 53: pb.GoBank.ListAccounts:output_type -> pb.ListAccountsResponse
+	54, // 54: pb.GoBank.ListEntries:output_type -> pb.ListEntriesResponse
+	55, // 55: pb.GoBank.UpdateAccount:output_type -> pb.UpdateAccountResponse
+	56, // 56: pb.GoBank.UpdateAccountNickname:output_type -> pb.UpdateAccountNicknameResponse
+	57, // 57: pb.GoBank.DeleteAccount:output_type -> pb.DeleteAccountResponse
+	58, // 58: pb.GoBank.LookUpAccount:output_type -> pb.LookUpAccountResponse
+	59, // 59: pb.GoBank.InviteAccountMember:output_type -> pb.InviteAccountMemberResponse
+	60, // 60: pb.GoBank.ListAccountMembers:output_type -> pb.ListAccountMembersResponse
+	61, // 61: pb.GoBank.RemoveAccountMember:output_type -> pb.RemoveAccountMemberResponse
+	62, // 62: pb.GoBank.ListAccountInvitations:output_type -> pb.ListAccountInvitationsResponse
+	63, // 63: pb.GoBank.AcceptAccountInvitation:output_type -> pb.AcceptAccountInvitationResponse
+	64, // 64: pb.GoBank.GetAccountInterest:output_type -> pb.GetAccountInterestResponse
+	65, // 65: pb.GoBank.CreateTransfer:output_type -> pb.CreateTransferResponse
+	66, // 66: pb.GoBank.CreateApiKey:output_type -> pb.CreateApiKeyResponse
+	67, // 67: pb.GoBank.ListApiKeys:output_type -> pb.ListApiKeysResponse
+	68, // 68: pb.GoBank.RevokeApiKey:output_type -> pb.RevokeApiKeyResponse
+	69, // 69: pb.GoBank.ListNotifications:output_type -> pb.ListNotificationsResponse
+	70, // 70: pb.GoBank.MarkNotificationRead:output_type -> pb.MarkNotificationReadResponse
+	71, // 71: pb.GoBank.GetNotificationPreferences:output_type -> pb.GetNotificationPreferencesResponse
+	72, // 72: pb.GoBank.UpdateNotificationPreferences:output_type -> pb.UpdateNotificationPreferencesResponse
+	73, // 73: pb.GoBank.CreateWebhookEndpoint:output_type -> pb.CreateWebhookEndpointResponse
+	74, // 74: pb.GoBank.ListWebhookEndpoints:output_type -> pb.ListWebhookEndpointsResponse
+	75, // 75: pb.GoBank.DeleteWebhookEndpoint:output_type -> pb.DeleteWebhookEndpointResponse
+	76, // 76: pb.GoBank.ListWebhookDeliveries:output_type -> pb.ListWebhookDeliveriesResponse
+	77, // 77: pb.GoBank.ReplayWebhookDelivery:output_type -> pb.ReplayWebhookDeliveryResponse
+	78, // 78: pb.GoBank.ListQueues:output_type -> pb.ListQueuesResponse
+	79, // 79: pb.GoBank.ListQueueTasks:output_type -> pb.ListQueueTasksResponse
+	80, // 80: pb.GoBank.RetryQueueTask:output_type -> pb.RetryQueueTaskResponse
+	81, // 81: pb.GoBank.DeleteQueueTask:output_type -> pb.DeleteQueueTaskResponse
+	82, // 82: pb.GoBank.GetLogLevel:output_type -> pb.GetLogLevelResponse
+	83, // 83: pb.GoBank.SetLogLevel:output_type -> pb.SetLogLevelResponse
+	84, // 84: pb.GoBank.CreateDebugLogToken:output_type -> pb.CreateDebugLogTokenResponse
+	85, // 85: pb.GoBank.QueryAuditLog:output_type -> pb.QueryAuditLogResponse
+	43, // [43:86] is the sub-list for method output_type
+	0,  // [0:43] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -263,6 +671,14 @@ func file_service_go_bank_proto_init() {
 	file_rpc_entry_proto_init()
 	file_rpc_update_user_proto_init()
 	file_rpc_verify_email_proto_init()
+	file_rpc_api_key_proto_init()
+	file_rpc_notification_proto_init()
+	file_rpc_webhook_proto_init()
+	file_rpc_admin_proto_init()
+	file_rpc_email_change_proto_init()
+	file_rpc_audit_proto_init()
+	file_rpc_account_member_proto_init()
+	file_rpc_interest_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -34,6 +34,7 @@ type User struct {
 	// Zero value (0001-01-01) means the password has never been changed.
 	PasswordChangedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=password_changed_at,json=passwordChangedAt,proto3" json:"password_changed_at,omitempty"`
 	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Locale            string                 `protobuf:"bytes,7,opt,name=locale,proto3" json:"locale,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -110,12 +111,19 @@ func (x *User) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *User) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
 var File_user_proto protoreflect.FileDescriptor
 
 const file_user_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"user.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xec\x04\n" +
+	"user.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xbf\x05\n" +
 	"\x04User\x12N\n" +
 	"\busername\x18\x01 \x01(\tB2\x92A/2\x1dUnique alphanumeric username.J\x0e\"john_doe_123\"R\busername\x12L\n" +
 	"\tfull_name\x18\x02 \x01(\tB/\x92A,2\x1eFull display name of the user.J\n" +
@@ -124,7 +132,8 @@ const file_user_proto_rawDesc = "" +
 	"\x11is_email_verified\x18\x04 \x01(\bB8\x92A523Whether the user's email address has been verified.R\x0fisEmailVerified\x12\xa8\x01\n" +
 	"\x13password_changed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\\\x92AY2WUTC timestamp of the last password change. Zero value means password was never changed.R\x11passwordChangedAt\x12k\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB0\x92A-2+UTC timestamp when the account was created.R\tcreatedAtB(Z&github.com/a7medalyapany/GoBank.git/pbb\x06proto3"
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB0\x92A-2+UTC timestamp when the account was created.R\tcreatedAt\x12Q\n" +
+	"\x06locale\x18\a \x01(\tB9\x92A62.Preferred language for emails. One of: en, ar.J\x04\"en\"R\x06localeB(Z&github.com/a7medalyapany/GoBank.git/pbb\x06proto3"
 
 var (
 	file_user_proto_rawDescOnce sync.Once
@@ -0,0 +1,144 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.0
+// source: v2/money.proto
+
+package pbv2
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Money is an exact amount of a currency. Responses set both units and
+// decimal. Requests may set either: decimal wins when set, and units must
+// then be 0 or agree with it.
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Units         int64                  `protobuf:"varint,2,opt,name=units,proto3" json:"units,omitempty"`
+	Decimal       string                 `protobuf:"bytes,3,opt,name=decimal,proto3" json:"decimal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_v2_money_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_money_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_v2_money_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Money) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *Money) GetDecimal() string {
+	if x != nil {
+		return x.Decimal
+	}
+	return ""
+}
+
+var File_v2_money_proto protoreflect.FileDescriptor
+
+const file_v2_money_proto_rawDesc = "" +
+	"\n" +
+	"\x0ev2/money.proto\x12\x05pb.v2\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xd6\x02\n" +
+	"\x05Money\x12?\n" +
+	"\bcurrency\x18\x01 \x01(\tB#\x92A 2\x17ISO 4217 currency code.J\x05\"USD\"R\bcurrency\x12t\n" +
+	"\x05units\x18\x02 \x01(\x03B^\x92A[2QAmount in minor units of the currency (cents for USD, yen for JPY, fils for KWD).J\x06\"1050\"R\x05units\x12\x95\x01\n" +
+	"\adecimal\x18\x03 \x01(\tB{\x92Ax2mThe same amount as an exact decimal string in major units, with at most as many decimals as the currency has.J\a\"10.50\"R\adecimalB0Z.github.com/a7medalyapany/GoBank.git/pb/v2;pbv2b\x06proto3"
+
+var (
+	file_v2_money_proto_rawDescOnce sync.Once
+	file_v2_money_proto_rawDescData []byte
+)
+
+func file_v2_money_proto_rawDescGZIP() []byte {
+	file_v2_money_proto_rawDescOnce.Do(func() {
+		file_v2_money_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v2_money_proto_rawDesc), len(file_v2_money_proto_rawDesc)))
+	})
+	return file_v2_money_proto_rawDescData
+}
+
+var file_v2_money_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_v2_money_proto_goTypes = []any{
+	(*Money)(nil), // 0: pb.v2.Money
+}
+var file_v2_money_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_v2_money_proto_init() }
+func file_v2_money_proto_init() {
+	if File_v2_money_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v2_money_proto_rawDesc), len(file_v2_money_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v2_money_proto_goTypes,
+		DependencyIndexes: file_v2_money_proto_depIdxs,
+		MessageInfos:      file_v2_money_proto_msgTypes,
+	}.Build()
+	File_v2_money_proto = out.File
+	file_v2_money_proto_goTypes = nil
+	file_v2_money_proto_depIdxs = nil
+}
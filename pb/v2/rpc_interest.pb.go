@@ -0,0 +1,320 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.0
+// source: v2/rpc_interest.proto
+
+package pbv2
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type InterestPosting struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	PeriodStart   string                 `protobuf:"bytes,3,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd     string                 `protobuf:"bytes,4,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	TransferId    int64                  `protobuf:"varint,5,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InterestPosting) Reset() {
+	*x = InterestPosting{}
+	mi := &file_v2_rpc_interest_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InterestPosting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterestPosting) ProtoMessage() {}
+
+func (x *InterestPosting) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_rpc_interest_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterestPosting.ProtoReflect.Descriptor instead.
+func (*InterestPosting) Descriptor() ([]byte, []int) {
+	return file_v2_rpc_interest_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InterestPosting) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InterestPosting) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *InterestPosting) GetPeriodStart() string {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return ""
+}
+
+func (x *InterestPosting) GetPeriodEnd() string {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return ""
+}
+
+func (x *InterestPosting) GetTransferId() int64 {
+	if x != nil {
+		return x.TransferId
+	}
+	return 0
+}
+
+func (x *InterestPosting) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetAccountInterestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	PageId        int32                  `protobuf:"varint,2,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountInterestRequest) Reset() {
+	*x = GetAccountInterestRequest{}
+	mi := &file_v2_rpc_interest_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountInterestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountInterestRequest) ProtoMessage() {}
+
+func (x *GetAccountInterestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_rpc_interest_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountInterestRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountInterestRequest) Descriptor() ([]byte, []int) {
+	return file_v2_rpc_interest_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetAccountInterestRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *GetAccountInterestRequest) GetPageId() int32 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *GetAccountInterestRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetAccountInterestResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	AnnualRate            string                 `protobuf:"bytes,1,opt,name=annual_rate,json=annualRate,proto3" json:"annual_rate,omitempty"`
+	PendingInterest       *Money                 `protobuf:"bytes,2,opt,name=pending_interest,json=pendingInterest,proto3" json:"pending_interest,omitempty"`
+	PendingInterestMicros int64                  `protobuf:"varint,3,opt,name=pending_interest_micros,json=pendingInterestMicros,proto3" json:"pending_interest_micros,omitempty"`
+	Postings              []*InterestPosting     `protobuf:"bytes,4,rep,name=postings,proto3" json:"postings,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetAccountInterestResponse) Reset() {
+	*x = GetAccountInterestResponse{}
+	mi := &file_v2_rpc_interest_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountInterestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountInterestResponse) ProtoMessage() {}
+
+func (x *GetAccountInterestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_rpc_interest_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountInterestResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountInterestResponse) Descriptor() ([]byte, []int) {
+	return file_v2_rpc_interest_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetAccountInterestResponse) GetAnnualRate() string {
+	if x != nil {
+		return x.AnnualRate
+	}
+	return ""
+}
+
+func (x *GetAccountInterestResponse) GetPendingInterest() *Money {
+	if x != nil {
+		return x.PendingInterest
+	}
+	return nil
+}
+
+func (x *GetAccountInterestResponse) GetPendingInterestMicros() int64 {
+	if x != nil {
+		return x.PendingInterestMicros
+	}
+	return 0
+}
+
+func (x *GetAccountInterestResponse) GetPostings() []*InterestPosting {
+	if x != nil {
+		return x.Postings
+	}
+	return nil
+}
+
+var File_v2_rpc_interest_proto protoreflect.FileDescriptor
+
+const file_v2_rpc_interest_proto_rawDesc = "" +
+	"\n" +
+	"\x15v2/rpc_interest.proto\x12\x05pb.v2\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x0ev2/money.proto\"\xc4\x04\n" +
+	"\x0fInterestPosting\x12'\n" +
+	"\x02id\x18\x01 \x01(\x03B\x17\x92A\x142\x12Unique posting ID.R\x02id\x12]\n" +
+	"\x06amount\x18\x02 \x01(\v2\f.pb.v2.MoneyB7\x92A422Interest credited, in the currency of the account.R\x06amount\x12d\n" +
+	"\fperiod_start\x18\x03 \x01(\tBA\x92A>2.First day (UTC) of interest this posting paid.J\f\"2026-09-01\"R\vperiodStart\x12_\n" +
+	"\n" +
+	"period_end\x18\x04 \x01(\tB@\x92A=2-Last day (UTC) of interest this posting paid.J\f\"2026-09-30\"R\tperiodEnd\x12\x82\x01\n" +
+	"\vtransfer_id\x18\x05 \x01(\x03Ba\x92A^2\\Transfer that credited the interest; 0 when it rounded down to nothing and was carried over.R\n" +
+	"transferId\x12]\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\"\x92A\x1f2\x1dUTC timestamp of the posting.R\tcreatedAt\"\xa5\x02\n" +
+	"\x19GetAccountInterestRequest\x12]\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03B>\x92A;20ID of the account. You must be an active member.i\x00\x00\x00\x00\x00\x00\xf0?R\taccountId\x12J\n" +
+	"\apage_id\x18\x02 \x01(\x05B1\x92A.2 1-based page number of postings.J\x011i\x00\x00\x00\x00\x00\x00\xf0?R\x06pageId\x12]\n" +
+	"\tpage_size\x18\x03 \x01(\x05B@\x92A=2%Number of postings per page. Max 100.J\x0212Y\x00\x00\x00\x00\x00\x00Y@i\x00\x00\x00\x00\x00\x00\xf0?R\bpageSize\"\xb2\x05\n" +
+	"\x1aGetAccountInterestResponse\x12\xc9\x01\n" +
+	"\vannual_rate\x18\x01 \x01(\tB\xa7\x01\x92A\xa3\x012\x98\x01Annual rate the account earns now, in percent, as an exact decimal string with two decimals. \"0.00\" for checking accounts and currencies without a rate.J\x06\"2.50\"R\n" +
+	"annualRate\x12\xab\x01\n" +
+	"\x10pending_interest\x18\x02 \x01(\v2\f.pb.v2.MoneyBr\x92Ao2mInterest accrued but not posted yet, rounded down to whole minor units: what a posting made now would credit.R\x0fpendingInterest\x12\xb6\x01\n" +
+	"\x17pending_interest_micros\x18\x03 \x01(\x03B~\x92A{2nThe exact pending interest in millionths of a minor unit, since interest accrues in fractions of a minor unit.J\t\"7547945\"R\x15pendingInterestMicros\x12a\n" +
+	"\bpostings\x18\x04 \x03(\v2\x16.pb.v2.InterestPostingB-\x92A*2(Monthly interest postings, newest first.R\bpostingsB0Z.github.com/a7medalyapany/GoBank.git/pb/v2;pbv2b\x06proto3"
+
+var (
+	file_v2_rpc_interest_proto_rawDescOnce sync.Once
+	file_v2_rpc_interest_proto_rawDescData []byte
+)
+
+func file_v2_rpc_interest_proto_rawDescGZIP() []byte {
+	file_v2_rpc_interest_proto_rawDescOnce.Do(func() {
+		file_v2_rpc_interest_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v2_rpc_interest_proto_rawDesc), len(file_v2_rpc_interest_proto_rawDesc)))
+	})
+	return file_v2_rpc_interest_proto_rawDescData
+}
+
+var file_v2_rpc_interest_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_v2_rpc_interest_proto_goTypes = []any{
+	(*InterestPosting)(nil),            // 0: pb.v2.InterestPosting
+	(*GetAccountInterestRequest)(nil),  // 1: pb.v2.GetAccountInterestRequest
+	(*GetAccountInterestResponse)(nil), // 2: pb.v2.GetAccountInterestResponse
+	(*Money)(nil),                      // 3: pb.v2.Money
+	(*timestamppb.Timestamp)(nil),      // 4: google.protobuf.Timestamp
+}
+var file_v2_rpc_interest_proto_depIdxs = []int32{
+	3, // 0: pb.v2.InterestPosting.amount:type_name -> pb.v2.Money
+	4, // 1: pb.v2.InterestPosting.created_at:type_name -> google.protobuf.Timestamp
+	3, // 2: pb.v2.GetAccountInterestResponse.pending_interest:type_name -> pb.v2.Money
+	0, // 3: pb.v2.GetAccountInterestResponse.postings:type_name -> pb.v2.InterestPosting
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_v2_rpc_interest_proto_init() }
+func file_v2_rpc_interest_proto_init() {
+	if File_v2_rpc_interest_proto != nil {
+		return
+	}
+	file_v2_money_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v2_rpc_interest_proto_rawDesc), len(file_v2_rpc_interest_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v2_rpc_interest_proto_goTypes,
+		DependencyIndexes: file_v2_rpc_interest_proto_depIdxs,
+		MessageInfos:      file_v2_rpc_interest_proto_msgTypes,
+	}.Build()
+	File_v2_rpc_interest_proto = out.File
+	file_v2_rpc_interest_proto_goTypes = nil
+	file_v2_rpc_interest_proto_depIdxs = nil
+}
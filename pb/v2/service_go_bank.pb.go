@@ -0,0 +1,170 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.0
+// source: v2/service_go_bank.proto
+
+package pbv2
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_v2_service_go_bank_proto protoreflect.FileDescriptor
+
+const file_v2_service_go_bank_proto_rawDesc = "" +
+	"\n" +
+	"\x18v2/service_go_bank.proto\x12\x05pb.v2\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x14v2/rpc_account.proto\x1a\x15v2/rpc_transfer.proto\x1a\x12v2/rpc_entry.proto\x1a\x15v2/rpc_interest.proto2\x87\x1c\n" +
+	"\x06GoBank\x12\xcc\x03\n" +
+	"\rCreateAccount\x12\x1b.pb.v2.CreateAccountRequest\x1a\x1c.pb.v2.CreateAccountResponse\"\xff\x02\x92A\xe4\x02\n" +
+	"\vAccounts v2\x12\x11Create an account\x1a\x9d\x01Creates a new checking or savings account for the authenticated user. A user may hold several accounts in the same currency, told apart by type and nickname.*\x0fCreateAccountV2J&\n" +
+	"\x03200\x12\x1f\n" +
+	"\x1dAccount created successfully.JW\n" +
+	"\x03412\x12P\n" +
+	"NEmail address is not verified. The error carries a PreconditionFailure detail.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v2/accounts\x12\x84\x03\n" +
+	"\n" +
+	"GetAccount\x12\x18.pb.v2.GetAccountRequest\x1a\x19.pb.v2.GetAccountResponse\"\xc0\x02\x92A\xa3\x02\n" +
+	"\vAccounts v2\x12\x0eGet an account\x1aaRetrieves a single account by ID. The caller must be an active member of the account in any role.*\fGetAccountV2J(\n" +
+	"\x03200\x12!\n" +
+	"\x1fAccount retrieved successfully.J:\n" +
+	"\x03403\x123\n" +
+	"1Caller's role on the account does not allow this.J\x1b\n" +
+	"\x03404\x12\x14\n" +
+	"\x12Account not found.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x13\x12\x11/v2/accounts/{id}\x12\xd2\x02\n" +
+	"\fListAccounts\x12\x1a.pb.v2.ListAccountsRequest\x1a\x1b.pb.v2.ListAccountsResponse\"\x88\x02\x92A\xf0\x01\n" +
+	"\vAccounts v2\x12\rList accounts\x1a\x89\x01Returns a paginated list of the accounts the authenticated user owns or is an active member of, optionally filtered by currency and type.*\x0eListAccountsV2J$\n" +
+	"\x03200\x12\x1d\n" +
+	"\x1bPaginated list of accounts.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x0e\x12\f/v2/accounts\x12\xb3\x03\n" +
+	"\rUpdateAccount\x12\x1b.pb.v2.UpdateAccountRequest\x1a\x1c.pb.v2.UpdateAccountResponse\"\xe6\x02\x92A\xc6\x02\n" +
+	"\vAccounts v2\x12\x16Update account balance\x1a{Sets the balance of an account. Only its owner may; co-owners cannot. The balance must be in the account currency and >= 0.*\x0fUpdateAccountV2J&\n" +
+	"\x03200\x12\x1f\n" +
+	"\x1dAccount updated successfully.J:\n" +
+	"\x03403\x123\n" +
+	"1Caller's role on the account does not allow this.J\x1b\n" +
+	"\x03404\x12\x14\n" +
+	"\x12Account not found.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x16:\x01*\x1a\x11/v2/accounts/{id}\x12\xff\x03\n" +
+	"\x12GetAccountInterest\x12 .pb.v2.GetAccountInterestRequest\x1a!.pb.v2.GetAccountInterestResponse\"\xa3\x03\x92A\xf5\x02\n" +
+	"\vInterest v2\x12\x14Get account interest\x1a\xb6\x01Returns the annual rate of a savings account, the interest accrued since the last posting and the monthly interest postings, all as exact values. The caller must be an active member.*\x14GetAccountInterestV2J!\n" +
+	"\x03200\x12\x1a\n" +
+	"\x18Interest of the account.J/\n" +
+	"\x03403\x12(\n" +
+	"&Caller is not a member of the account.J\x1b\n" +
+	"\x03404\x12\x14\n" +
+	"\x12Account not found.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02$\x12\"/v2/accounts/{account_id}/interest\x12\x9e\x03\n" +
+	"\vListEntries\x12\x19.pb.v2.ListEntriesRequest\x1a\x1a.pb.v2.ListEntriesResponse\"\xd7\x02\x92A\xc0\x02\n" +
+	"\n" +
+	"Entries v2\x12\x15List activity entries\x1a\x8d\x01Returns a paginated list of activity entries across all accounts the authenticated user is an active member of, ordered by most recent first.*\rListEntriesV2J?\n" +
+	"\x03200\x128\n" +
+	"6Paginated list of authenticated user activity entries.J)\n" +
+	"\x03401\x12\"\n" +
+	" Missing or invalid Bearer token.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\r\x12\v/v2/entries\x12\xf8\a\n" +
+	"\x0eCreateTransfer\x12\x1c.pb.v2.CreateTransferRequest\x1a\x1d.pb.v2.CreateTransferResponse\"\xa8\a\x92A\x8c\a\n" +
+	"\fTransfers v2\x12\x11Create a transfer\x1a\xb6\x03Atomically transfers funds between two accounts. The destination is given by ID or by account number. The caller must be the owner, a co-owner or a spender of the source account; spenders are held to their spend limit, which covers everything they transfer from the account in any 24 hours. Both accounts must hold the currency of the amount, which is exact: it is rejected rather than rounded when it has more decimals than the currency.*\x10CreateTransferV2J]\n" +
+	"\x03200\x12V\n" +
+	"TTransfer completed. Returns transfer record, entries, and updated account snapshots.J3\n" +
+	"\x03400\x12,\n" +
+	"*Insufficient balance or currency mismatch.Jl\n" +
+	"\x03403\x12e\n" +
+	"cCaller may not spend from the source account, or the amount would take them over their spend limit.J1\n" +
+	"\x03404\x12*\n" +
+	"(Source or destination account not found.JW\n" +
+	"\x03412\x12P\n" +
+	"NEmail address is not verified. The error carries a PreconditionFailure detail.b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v2/transfersB0Z.github.com/a7medalyapany/GoBank.git/pb/v2;pbv2b\x06proto3"
+
+var file_v2_service_go_bank_proto_goTypes = []any{
+	(*CreateAccountRequest)(nil),       // 0: pb.v2.CreateAccountRequest
+	(*GetAccountRequest)(nil),          // 1: pb.v2.GetAccountRequest
+	(*ListAccountsRequest)(nil),        // 2: pb.v2.ListAccountsRequest
+	(*UpdateAccountRequest)(nil),       // 3: pb.v2.UpdateAccountRequest
+	(*GetAccountInterestRequest)(nil),  // 4: pb.v2.GetAccountInterestRequest
+	(*ListEntriesRequest)(nil),         // 5: pb.v2.ListEntriesRequest
+	(*CreateTransferRequest)(nil),      // 6: pb.v2.CreateTransferRequest
+	(*CreateAccountResponse)(nil),      // 7: pb.v2.CreateAccountResponse
+	(*GetAccountResponse)(nil),         // 8: pb.v2.GetAccountResponse
+	(*ListAccountsResponse)(nil),       // 9: pb.v2.ListAccountsResponse
+	(*UpdateAccountResponse)(nil),      // 10: pb.v2.UpdateAccountResponse
+	(*GetAccountInterestResponse)(nil), // 11: pb.v2.GetAccountInterestResponse
+	(*ListEntriesResponse)(nil),        // 12: pb.v2.ListEntriesResponse
+	(*CreateTransferResponse)(nil),     // 13: pb.v2.CreateTransferResponse
+}
+var file_v2_service_go_bank_proto_depIdxs = []int32{
+	0,  // 0: pb.v2.GoBank.CreateAccount:input_type -> pb.v2.CreateAccountRequest
+	1,  // 1: pb.v2.GoBank.GetAccount:input_type -> pb.v2.GetAccountRequest
+	2,  // 2: pb.v2.GoBank.ListAccounts:input_type -> pb.v2.ListAccountsRequest
+	3,  // 3: pb.v2.GoBank.UpdateAccount:input_type -> pb.v2.UpdateAccountRequest
+	4,  // 4: pb.v2.GoBank.GetAccountInterest:input_type -> pb.v2.GetAccountInterestRequest
+	5,  // 5: pb.v2.GoBank.ListEntries:input_type -> pb.v2.ListEntriesRequest
+	6,  // 6: pb.v2.GoBank.CreateTransfer:input_type -> pb.v2.CreateTransferRequest
+	7,  // 7: pb.v2.GoBank.CreateAccount:output_type -> pb.v2.CreateAccountResponse
+	8,  // 8: pb.v2.GoBank.GetAccount:output_type -> pb.v2.GetAccountResponse
+	9,  // 9: pb.v2.GoBank.ListAccounts:output_type -> pb.v2.ListAccountsResponse
+	10, // 10: pb.v2.GoBank.UpdateAccount:output_type -> pb.v2.UpdateAccountResponse
+	11, // 11: pb.v2.GoBank.GetAccountInterest:output_type -> pb.v2.GetAccountInterestResponse
+	12, // 12: pb.v2.GoBank.ListEntries:output_type -> pb.v2.ListEntriesResponse
+	13, // 13: pb.v2.GoBank.CreateTransfer:output_type -> pb.v2.CreateTransferResponse
+	7,  // [7:14] is the sub-list for method output_type
+	0,  // [0:7] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_v2_service_go_bank_proto_init() }
+func file_v2_service_go_bank_proto_init() {
+	if File_v2_service_go_bank_proto != nil {
+		return
+	}
+	file_v2_rpc_account_proto_init()
+	file_v2_rpc_transfer_proto_init()
+	file_v2_rpc_entry_proto_init()
+	file_v2_rpc_interest_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v2_service_go_bank_proto_rawDesc), len(file_v2_service_go_bank_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v2_service_go_bank_proto_goTypes,
+		DependencyIndexes: file_v2_service_go_bank_proto_depIdxs,
+	}.Build()
+	File_v2_service_go_bank_proto = out.File
+	file_v2_service_go_bank_proto_goTypes = nil
+	file_v2_service_go_bank_proto_depIdxs = nil
+}
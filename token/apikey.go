@@ -0,0 +1,69 @@
+package token
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+// API keys look like "gbk_<prefix>_<secret>". The prefix is stored in clear
+// so a key can be found (and recognised in logs) without revealing it; only
+// a SHA-256 hash of the whole key is kept at rest.
+const (
+	apiKeyScheme     = "gbk"
+	apiKeyPrefixSize = 8
+	apiKeySecretSize = 32
+)
+
+var ErrInvalidAPIKey = errors.New("api key is invalid")
+
+// APIKey is a freshly generated key. Key is shown to the user once and never stored.
+type APIKey struct {
+	Prefix    string
+	Key       string
+	HashedKey string
+}
+
+// GenerateAPIKey creates a new random API key.
+func GenerateAPIKey() (APIKey, error) {
+	prefix := make([]byte, apiKeyPrefixSize/2)
+	if _, err := rand.Read(prefix); err != nil {
+		return APIKey{}, err
+	}
+	secret := make([]byte, apiKeySecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return APIKey{}, err
+	}
+
+	p := hex.EncodeToString(prefix)
+	key := apiKeyScheme + "_" + p + "_" + base64.RawURLEncoding.EncodeToString(secret)
+	return APIKey{Prefix: p, Key: key, HashedKey: HashAPIKey(key)}, nil
+}
+
+// ParseAPIKeyPrefix extracts the lookup prefix from a full API key.
+func ParseAPIKeyPrefix(key string) (string, error) {
+	parts := strings.SplitN(key, "_", 3)
+	if len(parts) != 3 || parts[0] != apiKeyScheme || len(parts[1]) != apiKeyPrefixSize || parts[2] == "" {
+		return "", ErrInvalidAPIKey
+	}
+	return parts[1], nil
+}
+
+// HashAPIKey returns the hex SHA-256 of key. API keys carry 256 bits of
+// entropy, so a fast hash is enough and keeps per-request checks cheap.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// CheckAPIKey compares key against hashedKey in constant time.
+func CheckAPIKey(key string, hashedKey string) error {
+	if subtle.ConstantTimeCompare([]byte(HashAPIKey(key)), []byte(hashedKey)) != 1 {
+		return ErrInvalidAPIKey
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package token
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateAPIKey(t *testing.T) {
+	apiKey, err := GenerateAPIKey()
+	require.NoError(t, err)
+	require.Len(t, apiKey.Prefix, apiKeyPrefixSize)
+	require.NotEqual(t, apiKey.Key, apiKey.HashedKey)
+
+	prefix, err := ParseAPIKeyPrefix(apiKey.Key)
+	require.NoError(t, err)
+	require.Equal(t, apiKey.Prefix, prefix)
+
+	require.NoError(t, CheckAPIKey(apiKey.Key, apiKey.HashedKey))
+
+	other, err := GenerateAPIKey()
+	require.NoError(t, err)
+	require.ErrorIs(t, CheckAPIKey(other.Key, apiKey.HashedKey), ErrInvalidAPIKey)
+}
+
+func TestParseAPIKeyPrefixErrors(t *testing.T) {
+	for _, key := range []string{
+		"",
+		"gbk",
+		"gbk_abcd1234",
+		"gbk_abcd1234_",
+		"xyz_abcd1234_secret",
+		"gbk_short_secret",
+	} {
+		_, err := ParseAPIKeyPrefix(key)
+		require.ErrorIs(t, err, ErrInvalidAPIKey, key)
+	}
+}
@@ -0,0 +1,83 @@
+package token
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Ed25519JWTMaker issues EdDSA-signed JWTs with the signing key id in the
+// "kid" header, so verifiers can pick the right key from the JWKS.
+type Ed25519JWTMaker struct {
+	keys *KeySet
+}
+
+func NewEd25519JWTMaker(keys *KeySet) (Maker, error) {
+	if _, err := keys.ActiveKey(); err != nil {
+		return nil, err
+	}
+	return &Ed25519JWTMaker{keys: keys}, nil
+}
+
+func (maker *Ed25519JWTMaker) CreateToken(username string, tokenType TokenType, duration time.Duration, opts ...PayloadOption) (string, *Payload, error) {
+	payload, err := NewPayload(username, tokenType, duration, opts...)
+	if err != nil {
+		return "", nil, err
+	}
+
+	key, err := maker.keys.ActiveKey()
+	if err != nil {
+		return "", nil, err
+	}
+
+	claims := newJWTClaims(payload)
+
+	jwtToken := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+	jwtToken.Header["kid"] = key.ID
+
+	tokenStr, err := jwtToken.SignedString(key.PrivateKey)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return tokenStr, payload, nil
+}
+
+func (maker *Ed25519JWTMaker) VerifyToken(token string, tokenType TokenType) (*Payload, error) {
+	keyFunc := func(t *jwt.Token) (any, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodEd25519); !ok {
+			return nil, ErrInvalidToken
+		}
+		kid, ok := t.Header["kid"].(string)
+		if !ok {
+			return nil, ErrInvalidToken
+		}
+		return maker.keys.PublicKey(kid)
+	}
+
+	jwtToken, err := jwt.ParseWithClaims(token, &jwtClaims{}, keyFunc)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, ErrExpiredToken
+		}
+		return nil, ErrInvalidToken
+	}
+
+	claims, ok := jwtToken.Claims.(*jwtClaims)
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+
+	payload := claims.toPayload()
+	if err := payload.checkType(tokenType); err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+// JWKS publishes the public keys trusted by this maker.
+func (maker *Ed25519JWTMaker) JWKS() JSONWebKeySet {
+	return maker.keys.JWKS()
+}
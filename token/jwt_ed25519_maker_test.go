@@ -0,0 +1,129 @@
+package token
+
+import (
+	"testing"
+	"time"
+
+	"github.com/a7medalyapany/GoBank.git/util"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEd25519JWTMaker(t *testing.T) {
+	maker, err := NewEd25519JWTMaker(newTestKeySet(t, "k1"))
+	require.NoError(t, err)
+
+	username := util.RandomOwner()
+	duration := time.Minute
+
+	issuedAt := time.Now()
+	expiredAt := issuedAt.Add(duration)
+
+	token, payload, err := maker.CreateToken(username, TokenTypeAccess, duration)
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+	require.NotNil(t, payload)
+
+	require.NotZero(t, payload.ID)
+	require.Equal(t, username, payload.Username)
+	require.WithinDuration(t, issuedAt, payload.IssuedAt, time.Second)
+	require.WithinDuration(t, expiredAt, payload.ExpiredAt, time.Second)
+
+	verified, err := maker.VerifyToken(token, TokenTypeAccess)
+	require.NoError(t, err)
+	require.NotNil(t, verified)
+
+	require.Equal(t, payload.ID, verified.ID)
+	require.Equal(t, payload.Username, verified.Username)
+}
+
+func TestExpiredEd25519JWTToken(t *testing.T) {
+	maker, err := NewEd25519JWTMaker(newTestKeySet(t, "k1"))
+	require.NoError(t, err)
+
+	token, _, err := maker.CreateToken(util.RandomOwner(), TokenTypeAccess, -time.Minute)
+	require.NoError(t, err)
+
+	verified, err := maker.VerifyToken(token, TokenTypeAccess)
+	require.Error(t, err)
+	require.EqualError(t, err, ErrExpiredToken.Error())
+	require.Nil(t, verified)
+}
+
+func TestEd25519JWTRejectsHMACToken(t *testing.T) {
+	// An attacker who knows the public key must not be able to forge an
+	// HS256 token using it as the HMAC secret.
+	keys := newTestKeySet(t, "k1")
+	maker, err := NewEd25519JWTMaker(keys)
+	require.NoError(t, err)
+
+	payload, err := NewPayload(util.RandomOwner(), TokenTypeAccess, time.Minute)
+	require.NoError(t, err)
+
+	publicKey, err := keys.PublicKey("k1")
+	require.NoError(t, err)
+
+	jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, &jwtClaims{
+		ID:       payload.ID,
+		Username: payload.Username,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(payload.ExpiredAt),
+		},
+	})
+	jwtToken.Header["kid"] = "k1"
+	token, err := jwtToken.SignedString([]byte(publicKey))
+	require.NoError(t, err)
+
+	verified, err := maker.VerifyToken(token, TokenTypeAccess)
+	require.Error(t, err)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+	require.Nil(t, verified)
+}
+
+func TestEd25519JWTVerifyTokenWithUnknownKey(t *testing.T) {
+	maker1, err := NewEd25519JWTMaker(newTestKeySet(t, "k1"))
+	require.NoError(t, err)
+
+	maker2, err := NewEd25519JWTMaker(newTestKeySet(t, "k2"))
+	require.NoError(t, err)
+
+	token, _, err := maker1.CreateToken(util.RandomOwner(), TokenTypeAccess, time.Minute)
+	require.NoError(t, err)
+
+	verified, err := maker2.VerifyToken(token, TokenTypeAccess)
+	require.Error(t, err)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+	require.Nil(t, verified)
+}
+
+func TestEd25519JWTVerifyTokenWithWrongType(t *testing.T) {
+	maker, err := NewEd25519JWTMaker(newTestKeySet(t, "k1"))
+	require.NoError(t, err)
+
+	refreshToken, _, err := maker.CreateToken(util.RandomOwner(), TokenTypeRefresh, time.Minute)
+	require.NoError(t, err)
+
+	verified, err := maker.VerifyToken(refreshToken, TokenTypeAccess)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+	require.Nil(t, verified)
+
+	verified, err = maker.VerifyToken(refreshToken, TokenTypeRefresh)
+	require.NoError(t, err)
+	require.Equal(t, TokenTypeRefresh, verified.Type)
+}
+
+func TestEd25519JWTTokenScopesAndAudience(t *testing.T) {
+	maker, err := NewEd25519JWTMaker(newTestKeySet(t, "k1"))
+	require.NoError(t, err)
+
+	token, _, err := maker.CreateToken(util.RandomOwner(), TokenTypeAccess, time.Minute,
+		WithScopes(ScopeAccountsRead), WithAudience("partner"))
+	require.NoError(t, err)
+
+	verified, err := maker.VerifyToken(token, TokenTypeAccess)
+	require.NoError(t, err)
+	require.Equal(t, "partner", verified.Audience)
+	require.Equal(t, []string{ScopeAccountsRead}, verified.Scopes)
+	require.True(t, verified.HasScope(ScopeAccountsRead))
+	require.False(t, verified.HasScope(ScopeTransfersWrite))
+}
@@ -3,6 +3,7 @@ package token
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -14,17 +15,41 @@ const minSecretKeySize = 32
 // jwtClaims is a private adapter — keeps jwt concerns out of Payload
 type jwtClaims struct {
 	ID       uuid.UUID `json:"id"`
+	Type     TokenType `json:"typ"`
 	Username string    `json:"username"`
+	Scope    string    `json:"scope"`
 	jwt.RegisteredClaims
 }
 
+func newJWTClaims(payload *Payload) *jwtClaims {
+	return &jwtClaims{
+		ID:       payload.ID,
+		Type:     payload.Type,
+		Username: payload.Username,
+		Scope:    strings.Join(payload.Scopes, " "),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        payload.ID.String(),
+			Subject:   payload.Username,
+			Audience:  jwt.ClaimStrings{payload.Audience},
+			IssuedAt:  jwt.NewNumericDate(payload.IssuedAt),
+			ExpiresAt: jwt.NewNumericDate(payload.ExpiredAt),
+		},
+	}
+}
+
 func (c *jwtClaims) toPayload() *Payload {
-	return &Payload{
+	payload := &Payload{
 		ID:        c.ID,
+		Type:      c.Type,
 		Username:  c.Username,
+		Scopes:    strings.Fields(c.Scope),
 		IssuedAt:  c.RegisteredClaims.IssuedAt.Time,
 		ExpiredAt: c.RegisteredClaims.ExpiresAt.Time,
 	}
+	if len(c.Audience) > 0 {
+		payload.Audience = c.Audience[0]
+	}
+	return payload
 }
 
 type JWTMaker struct {
@@ -38,22 +63,13 @@ func NewJWTMaker(secretKey string) (Maker, error) {
 	return &JWTMaker{secretKey: secretKey}, nil
 }
 
-func (maker *JWTMaker) CreateToken(username string, duration time.Duration) (string, *Payload, error) {
-	payload, err := NewPayload(username, duration)
+func (maker *JWTMaker) CreateToken(username string, tokenType TokenType, duration time.Duration, opts ...PayloadOption) (string, *Payload, error) {
+	payload, err := NewPayload(username, tokenType, duration, opts...)
 	if err != nil {
 		return "", nil, err
 	}
 
-	claims := &jwtClaims{
-		ID:       payload.ID,
-		Username: payload.Username,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ID:        payload.ID.String(),
-			Subject:   payload.Username,
-			IssuedAt:  jwt.NewNumericDate(payload.IssuedAt),
-			ExpiresAt: jwt.NewNumericDate(payload.ExpiredAt),
-		},
-	}
+	claims := newJWTClaims(payload)
 
 	jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	tokenStr, err := jwtToken.SignedString([]byte(maker.secretKey))
@@ -64,7 +80,7 @@ func (maker *JWTMaker) CreateToken(username string, duration time.Duration) (str
 	return tokenStr, payload, nil
 }
 
-func (maker *JWTMaker) VerifyToken(token string) (*Payload, error) {
+func (maker *JWTMaker) VerifyToken(token string, tokenType TokenType) (*Payload, error) {
 	keyFunc := func(t *jwt.Token) (any, error) {
 		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, ErrInvalidToken
@@ -85,5 +101,10 @@ func (maker *JWTMaker) VerifyToken(token string) (*Payload, error) {
 		return nil, ErrInvalidToken
 	}
 
-	return claims.toPayload(), nil
+	payload := claims.toPayload()
+	if err := payload.checkType(tokenType); err != nil {
+		return nil, err
+	}
+
+	return payload, nil
 }
\ No newline at end of file
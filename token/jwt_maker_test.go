@@ -19,7 +19,7 @@ func TestJWTMaker(t *testing.T) {
 	issuedAt := time.Now()
 	expiredAt := issuedAt.Add(duration)
 
-	token, payload, err := maker.CreateToken(username, duration)
+	token, payload, err := maker.CreateToken(username, TokenTypeAccess, duration)
 	require.NoError(t, err)
 	require.NotEmpty(t, token)
 	require.NotNil(t, payload)
@@ -29,7 +29,7 @@ func TestJWTMaker(t *testing.T) {
 	require.WithinDuration(t, issuedAt, payload.IssuedAt, time.Second)
 	require.WithinDuration(t, expiredAt, payload.ExpiredAt, time.Second)
 
-	verified, err := maker.VerifyToken(token)
+	verified, err := maker.VerifyToken(token, TokenTypeAccess)
 	require.NoError(t, err)
 	require.NotNil(t, verified)
 
@@ -43,19 +43,19 @@ func TestExpiredJWTToken(t *testing.T) {
 	maker, err := NewJWTMaker(util.RandomString(32))
 	require.NoError(t, err)
 
-	token, payload, err := maker.CreateToken(util.RandomOwner(), -time.Minute)
+	token, payload, err := maker.CreateToken(util.RandomOwner(), TokenTypeAccess, -time.Minute)
 	require.NoError(t, err)
 	require.NotEmpty(t, token)
 	require.NotNil(t, payload)
 
-	verified, err := maker.VerifyToken(token)
+	verified, err := maker.VerifyToken(token, TokenTypeAccess)
 	require.Error(t, err)
 	require.EqualError(t, err, ErrExpiredToken.Error())
 	require.Nil(t, verified)
 }
 
 func TestInvalidJWTTokenAlgNone(t *testing.T) {
-	payload, err := NewPayload(util.RandomOwner(), time.Minute)
+	payload, err := NewPayload(util.RandomOwner(), TokenTypeAccess, time.Minute)
 	require.NoError(t, err)
 
 	// Manually craft a token with alg:none to simulate an attack
@@ -77,7 +77,7 @@ func TestInvalidJWTTokenAlgNone(t *testing.T) {
 	maker, err := NewJWTMaker(util.RandomString(32))
 	require.NoError(t, err)
 
-	verified, err := maker.VerifyToken(token)
+	verified, err := maker.VerifyToken(token, TokenTypeAccess)
 	require.Error(t, err)
 	require.EqualError(t, err, ErrInvalidToken.Error())
 	require.Nil(t, verified)
@@ -93,14 +93,46 @@ func TestJWTVerifyTokenWithTamperedPayload(t *testing.T) {
 	maker, err := NewJWTMaker(util.RandomString(32))
 	require.NoError(t, err)
 
-	token, _, err := maker.CreateToken(util.RandomOwner(), time.Minute)
+	token, _, err := maker.CreateToken(util.RandomOwner(), TokenTypeAccess, time.Minute)
 	require.NoError(t, err)
 
 	// Tamper the token by flipping a character in the signature segment
 	tampered := token[:len(token)-4] + "xxxx"
 
-	verified, err := maker.VerifyToken(tampered)
+	verified, err := maker.VerifyToken(tampered, TokenTypeAccess)
 	require.Error(t, err)
 	require.EqualError(t, err, ErrInvalidToken.Error())
 	require.Nil(t, verified)
-}
\ No newline at end of file
+}
+
+func TestJWTVerifyTokenWithWrongType(t *testing.T) {
+	maker, err := NewJWTMaker(util.RandomString(32))
+	require.NoError(t, err)
+
+	refreshToken, _, err := maker.CreateToken(util.RandomOwner(), TokenTypeRefresh, time.Minute)
+	require.NoError(t, err)
+
+	verified, err := maker.VerifyToken(refreshToken, TokenTypeAccess)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+	require.Nil(t, verified)
+
+	verified, err = maker.VerifyToken(refreshToken, TokenTypeRefresh)
+	require.NoError(t, err)
+	require.Equal(t, TokenTypeRefresh, verified.Type)
+}
+
+func TestJWTTokenScopesAndAudience(t *testing.T) {
+	maker, err := NewJWTMaker(util.RandomString(32))
+	require.NoError(t, err)
+
+	token, _, err := maker.CreateToken(util.RandomOwner(), TokenTypeAccess, time.Minute,
+		WithScopes(ScopeAccountsRead), WithAudience("partner"))
+	require.NoError(t, err)
+
+	verified, err := maker.VerifyToken(token, TokenTypeAccess)
+	require.NoError(t, err)
+	require.Equal(t, "partner", verified.Audience)
+	require.Equal(t, []string{ScopeAccountsRead}, verified.Scopes)
+	require.True(t, verified.HasScope(ScopeAccountsRead))
+	require.False(t, verified.HasScope(ScopeTransfersWrite))
+}
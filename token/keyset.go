@@ -0,0 +1,215 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrUnknownKeyID = errors.New("unknown key id")
+	ErrNoSigningKey = errors.New("no active signing key")
+)
+
+// KeyPair is a single Ed25519 signing key identified by a key ID (kid).
+// PrivateKey may be nil for verification-only keys.
+type KeyPair struct {
+	ID         string
+	PrivateKey ed25519.PrivateKey
+	PublicKey  ed25519.PublicKey
+}
+
+// GenerateKeyPair creates a new random Ed25519 key pair with the given id.
+func GenerateKeyPair(id string) (KeyPair, error) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return KeyPair{}, err
+	}
+	return KeyPair{ID: id, PrivateKey: priv, PublicKey: pub}, nil
+}
+
+// KeySet holds every key that is currently trusted for verification and
+// the single key that is used to sign new tokens. It is built once from
+// config and never changes, so rotation happens on restart:
+//  1. Add the new key and make it active; keep the previous key, by its
+//     public key only, so tokens it signed still verify.
+//  2. Once the longest-lived token signed by the old key has expired
+//     (REFRESH_TOKEN_DURATION), drop it from the config.
+type KeySet struct {
+	keys     map[string]KeyPair
+	order    []string
+	activeID string
+}
+
+// NewKeySet creates a KeySet that signs with active and additionally trusts
+// the verifyOnly keys.
+func NewKeySet(active KeyPair, verifyOnly ...KeyPair) (*KeySet, error) {
+	ks := &KeySet{keys: make(map[string]KeyPair)}
+
+	if err := ks.add(active); err != nil {
+		return nil, err
+	}
+	if len(active.PrivateKey) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("active key %q has no private key", active.ID)
+	}
+	ks.activeID = active.ID
+
+	for _, key := range verifyOnly {
+		if err := ks.add(key); err != nil {
+			return nil, err
+		}
+	}
+	return ks, nil
+}
+
+// ParseKeySet builds a KeySet from config. signing is a comma-separated list
+// of "kid:base64(ed25519 seed)" entries, as stored in TOKEN_SIGNING_KEYS, and
+// verify a list of "kid:base64(ed25519 public key)" entries, as stored in
+// TOKEN_VERIFY_KEYS. activeID selects the signing key; every other entry is
+// verification-only, so retired keys need not keep their private seed.
+func ParseKeySet(signing string, verify string, activeID string) (*KeySet, error) {
+	var active *KeyPair
+	var others []KeyPair
+
+	err := parseKeyEntries(signing, "seed", ed25519.SeedSize, func(id string, seed []byte) error {
+		priv := ed25519.NewKeyFromSeed(seed)
+		key := KeyPair{ID: id, PrivateKey: priv, PublicKey: priv.Public().(ed25519.PublicKey)}
+
+		if id == activeID {
+			if active != nil {
+				return fmt.Errorf("duplicate key id %q", id)
+			}
+			active = &key
+			return nil
+		}
+		others = append(others, key)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = parseKeyEntries(verify, "public key", ed25519.PublicKeySize, func(id string, pub []byte) error {
+		if id == activeID {
+			return fmt.Errorf("active key %q has no private key", id)
+		}
+		others = append(others, KeyPair{ID: id, PublicKey: ed25519.PublicKey(pub)})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if active == nil {
+		return nil, fmt.Errorf("active key id %q not found in signing keys", activeID)
+	}
+
+	return NewKeySet(*active, others...)
+}
+
+// parseKeyEntries decodes a comma-separated list of "kid:base64" entries,
+// each of which must hold exactly size bytes, and hands them to fn.
+func parseKeyEntries(spec string, kind string, size int, fn func(id string, key []byte) error) error {
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		id, encoded, ok := strings.Cut(entry, ":")
+		if !ok || id == "" {
+			return fmt.Errorf("invalid key entry: expected kid:base64-%s", strings.ReplaceAll(kind, " ", "-"))
+		}
+
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return fmt.Errorf("invalid key %q: %w", id, err)
+		}
+		if len(key) != size {
+			return fmt.Errorf("invalid key %q: %s must be exactly %d bytes", id, kind, size)
+		}
+
+		if err := fn(id, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ks *KeySet) add(key KeyPair) error {
+	if key.ID == "" {
+		return fmt.Errorf("key id cannot be empty")
+	}
+	if key.PublicKey == nil && key.PrivateKey != nil {
+		key.PublicKey = key.PrivateKey.Public().(ed25519.PublicKey)
+	}
+	if len(key.PublicKey) != ed25519.PublicKeySize {
+		return fmt.Errorf("key %q: invalid public key size", key.ID)
+	}
+	if _, exists := ks.keys[key.ID]; exists {
+		return fmt.Errorf("duplicate key id %q", key.ID)
+	}
+
+	ks.keys[key.ID] = key
+	ks.order = append(ks.order, key.ID)
+	return nil
+}
+
+// ActiveKey returns the key used to sign new tokens.
+func (ks *KeySet) ActiveKey() (KeyPair, error) {
+	key, ok := ks.keys[ks.activeID]
+	if !ok {
+		return KeyPair{}, ErrNoSigningKey
+	}
+	return key, nil
+}
+
+// PublicKey returns the trusted public key for id.
+func (ks *KeySet) PublicKey(id string) (ed25519.PublicKey, error) {
+	key, ok := ks.keys[id]
+	if !ok {
+		return nil, ErrUnknownKeyID
+	}
+	return key.PublicKey, nil
+}
+
+// JSONWebKey is the public half of an Ed25519 key in JWK (RFC 8037) form.
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	Curve     string `json:"crv"`
+	X         string `json:"x"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+}
+
+// JSONWebKeySet is the document served at /.well-known/jwks.json.
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// JWKS returns every trusted public key so other services can verify tokens
+// without holding any signing secret.
+func (ks *KeySet) JWKS() JSONWebKeySet {
+	set := JSONWebKeySet{Keys: make([]JSONWebKey, 0, len(ks.order))}
+	for _, id := range ks.order {
+		set.Keys = append(set.Keys, JSONWebKey{
+			KeyType:   "OKP",
+			Curve:     "Ed25519",
+			X:         base64.RawURLEncoding.EncodeToString(ks.keys[id].PublicKey),
+			KeyID:     id,
+			Use:       "sig",
+			Algorithm: "EdDSA",
+		})
+	}
+	return set
+}
+
+// PublicKeyProvider is implemented by asymmetric makers that can publish
+// their verification keys.
+type PublicKeyProvider interface {
+	JWKS() JSONWebKeySet
+}
@@ -0,0 +1,101 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func randomSeed(t *testing.T) string {
+	key, err := GenerateKeyPair("tmp")
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(key.PrivateKey.Seed())
+}
+
+func randomPublicKey(t *testing.T) string {
+	key, err := GenerateKeyPair("tmp")
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(key.PublicKey)
+}
+
+func TestParseKeySet(t *testing.T) {
+	spec := "2025-01:" + randomSeed(t) + ", 2025-06:" + randomSeed(t)
+
+	retired, err := GenerateKeyPair("2024-06")
+	require.NoError(t, err)
+	verify := "2024-06:" + base64.StdEncoding.EncodeToString(retired.PublicKey)
+
+	keys, err := ParseKeySet(spec, verify, "2025-06")
+	require.NoError(t, err)
+
+	active, err := keys.ActiveKey()
+	require.NoError(t, err)
+	require.Equal(t, "2025-06", active.ID)
+
+	_, err = keys.PublicKey("2025-01")
+	require.NoError(t, err)
+
+	// A retired key is trusted from its public key alone.
+	publicKey, err := keys.PublicKey("2024-06")
+	require.NoError(t, err)
+	require.Equal(t, retired.PublicKey, publicKey)
+
+	_, err = keys.PublicKey("missing")
+	require.ErrorIs(t, err, ErrUnknownKeyID)
+}
+
+func TestParseKeySetErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		spec     string
+		verify   string
+		activeID string
+	}{
+		{"missing active key", "a:" + randomSeed(t), "", "b"},
+		{"missing separator", randomSeed(t), "", "a"},
+		{"invalid base64", "a:not-base64!", "", "a"},
+		{"short seed", "a:" + base64.StdEncoding.EncodeToString([]byte("short")), "", "a"},
+		{"duplicate id", "a:" + randomSeed(t) + ",a:" + randomSeed(t), "", "a"},
+		{"short public key", "a:" + randomSeed(t), "b:" + base64.StdEncoding.EncodeToString([]byte("short")), "a"},
+		{"duplicate verify id", "a:" + randomSeed(t), "a:" + randomPublicKey(t), "a"},
+		{"active key public only", "", "a:" + randomPublicKey(t), "a"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			keys, err := ParseKeySet(tc.spec, tc.verify, tc.activeID)
+			require.Error(t, err)
+			require.Nil(t, keys)
+		})
+	}
+}
+
+func TestKeySetJWKS(t *testing.T) {
+	active, err := GenerateKeyPair("k1")
+	require.NoError(t, err)
+	retired, err := GenerateKeyPair("k2")
+	require.NoError(t, err)
+
+	keys, err := NewKeySet(active, KeyPair{ID: retired.ID, PublicKey: retired.PublicKey})
+	require.NoError(t, err)
+
+	jwks := keys.JWKS()
+	require.Len(t, jwks.Keys, 2)
+
+	for _, jwk := range jwks.Keys {
+		require.Equal(t, "OKP", jwk.KeyType)
+		require.Equal(t, "Ed25519", jwk.Curve)
+		require.Equal(t, "EdDSA", jwk.Algorithm)
+		require.False(t, strings.ContainsAny(jwk.X, "+/="))
+
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		require.NoError(t, err)
+
+		publicKey, err := keys.PublicKey(jwk.KeyID)
+		require.NoError(t, err)
+		require.Equal(t, ed25519.PublicKey(x), publicKey)
+	}
+}
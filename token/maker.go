@@ -4,9 +4,9 @@ import "time"
 
 type Maker interface {
 
-	// CreateToken creates a new token for a specific username and duration. It returns the token string and the payload data.
-	CreateToken(username string, duration time.Duration) (string, *Payload, error)
+	// CreateToken creates a new token of the given type for a specific username and duration. It returns the token string and the payload data.
+	CreateToken(username string, tokenType TokenType, duration time.Duration, opts ...PayloadOption) (string, *Payload, error)
 
-	// VerifyToken checks if the token is valid or not. It returns the token payload if the token is valid.
-	VerifyToken(token string) (*Payload, error)
-}
\ No newline at end of file
+	// VerifyToken checks if the token is valid and of the expected type. It returns the token payload if the token is valid.
+	VerifyToken(token string, tokenType TokenType) (*Payload, error)
+}
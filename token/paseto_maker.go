@@ -25,9 +25,9 @@ func NewPasetoMaker(symmetricKey string) (Maker, error) {
 	}, nil
 }
 
-// CreateToken creates a new token for a specific username and duration.
-func (maker *PasetoMaker) CreateToken(username string, duration time.Duration) (string, *Payload, error) {
-	payload, err := NewPayload(username, duration)
+// CreateToken creates a new token of the given type for a specific username and duration.
+func (maker *PasetoMaker) CreateToken(username string, tokenType TokenType, duration time.Duration, opts ...PayloadOption) (string, *Payload, error) {
+	payload, err := NewPayload(username, tokenType, duration, opts...)
 	if err != nil {
 		return "", nil, err
 	}
@@ -40,8 +40,8 @@ func (maker *PasetoMaker) CreateToken(username string, duration time.Duration) (
 	return tokenStr, payload, nil
 }
 
-// VerifyToken checks if the token is valid or not. It returns the token payload if the token is valid.
-func (maker *PasetoMaker) VerifyToken(token string) (*Payload, error) {
+// VerifyToken checks if the token is valid and of the expected type. It returns the token payload if the token is valid.
+func (maker *PasetoMaker) VerifyToken(token string, tokenType TokenType) (*Payload, error) {
 	payload := &Payload{}
 
 	err := maker.paseto.Decrypt(token, maker.symmetricKey, payload, nil)
@@ -54,5 +54,9 @@ func (maker *PasetoMaker) VerifyToken(token string) (*Payload, error) {
 		return nil, err
 	}
 
+	if err = payload.checkType(tokenType); err != nil {
+		return nil, err
+	}
+
 	return payload, nil
 }
\ No newline at end of file
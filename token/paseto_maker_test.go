@@ -18,7 +18,7 @@ func TestPasetoMaker(t *testing.T) {
 	issuedAt := time.Now()
 	expiredAt := issuedAt.Add(duration)
 
-	token, payload, err := maker.CreateToken(username, duration)
+	token, payload, err := maker.CreateToken(username, TokenTypeAccess, duration)
 	require.NoError(t, err)
 	require.NotEmpty(t, token)
 	require.NotNil(t, payload)
@@ -28,7 +28,7 @@ func TestPasetoMaker(t *testing.T) {
 	require.WithinDuration(t, issuedAt, payload.IssuedAt, time.Second)
 	require.WithinDuration(t, expiredAt, payload.ExpiredAt, time.Second)
 
-	verified, err := maker.VerifyToken(token)
+	verified, err := maker.VerifyToken(token, TokenTypeAccess)
 	require.NoError(t, err)
 	require.NotNil(t, verified)
 
@@ -42,12 +42,12 @@ func TestExpiredPasetoToken(t *testing.T) {
 	maker, err := NewPasetoMaker(util.RandomString(32))
 	require.NoError(t, err)
 
-	token, payload, err := maker.CreateToken(util.RandomOwner(), -time.Minute)
+	token, payload, err := maker.CreateToken(util.RandomOwner(), TokenTypeAccess, -time.Minute)
 	require.NoError(t, err)
 	require.NotEmpty(t, token)
 	require.NotNil(t, payload)
 
-	verified, err := maker.VerifyToken(token)
+	verified, err := maker.VerifyToken(token, TokenTypeAccess)
 	require.Error(t, err)
 	require.EqualError(t, err, ErrExpiredToken.Error())
 	require.Nil(t, verified)
@@ -70,13 +70,13 @@ func TestPasetoVerifyTokenWithTamperedToken(t *testing.T) {
 	maker, err := NewPasetoMaker(util.RandomString(32))
 	require.NoError(t, err)
 
-	token, _, err := maker.CreateToken(util.RandomOwner(), time.Minute)
+	token, _, err := maker.CreateToken(util.RandomOwner(), TokenTypeAccess, time.Minute)
 	require.NoError(t, err)
 
 	// Tamper the token — PASETO's authenticated encryption will reject this
 	tampered := token[:len(token)-4] + "xxxx"
 
-	verified, err := maker.VerifyToken(tampered)
+	verified, err := maker.VerifyToken(tampered, TokenTypeAccess)
 	require.Error(t, err)
 	require.EqualError(t, err, ErrInvalidToken.Error())
 	require.Nil(t, verified)
@@ -89,12 +89,44 @@ func TestPasetoVerifyTokenWithWrongKey(t *testing.T) {
 	maker2, err := NewPasetoMaker(util.RandomString(32))
 	require.NoError(t, err)
 
-	token, _, err := maker1.CreateToken(util.RandomOwner(), time.Minute)
+	token, _, err := maker1.CreateToken(util.RandomOwner(), TokenTypeAccess, time.Minute)
 	require.NoError(t, err)
 
 	// maker2 has a different key — decryption must fail
-	verified, err := maker2.VerifyToken(token)
+	verified, err := maker2.VerifyToken(token, TokenTypeAccess)
 	require.Error(t, err)
 	require.EqualError(t, err, ErrInvalidToken.Error())
 	require.Nil(t, verified)
-}
\ No newline at end of file
+}
+
+func TestPasetoVerifyTokenWithWrongType(t *testing.T) {
+	maker, err := NewPasetoMaker(util.RandomString(32))
+	require.NoError(t, err)
+
+	refreshToken, _, err := maker.CreateToken(util.RandomOwner(), TokenTypeRefresh, time.Minute)
+	require.NoError(t, err)
+
+	verified, err := maker.VerifyToken(refreshToken, TokenTypeAccess)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+	require.Nil(t, verified)
+
+	verified, err = maker.VerifyToken(refreshToken, TokenTypeRefresh)
+	require.NoError(t, err)
+	require.Equal(t, TokenTypeRefresh, verified.Type)
+}
+
+func TestPasetoTokenScopesAndAudience(t *testing.T) {
+	maker, err := NewPasetoMaker(util.RandomString(32))
+	require.NoError(t, err)
+
+	token, _, err := maker.CreateToken(util.RandomOwner(), TokenTypeAccess, time.Minute,
+		WithScopes(ScopeAccountsRead), WithAudience("partner"))
+	require.NoError(t, err)
+
+	verified, err := maker.VerifyToken(token, TokenTypeAccess)
+	require.NoError(t, err)
+	require.Equal(t, "partner", verified.Audience)
+	require.Equal(t, []string{ScopeAccountsRead}, verified.Scopes)
+	require.True(t, verified.HasScope(ScopeAccountsRead))
+	require.False(t, verified.HasScope(ScopeTransfersWrite))
+}
@@ -0,0 +1,140 @@
+package token
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+const pasetoV4PublicHeader = "v4.public."
+
+// pasetoFooter is the unencrypted footer of every v4.public token.
+// It carries the key id so the verifier knows which public key to use.
+type pasetoFooter struct {
+	KeyID string `json:"kid"`
+}
+
+// PasetoPublicMaker issues PASETO v4.public tokens signed with Ed25519.
+// Any service holding the public keys (see JWKS) can verify tokens, only
+// this maker can create them.
+type PasetoPublicMaker struct {
+	keys *KeySet
+}
+
+func NewPasetoPublicMaker(keys *KeySet) (Maker, error) {
+	if _, err := keys.ActiveKey(); err != nil {
+		return nil, err
+	}
+	return &PasetoPublicMaker{keys: keys}, nil
+}
+
+// CreateToken creates a new token of the given type for a specific username and duration.
+func (maker *PasetoPublicMaker) CreateToken(username string, tokenType TokenType, duration time.Duration, opts ...PayloadOption) (string, *Payload, error) {
+	payload, err := NewPayload(username, tokenType, duration, opts...)
+	if err != nil {
+		return "", nil, err
+	}
+
+	key, err := maker.keys.ActiveKey()
+	if err != nil {
+		return "", nil, err
+	}
+
+	message, err := json.Marshal(payload)
+	if err != nil {
+		return "", nil, err
+	}
+
+	footer, err := json.Marshal(pasetoFooter{KeyID: key.ID})
+	if err != nil {
+		return "", nil, err
+	}
+
+	sig := ed25519.Sign(key.PrivateKey, pae([]byte(pasetoV4PublicHeader), message, footer, nil))
+
+	body := append(message, sig...)
+	tokenStr := pasetoV4PublicHeader +
+		base64.RawURLEncoding.EncodeToString(body) + "." +
+		base64.RawURLEncoding.EncodeToString(footer)
+
+	return tokenStr, payload, nil
+}
+
+// VerifyToken checks if the token is valid and of the expected type. It returns the token payload if the token is valid.
+func (maker *PasetoPublicMaker) VerifyToken(token string, tokenType TokenType) (*Payload, error) {
+	if !strings.HasPrefix(token, pasetoV4PublicHeader) {
+		return nil, ErrInvalidToken
+	}
+
+	encodedBody, encodedFooter, ok := strings.Cut(strings.TrimPrefix(token, pasetoV4PublicHeader), ".")
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+
+	body, err := base64.RawURLEncoding.DecodeString(encodedBody)
+	if err != nil || len(body) < ed25519.SignatureSize {
+		return nil, ErrInvalidToken
+	}
+
+	footer, err := base64.RawURLEncoding.DecodeString(encodedFooter)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	var f pasetoFooter
+	if err := json.Unmarshal(footer, &f); err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	publicKey, err := maker.keys.PublicKey(f.KeyID)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	message := body[:len(body)-ed25519.SignatureSize]
+	sig := body[len(body)-ed25519.SignatureSize:]
+	if !ed25519.Verify(publicKey, pae([]byte(pasetoV4PublicHeader), message, footer, nil), sig) {
+		return nil, ErrInvalidToken
+	}
+
+	payload := &Payload{}
+	if err := json.Unmarshal(message, payload); err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	if err := payload.Valid(); err != nil {
+		return nil, err
+	}
+
+	if err := payload.checkType(tokenType); err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+// JWKS publishes the public keys trusted by this maker.
+func (maker *PasetoPublicMaker) JWKS() JSONWebKeySet {
+	return maker.keys.JWKS()
+}
+
+// pae implements PASETO Pre-Authentication Encoding.
+func pae(pieces ...[]byte) []byte {
+	var buf bytes.Buffer
+	le64 := func(n uint64) {
+		var b [8]byte
+		binary.LittleEndian.PutUint64(b[:], n&^(1<<63))
+		buf.Write(b[:])
+	}
+
+	le64(uint64(len(pieces)))
+	for _, p := range pieces {
+		le64(uint64(len(p)))
+		buf.Write(p)
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,174 @@
+package token
+
+import (
+	"testing"
+	"time"
+
+	"github.com/a7medalyapany/GoBank.git/util"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKeySet(t *testing.T, id string) *KeySet {
+	key, err := GenerateKeyPair(id)
+	require.NoError(t, err)
+
+	keys, err := NewKeySet(key)
+	require.NoError(t, err)
+	return keys
+}
+
+func TestPasetoPublicMaker(t *testing.T) {
+	maker, err := NewPasetoPublicMaker(newTestKeySet(t, "k1"))
+	require.NoError(t, err)
+
+	username := util.RandomOwner()
+	duration := time.Minute
+
+	issuedAt := time.Now()
+	expiredAt := issuedAt.Add(duration)
+
+	token, payload, err := maker.CreateToken(username, TokenTypeAccess, duration)
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+	require.NotNil(t, payload)
+	require.Contains(t, token, pasetoV4PublicHeader)
+
+	require.NotZero(t, payload.ID)
+	require.Equal(t, username, payload.Username)
+	require.WithinDuration(t, issuedAt, payload.IssuedAt, time.Second)
+	require.WithinDuration(t, expiredAt, payload.ExpiredAt, time.Second)
+
+	verified, err := maker.VerifyToken(token, TokenTypeAccess)
+	require.NoError(t, err)
+	require.NotNil(t, verified)
+
+	require.Equal(t, payload.ID, verified.ID)
+	require.Equal(t, payload.Username, verified.Username)
+	require.WithinDuration(t, payload.IssuedAt, verified.IssuedAt, time.Second)
+	require.WithinDuration(t, payload.ExpiredAt, verified.ExpiredAt, time.Second)
+}
+
+func TestExpiredPasetoPublicToken(t *testing.T) {
+	maker, err := NewPasetoPublicMaker(newTestKeySet(t, "k1"))
+	require.NoError(t, err)
+
+	token, _, err := maker.CreateToken(util.RandomOwner(), TokenTypeAccess, -time.Minute)
+	require.NoError(t, err)
+
+	verified, err := maker.VerifyToken(token, TokenTypeAccess)
+	require.Error(t, err)
+	require.EqualError(t, err, ErrExpiredToken.Error())
+	require.Nil(t, verified)
+}
+
+func TestPasetoPublicVerifyTokenWithTamperedToken(t *testing.T) {
+	maker, err := NewPasetoPublicMaker(newTestKeySet(t, "k1"))
+	require.NoError(t, err)
+
+	token, _, err := maker.CreateToken(util.RandomOwner(), TokenTypeAccess, time.Minute)
+	require.NoError(t, err)
+
+	// Flip a character inside the signed body, before the footer
+	idx := len(pasetoV4PublicHeader) + 10
+	tampered := token[:idx] + string(token[idx]^1) + token[idx+1:]
+
+	verified, err := maker.VerifyToken(tampered, TokenTypeAccess)
+	require.Error(t, err)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+	require.Nil(t, verified)
+}
+
+func TestPasetoPublicVerifyTokenWithUnknownKey(t *testing.T) {
+	maker1, err := NewPasetoPublicMaker(newTestKeySet(t, "k1"))
+	require.NoError(t, err)
+
+	// Same kid, different key material — signature check must fail
+	maker2, err := NewPasetoPublicMaker(newTestKeySet(t, "k1"))
+	require.NoError(t, err)
+
+	// Different kid — key lookup must fail
+	maker3, err := NewPasetoPublicMaker(newTestKeySet(t, "k2"))
+	require.NoError(t, err)
+
+	token, _, err := maker1.CreateToken(util.RandomOwner(), TokenTypeAccess, time.Minute)
+	require.NoError(t, err)
+
+	for _, maker := range []Maker{maker2, maker3} {
+		verified, err := maker.VerifyToken(token, TokenTypeAccess)
+		require.Error(t, err)
+		require.EqualError(t, err, ErrInvalidToken.Error())
+		require.Nil(t, verified)
+	}
+}
+
+func TestPasetoPublicKeyRotation(t *testing.T) {
+	oldKey, err := GenerateKeyPair("old")
+	require.NoError(t, err)
+	oldKeys, err := NewKeySet(oldKey)
+	require.NoError(t, err)
+	oldMaker, err := NewPasetoPublicMaker(oldKeys)
+	require.NoError(t, err)
+
+	oldToken, _, err := oldMaker.CreateToken(util.RandomOwner(), TokenTypeAccess, time.Minute)
+	require.NoError(t, err)
+
+	// Restart with a new active key, keeping only the old public key.
+	newKey, err := GenerateKeyPair("new")
+	require.NoError(t, err)
+	keys, err := NewKeySet(newKey, KeyPair{ID: oldKey.ID, PublicKey: oldKey.PublicKey})
+	require.NoError(t, err)
+	maker, err := NewPasetoPublicMaker(keys)
+	require.NoError(t, err)
+
+	newToken, _, err := maker.CreateToken(util.RandomOwner(), TokenTypeAccess, time.Minute)
+	require.NoError(t, err)
+
+	// Overlap window: both tokens verify
+	_, err = maker.VerifyToken(oldToken, TokenTypeAccess)
+	require.NoError(t, err)
+	_, err = maker.VerifyToken(newToken, TokenTypeAccess)
+	require.NoError(t, err)
+
+	// After dropping the old key only the new token verifies
+	keys, err = NewKeySet(newKey)
+	require.NoError(t, err)
+	maker, err = NewPasetoPublicMaker(keys)
+	require.NoError(t, err)
+
+	_, err = maker.VerifyToken(oldToken, TokenTypeAccess)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+	_, err = maker.VerifyToken(newToken, TokenTypeAccess)
+	require.NoError(t, err)
+}
+
+func TestPasetoPublicVerifyTokenWithWrongType(t *testing.T) {
+	maker, err := NewPasetoPublicMaker(newTestKeySet(t, "k1"))
+	require.NoError(t, err)
+
+	refreshToken, _, err := maker.CreateToken(util.RandomOwner(), TokenTypeRefresh, time.Minute)
+	require.NoError(t, err)
+
+	verified, err := maker.VerifyToken(refreshToken, TokenTypeAccess)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+	require.Nil(t, verified)
+
+	verified, err = maker.VerifyToken(refreshToken, TokenTypeRefresh)
+	require.NoError(t, err)
+	require.Equal(t, TokenTypeRefresh, verified.Type)
+}
+
+func TestPasetoPublicTokenScopesAndAudience(t *testing.T) {
+	maker, err := NewPasetoPublicMaker(newTestKeySet(t, "k1"))
+	require.NoError(t, err)
+
+	token, _, err := maker.CreateToken(util.RandomOwner(), TokenTypeAccess, time.Minute,
+		WithScopes(ScopeAccountsRead), WithAudience("partner"))
+	require.NoError(t, err)
+
+	verified, err := maker.VerifyToken(token, TokenTypeAccess)
+	require.NoError(t, err)
+	require.Equal(t, "partner", verified.Audience)
+	require.Equal(t, []string{ScopeAccountsRead}, verified.Scopes)
+	require.True(t, verified.HasScope(ScopeAccountsRead))
+	require.False(t, verified.HasScope(ScopeTransfersWrite))
+}
@@ -2,6 +2,7 @@ package token
 
 import (
 	"errors"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,26 +13,68 @@ var (
 	ErrInvalidToken = errors.New("token is invalid")
 )
 
+// TokenType distinguishes short-lived access tokens from refresh tokens so
+// one can never be presented in place of the other.
+type TokenType string
+
+const (
+	TokenTypeAccess  TokenType = "access"
+	TokenTypeRefresh TokenType = "refresh"
+)
+
+// AudienceGoBank is the default audience: tokens accepted by the GoBank API.
+const AudienceGoBank = "gobank"
+
 type Payload struct {
 	ID        uuid.UUID `json:"id"`
+	Type      TokenType `json:"type"`
 	Username  string    `json:"username"`
+	Audience  string    `json:"audience"`
+	Scopes    []string  `json:"scopes"`
 	IssuedAt  time.Time `json:"issued_at"`
 	ExpiredAt time.Time `json:"expired_at"`
 }
 
-func NewPayload(username string, duration time.Duration) (*Payload, error) {
+// PayloadOption customises a Payload created by NewPayload.
+type PayloadOption func(*Payload)
+
+// WithScopes restricts the token to the given scopes.
+// Without it the token carries every scope in AllScopes.
+func WithScopes(scopes ...string) PayloadOption {
+	return func(p *Payload) {
+		p.Scopes = slices.Clone(scopes)
+	}
+}
+
+// WithAudience sets the intended recipient of the token.
+func WithAudience(audience string) PayloadOption {
+	return func(p *Payload) {
+		p.Audience = audience
+	}
+}
+
+func NewPayload(username string, tokenType TokenType, duration time.Duration, opts ...PayloadOption) (*Payload, error) {
 	tokenID, err := uuid.NewRandom()
 	if err != nil {
 		return nil, err
 	}
 
 	now := time.Now()
-	return &Payload{
+	payload := &Payload{
 		ID:        tokenID,
+		Type:      tokenType,
 		Username:  username,
+		Audience:  AudienceGoBank,
+		Scopes:    AllScopes(),
 		IssuedAt:  now,
 		ExpiredAt: now.Add(duration),
-	}, nil
+	}
+
+	for _, opt := range opts {
+		opt(payload)
+	}
+
+	return payload, nil
 }
 
 func (p *Payload) Valid() error {
@@ -39,4 +82,18 @@ func (p *Payload) Valid() error {
 		return ErrExpiredToken
 	}
 	return nil
-}
\ No newline at end of file
+}
+
+// checkType rejects a token that is valid but of the wrong kind,
+// e.g. a refresh token presented as an access token.
+func (p *Payload) checkType(tokenType TokenType) error {
+	if p.Type != tokenType {
+		return ErrInvalidToken
+	}
+	return nil
+}
+
+// HasScope reports whether the token grants scope.
+func (p *Payload) HasScope(scope string) bool {
+	return slices.Contains(p.Scopes, scope)
+}
@@ -0,0 +1,27 @@
+package token
+
+import (
+	"testing"
+	"time"
+
+	"github.com/a7medalyapany/GoBank.git/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPayloadDefaults(t *testing.T) {
+	payload, err := NewPayload(util.RandomOwner(), TokenTypeAccess, time.Minute)
+	require.NoError(t, err)
+
+	require.Equal(t, TokenTypeAccess, payload.Type)
+	require.Equal(t, AudienceGoBank, payload.Audience)
+	require.ElementsMatch(t, AllScopes(), payload.Scopes)
+	require.NoError(t, payload.checkType(TokenTypeAccess))
+	require.ErrorIs(t, payload.checkType(TokenTypeRefresh), ErrInvalidToken)
+}
+
+func TestIsKnownScope(t *testing.T) {
+	for _, scope := range AllScopes() {
+		require.True(t, IsKnownScope(scope))
+	}
+	require.False(t, IsKnownScope("accounts:delete"))
+}
@@ -0,0 +1,45 @@
+package token
+
+import "slices"
+
+// Scopes limit what a token may be used for. First-party logins receive
+// every scope; third-party integrations can be issued a subset.
+const (
+	ScopeUsersWrite         = "users:write"
+	ScopeAccountsRead       = "accounts:read"
+	ScopeAccountsWrite      = "accounts:write"
+	ScopeEntriesRead        = "entries:read"
+	ScopeTransfersWrite     = "transfers:write"
+	ScopeAPIKeysManage      = "api_keys:manage"
+	ScopeNotificationsRead  = "notifications:read"
+	ScopeNotificationsWrite = "notifications:write"
+	ScopeWebhooksManage     = "webhooks:manage"
+	ScopeQueuesAdmin        = "queues:admin"
+	ScopeLogsAdmin          = "logs:admin"
+	ScopeAuditRead          = "audit:read"
+)
+
+var allScopes = []string{
+	ScopeUsersWrite,
+	ScopeAccountsRead,
+	ScopeAccountsWrite,
+	ScopeEntriesRead,
+	ScopeTransfersWrite,
+	ScopeAPIKeysManage,
+	ScopeNotificationsRead,
+	ScopeNotificationsWrite,
+	ScopeWebhooksManage,
+	ScopeQueuesAdmin,
+	ScopeLogsAdmin,
+	ScopeAuditRead,
+}
+
+// AllScopes returns every scope known to the API.
+func AllScopes() []string {
+	return slices.Clone(allScopes)
+}
+
+// IsKnownScope reports whether scope is one of AllScopes.
+func IsKnownScope(scope string) bool {
+	return slices.Contains(allScopes, scope)
+}
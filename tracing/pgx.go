@@ -0,0 +1,90 @@
+package tracing
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// QueryTracer is a pgx.QueryTracer that records a client span per query.
+// Set it on pgxpool.Config.ConnConfig.Tracer.
+//
+// Spans are named after the sqlc query ("-- name: GetAccount :one" becomes
+// "GetAccount"), or the SQL verb for hand-written statements such as the
+// BEGIN and COMMIT that wrap transactions. Arguments are never recorded.
+type QueryTracer struct {
+	tracer trace.Tracer
+}
+
+func NewQueryTracer() *QueryTracer {
+	return &QueryTracer{tracer: Tracer()}
+}
+
+func (t *QueryTracer) TraceQueryStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	if !trace.SpanFromContext(ctx).SpanContext().IsValid() {
+		// Queries outside a request, such as the outbox poll, would each
+		// start a trace of their own.
+		return ctx
+	}
+
+	name, operation := QueryName(data.SQL)
+
+	attrs := []attribute.KeyValue{
+		semconv.DBSystemNamePostgreSQL,
+		semconv.DBOperationName(operation),
+		semconv.DBQueryText(data.SQL),
+	}
+	if conn != nil {
+		config := conn.Config()
+		attrs = append(attrs,
+			semconv.DBNamespace(config.Database),
+			semconv.ServerAddress(config.Host),
+			semconv.ServerPort(int(config.Port)),
+		)
+	}
+
+	ctx, _ = t.tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+	return ctx
+}
+
+func (t *QueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return
+	}
+
+	if data.Err != nil {
+		span.RecordError(data.Err)
+		span.SetStatus(codes.Error, data.Err.Error())
+	} else {
+		span.SetAttributes(attribute.Int64("db.response.rows_affected", data.CommandTag.RowsAffected()))
+	}
+	span.End()
+}
+
+// QueryName returns the span name and operation for a SQL statement.
+func QueryName(sql string) (name string, operation string) {
+	sql = strings.TrimSpace(sql)
+
+	if rest, ok := strings.CutPrefix(sql, "-- name: "); ok {
+		line, body, _ := strings.Cut(rest, "\n")
+		name, _, _ = strings.Cut(line, " ")
+		sql = strings.TrimSpace(body)
+	}
+
+	operation, _, _ = strings.Cut(sql, " ")
+	operation = strings.ToUpper(strings.TrimRight(operation, ";"))
+
+	if name == "" {
+		name = operation
+	}
+	return name, operation
+}
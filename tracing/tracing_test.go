@@ -0,0 +1,82 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func setupTestProvider(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { provider.Shutdown(context.Background()) })
+	return recorder
+}
+
+func TestQueryName(t *testing.T) {
+	testCases := []struct {
+		sql       string
+		name      string
+		operation string
+	}{
+		{"-- name: GetAccount :one\nSELECT id FROM accounts WHERE id = $1", "GetAccount", "SELECT"},
+		{"-- name: CreateTransfer :one\nINSERT INTO transfers DEFAULT VALUES", "CreateTransfer", "INSERT"},
+		{"begin", "BEGIN", "BEGIN"},
+		{"  commit;", "COMMIT", "COMMIT"},
+	}
+
+	for _, tc := range testCases {
+		name, operation := QueryName(tc.sql)
+		require.Equal(t, tc.name, name)
+		require.Equal(t, tc.operation, operation)
+	}
+}
+
+func TestInjectExtract(t *testing.T) {
+	setupTestProvider(t)
+
+	require.Nil(t, Inject(context.Background()))
+
+	ctx, span := Tracer().Start(context.Background(), "request")
+	defer span.End()
+
+	headers := Inject(ctx)
+	require.Contains(t, headers, "traceparent")
+
+	extracted := trace.SpanContextFromContext(Extract(context.Background(), headers))
+	require.Equal(t, span.SpanContext().TraceID(), extracted.TraceID())
+	require.Equal(t, span.SpanContext().SpanID(), extracted.SpanID())
+	require.True(t, extracted.IsRemote())
+}
+
+func TestQueryTracer(t *testing.T) {
+	recorder := setupTestProvider(t)
+	tracer := NewQueryTracer()
+	sql := "-- name: GetAccount :one\nSELECT id FROM accounts WHERE id = $1"
+
+	// No span is started outside a trace.
+	ctx := tracer.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{SQL: sql})
+	tracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{})
+	require.Empty(t, recorder.Ended())
+
+	parent, span := Tracer().Start(context.Background(), "request")
+	ctx = tracer.TraceQueryStart(parent, nil, pgx.TraceQueryStartData{SQL: sql})
+	tracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{CommandTag: pgconn.NewCommandTag("SELECT 1")})
+	span.End()
+
+	ended := recorder.Ended()
+	require.Len(t, ended, 2)
+	require.Equal(t, "GetAccount", ended[0].Name())
+	require.Equal(t, trace.SpanKindClient, ended[0].SpanKind())
+	require.Equal(t, span.SpanContext().SpanID(), ended[0].Parent().SpanID())
+}
@@ -0,0 +1,14 @@
+package util
+
+import "strings"
+
+// AccountNumberPrefix starts every account number, like the country code of
+// an IBAN. The database generates the numbers; see generate_account_number.
+const AccountNumberPrefix = "GO"
+
+// NormalizeAccountNumber removes the spaces an account number is often
+// written with and upper-cases it, so "go92 0000 1234 5678" and
+// "GO92000012345678" look up the same account.
+func NormalizeAccountNumber(number string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(number), ""))
+}
@@ -0,0 +1,30 @@
+package util
+
+import "net/netip"
+
+// nonPublicPrefixes are ranges that netip does not classify as private or
+// non-global, but that never belong to a host on the public internet.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),     // "this network"
+	netip.MustParsePrefix("100.64.0.0/10"), // carrier-grade NAT
+	netip.MustParsePrefix("198.18.0.0/15"), // benchmarking
+	netip.MustParsePrefix("240.0.0.0/4"),   // reserved
+}
+
+// IsPublicAddr reports whether addr belongs to a host on the public internet.
+// Loopback, private (RFC 1918), unique-local (fc00::/7), link-local (which
+// includes cloud metadata endpoints such as 169.254.169.254), multicast and
+// reserved addresses are not public. IPv4-mapped IPv6 addresses are judged
+// by their IPv4 address.
+func IsPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,34 @@
+package util
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsPublicAddr(t *testing.T) {
+	for _, addr := range []string{"93.184.215.14", "8.8.8.8", "2606:2800:21f:cb07:6820:80da:af6b:8b2c"} {
+		require.True(t, IsPublicAddr(netip.MustParseAddr(addr)), addr)
+	}
+
+	for _, addr := range []string{
+		"127.0.0.1",        // loopback
+		"::1",              // loopback
+		"::ffff:127.0.0.1", // IPv4-mapped loopback
+		"10.1.2.3",         // private
+		"172.16.0.1",       // private
+		"192.168.1.1",      // private
+		"::ffff:10.0.0.1",  // IPv4-mapped private
+		"fd00::1",          // unique-local
+		"169.254.169.254",  // link-local, cloud metadata
+		"fe80::1",          // link-local
+		"0.0.0.0",          // unspecified
+		"::",               // unspecified
+		"100.64.0.1",       // carrier-grade NAT
+		"224.0.0.1",        // multicast
+		"255.255.255.255",  // broadcast
+	} {
+		require.False(t, IsPublicAddr(netip.MustParseAddr(addr)), addr)
+	}
+}
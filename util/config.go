@@ -14,15 +14,49 @@ type Config struct {
 	TESTING_DB_URL         string        `mapstructure:"TESTING_DB_URL"`
 	SERVER_ADDRESS         string        `mapstructure:"SERVER_ADDRESS"`
 	TOKEN_SYMMETRIC_KEY    string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
+	TOKEN_MAKER            string        `mapstructure:"TOKEN_MAKER"`
+	TOKEN_SIGNING_KEYS     string        `mapstructure:"TOKEN_SIGNING_KEYS"`
+	TOKEN_VERIFY_KEYS      string        `mapstructure:"TOKEN_VERIFY_KEYS"`
+	TOKEN_ACTIVE_KEY_ID    string        `mapstructure:"TOKEN_ACTIVE_KEY_ID"`
 	ACCESS_TOKEN_DURATION  time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
 	REFRESH_TOKEN_DURATION time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
 	GRPC_SERVER_PORT       string        `mapstructure:"GRPC_SERVER_PORT"`
+	METRICS_ADDRESS        string        `mapstructure:"METRICS_ADDRESS"`
 	ENVIRONMENT            string        `mapstructure:"ENVIRONMENT"`
     REDIS_ADDRESS          string        `mapstructure:"REDIS_ADDRESS"`
     BASE_URL               string        `mapstructure:"BASE_URL"`
     EMAIL_SENDER_NAME      string        `mapstructure:"EMAIL_SENDER_NAME"`
     EMAIL_SENDER_ADDRESS   string        `mapstructure:"EMAIL_SENDER_ADDRESS"`
     EMAIL_SENDER_PASSWORD  string        `mapstructure:"EMAIL_SENDER_PASSWORD"`
+    EMAIL_PROVIDER         string        `mapstructure:"EMAIL_PROVIDER"`
+    EMAIL_FILE_PATH        string        `mapstructure:"EMAIL_FILE_PATH"`
+    SMTP_HOST              string        `mapstructure:"SMTP_HOST"`
+    SMTP_PORT              int           `mapstructure:"SMTP_PORT"`
+    SMTP_USERNAME          string        `mapstructure:"SMTP_USERNAME"`
+    SMTP_PASSWORD          string        `mapstructure:"SMTP_PASSWORD"`
+    SMTP_TLS_POLICY        string        `mapstructure:"SMTP_TLS_POLICY"`
+    OUTBOX_POLL_INTERVAL   time.Duration `mapstructure:"OUTBOX_POLL_INTERVAL"`
+    OUTBOX_BATCH_SIZE      int32         `mapstructure:"OUTBOX_BATCH_SIZE"`
+    OUTBOX_RETENTION       time.Duration `mapstructure:"OUTBOX_RETENTION"`
+    OUTBOX_MAX_ATTEMPTS    int32         `mapstructure:"OUTBOX_MAX_ATTEMPTS"`
+    OUTBOX_RETRY_BACKOFF   time.Duration `mapstructure:"OUTBOX_RETRY_BACKOFF"`
+    WEBHOOK_TIMEOUT        time.Duration `mapstructure:"WEBHOOK_TIMEOUT"`
+    WEBHOOK_MAX_RETRY      int           `mapstructure:"WEBHOOK_MAX_RETRY"`
+    VERIFY_EMAIL_RESEND_COOLDOWN  time.Duration `mapstructure:"VERIFY_EMAIL_RESEND_COOLDOWN"`
+    VERIFY_EMAIL_CLEANUP_SCHEDULE string        `mapstructure:"VERIFY_EMAIL_CLEANUP_SCHEDULE"`
+    REQUIRE_VERIFIED_EMAIL        string        `mapstructure:"REQUIRE_VERIFIED_EMAIL"`
+    TRACING_EXPORTER              string        `mapstructure:"TRACING_EXPORTER"`
+    TRACING_SAMPLE_RATIO          float64       `mapstructure:"TRACING_SAMPLE_RATIO"`
+    HEALTH_CHECK_TIMEOUT          time.Duration `mapstructure:"HEALTH_CHECK_TIMEOUT"`
+    HEALTH_CHECK_INTERVAL         time.Duration `mapstructure:"HEALTH_CHECK_INTERVAL"`
+    LOG_LEVEL                     string        `mapstructure:"LOG_LEVEL"`
+    LOG_DEBUG_SECRET              string        `mapstructure:"LOG_DEBUG_SECRET"`
+    LOG_PAYLOADS                  bool          `mapstructure:"LOG_PAYLOADS"`
+    LEDGER_ANCHOR_SCHEDULE        string        `mapstructure:"LEDGER_ANCHOR_SCHEDULE"`
+    CURRENCIES                    string        `mapstructure:"CURRENCIES"`
+    SAVINGS_INTEREST_RATES        string        `mapstructure:"SAVINGS_INTEREST_RATES"`
+    INTEREST_ACCRUAL_SCHEDULE     string        `mapstructure:"INTEREST_ACCRUAL_SCHEDULE"`
+    INTEREST_POSTING_SCHEDULE     string        `mapstructure:"INTEREST_POSTING_SCHEDULE"`
 }
 
 
@@ -35,6 +69,32 @@ func LoadConfig(path string) (config Config, err error) {
 
     // Set a safe default for ENVIRONMENT.
 	viper.SetDefault("ENVIRONMENT", "production")
+	viper.SetDefault("TOKEN_MAKER", "paseto")
+	viper.SetDefault("EMAIL_PROVIDER", "gmail")
+	viper.SetDefault("EMAIL_FILE_PATH", "tmp/mail.mbox")
+	viper.SetDefault("SMTP_HOST", "localhost")
+	viper.SetDefault("SMTP_PORT", 587)
+	viper.SetDefault("SMTP_TLS_POLICY", "mandatory")
+	viper.SetDefault("OUTBOX_POLL_INTERVAL", "1s")
+	viper.SetDefault("OUTBOX_BATCH_SIZE", 100)
+	viper.SetDefault("OUTBOX_RETENTION", "168h")
+	viper.SetDefault("OUTBOX_MAX_ATTEMPTS", 10)
+	viper.SetDefault("OUTBOX_RETRY_BACKOFF", "1s")
+	viper.SetDefault("WEBHOOK_TIMEOUT", "10s")
+	viper.SetDefault("WEBHOOK_MAX_RETRY", 10)
+	viper.SetDefault("VERIFY_EMAIL_RESEND_COOLDOWN", "1m")
+	viper.SetDefault("VERIFY_EMAIL_CLEANUP_SCHEDULE", "@hourly")
+	viper.SetDefault("REQUIRE_VERIFIED_EMAIL", "CreateAccount,CreateTransfer")
+	viper.SetDefault("TRACING_EXPORTER", "none")
+	viper.SetDefault("TRACING_SAMPLE_RATIO", 1.0)
+	viper.SetDefault("HEALTH_CHECK_TIMEOUT", "2s")
+	viper.SetDefault("HEALTH_CHECK_INTERVAL", "5s")
+	viper.SetDefault("LOG_LEVEL", "info")
+	viper.SetDefault("METRICS_ADDRESS", "127.0.0.1:9091")
+	viper.SetDefault("LEDGER_ANCHOR_SCHEDULE", "@hourly")
+	viper.SetDefault("CURRENCIES", DefaultCurrencies)
+	viper.SetDefault("INTEREST_ACCRUAL_SCHEDULE", "5 0 * * *")
+	viper.SetDefault("INTEREST_POSTING_SCHEDULE", "30 0 1 * *")
 
     // Only read file if it exists — in production, env vars are enough
     if err = viper.ReadInConfig(); err != nil {
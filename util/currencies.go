@@ -1,16 +1,154 @@
 package util
 
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
 const (
 	USD = "USD"
 	EUR = "EUR"
 	EGP = "EGP"
+	GBP = "GBP"
+	JPY = "JPY"
+	KWD = "KWD"
 )
 
+// DefaultCurrencies is the CURRENCIES value used when none is configured.
+const DefaultCurrencies = "USD,EUR,EGP"
+
+// Currency is an ISO 4217 currency. Amounts are stored as integers in its
+// minor units: cents for USD, yen for JPY (0 decimals) and fils for KWD
+// (3 decimals).
+type Currency struct {
+	Code       string
+	MinorUnits int
+	Symbol     string
+	// Enabled currencies can be used for new accounts and transfers.
+	// Disabled ones are still converted and formatted, so accounts opened
+	// before a currency was disabled keep working.
+	Enabled bool
+}
+
+// knownCurrencies are the currencies CURRENCIES can enable by code alone.
+var knownCurrencies = []Currency{
+	{Code: USD, MinorUnits: 2, Symbol: "$"},
+	{Code: EUR, MinorUnits: 2, Symbol: "€"},
+	{Code: EGP, MinorUnits: 2, Symbol: "E£"},
+	{Code: GBP, MinorUnits: 2, Symbol: "£"},
+	{Code: JPY, MinorUnits: 0, Symbol: "¥"},
+	{Code: KWD, MinorUnits: 3, Symbol: "KD"},
+}
+
+// CurrencyRegistry holds every currency the bank knows about.
+type CurrencyRegistry struct {
+	currencies map[string]Currency
+}
+
+// NewCurrencyRegistry parses a CURRENCIES value: a comma-separated list of
+// the enabled currencies. Each item is either a known ISO 4217 code such as
+// "JPY", or "CODE:minor_units:symbol" to add a currency or override a known
+// one. Known currencies that are not listed are registered as disabled.
+func NewCurrencyRegistry(spec string) (*CurrencyRegistry, error) {
+	registry := &CurrencyRegistry{currencies: make(map[string]Currency, len(knownCurrencies))}
+	for _, currency := range knownCurrencies {
+		registry.currencies[currency.Code] = currency
+	}
+
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		code, rest, custom := strings.Cut(item, ":")
+		if len(code) != 3 || strings.ToUpper(code) != code {
+			return nil, fmt.Errorf("currency %q: code must be 3 upper-case letters", item)
+		}
 
-func IsSupportedCurrency(currency string) bool {
-	switch currency {
-	case USD, EUR, EGP:
-		return true
+		currency, known := registry.currencies[code]
+		if custom {
+			minorUnits, symbol, ok := strings.Cut(rest, ":")
+			if !ok || symbol == "" {
+				return nil, fmt.Errorf("currency %q: want CODE:minor_units:symbol", item)
+			}
+			units, err := strconv.Atoi(minorUnits)
+			if err != nil || units < 0 || units > 4 {
+				return nil, fmt.Errorf("currency %q: minor units must be between 0 and 4", item)
+			}
+			currency = Currency{Code: code, MinorUnits: units, Symbol: symbol}
+		} else if !known {
+			return nil, fmt.Errorf("currency %q: unknown code, use CODE:minor_units:symbol", item)
+		}
+
+		currency.Enabled = true
+		registry.currencies[code] = currency
 	}
-	return false
-}
\ No newline at end of file
+
+	return registry, nil
+}
+
+// Lookup returns the currency with code, enabled or not.
+func (registry *CurrencyRegistry) Lookup(code string) (Currency, bool) {
+	currency, ok := registry.currencies[code]
+	return currency, ok
+}
+
+// Enabled returns the enabled currencies sorted by code.
+func (registry *CurrencyRegistry) Enabled() []Currency {
+	var enabled []Currency
+	for _, currency := range registry.currencies {
+		if currency.Enabled {
+			enabled = append(enabled, currency)
+		}
+	}
+	slices.SortFunc(enabled, func(a, b Currency) int {
+		return strings.Compare(a.Code, b.Code)
+	})
+	return enabled
+}
+
+// EnabledCodes returns the codes of the enabled currencies sorted.
+func (registry *CurrencyRegistry) EnabledCodes() []string {
+	enabled := registry.Enabled()
+	codes := make([]string, len(enabled))
+	for i, currency := range enabled {
+		codes[i] = currency.Code
+	}
+	return codes
+}
+
+var currencies atomic.Pointer[CurrencyRegistry]
+
+func init() {
+	registry, err := NewCurrencyRegistry(DefaultCurrencies)
+	if err != nil {
+		panic(err)
+	}
+	currencies.Store(registry)
+}
+
+// Currencies returns the registry used by the package-level helpers.
+func Currencies() *CurrencyRegistry {
+	return currencies.Load()
+}
+
+// SetCurrencies replaces the registry used by the package-level helpers.
+// main calls it once with the registry built from CURRENCIES.
+func SetCurrencies(registry *CurrencyRegistry) {
+	currencies.Store(registry)
+}
+
+// LookupCurrency looks code up in Currencies.
+func LookupCurrency(code string) (Currency, bool) {
+	return Currencies().Lookup(code)
+}
+
+// IsSupportedCurrency reports whether code is an enabled currency.
+func IsSupportedCurrency(code string) bool {
+	currency, ok := LookupCurrency(code)
+	return ok && currency.Enabled
+}
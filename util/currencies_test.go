@@ -0,0 +1,136 @@
+package util
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCurrencyRegistry(t *testing.T) {
+	registry, err := NewCurrencyRegistry("USD, JPY,XAU:4:oz ,EUR:2:EUR ")
+	require.NoError(t, err)
+	require.Equal(t, []string{EUR, JPY, USD, "XAU"}, registry.EnabledCodes())
+
+	jpy, ok := registry.Lookup(JPY)
+	require.True(t, ok)
+	require.Equal(t, Currency{Code: JPY, MinorUnits: 0, Symbol: "¥", Enabled: true}, jpy)
+
+	eur, ok := registry.Lookup(EUR)
+	require.True(t, ok)
+	require.Equal(t, "EUR", eur.Symbol)
+
+	// Known but not listed: still registered, but disabled.
+	egp, ok := registry.Lookup(EGP)
+	require.True(t, ok)
+	require.False(t, egp.Enabled)
+
+	_, ok = registry.Lookup("ABC")
+	require.False(t, ok)
+
+	for _, spec := range []string{"usd", "ABC", "USDX", "XAU:2", "XAU:x:oz", "XAU:9:oz", "XAU:2:"} {
+		_, err := NewCurrencyRegistry(spec)
+		require.Error(t, err, spec)
+	}
+}
+
+func TestDefaultCurrencies(t *testing.T) {
+	registry, err := NewCurrencyRegistry(DefaultCurrencies)
+	require.NoError(t, err)
+	require.Equal(t, []string{EGP, EUR, USD}, registry.EnabledCodes())
+
+	require.True(t, IsSupportedCurrency(USD))
+	require.False(t, IsSupportedCurrency(JPY))
+	require.False(t, IsSupportedCurrency("ABC"))
+}
+
+func TestMinorUnits(t *testing.T) {
+	original := Currencies()
+	t.Cleanup(func() { SetCurrencies(original) })
+
+	registry, err := NewCurrencyRegistry("USD,JPY,KWD")
+	require.NoError(t, err)
+	SetCurrencies(registry)
+
+	testCases := []struct {
+		currency  string
+		amount    float64
+		minor     int64
+		formatted string
+	}{
+		{USD, 10.5, 1050, "$10.50"},
+		{JPY, 1000, 1000, "¥1000"},
+		{KWD, 1.5, 1500, "KD1.500"},
+		{KWD, -0.125, -125, "KD-0.125"},
+		{EGP, 3.99, 399, "E£3.99"},
+		{"ABC", 1.25, 125, "ABC 1.25"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.currency, func(t *testing.T) {
+			require.Equal(t, tc.minor, ToMinorUnits(tc.amount, tc.currency))
+			require.Equal(t, tc.amount, FromMinorUnits(tc.minor, tc.currency))
+			require.Equal(t, tc.formatted, FormatMoney(tc.minor, tc.currency))
+			require.True(t, HasMinorUnitPrecision(tc.amount, tc.currency))
+		})
+	}
+
+	require.False(t, HasMinorUnitPrecision(10.5, JPY))
+	require.False(t, HasMinorUnitPrecision(1.0005, KWD))
+	require.True(t, HasMinorUnitPrecision(0.1+0.2, USD))
+}
+
+func TestDecimal(t *testing.T) {
+	original := Currencies()
+	t.Cleanup(func() { SetCurrencies(original) })
+
+	registry, err := NewCurrencyRegistry("USD,JPY,KWD")
+	require.NoError(t, err)
+	SetCurrencies(registry)
+
+	testCases := []struct {
+		currency string
+		decimal  string
+		minor    int64
+	}{
+		{USD, "10.50", 1050},
+		{USD, "0.01", 1},
+		{USD, "-0.05", -5},
+		{USD, "92233720368547758.07", math.MaxInt64},
+		{JPY, "1000", 1000},
+		{KWD, "1.500", 1500},
+		{KWD, "-0.125", -125},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.currency+" "+tc.decimal, func(t *testing.T) {
+			amount, err := ParseDecimal(tc.decimal, tc.currency)
+			require.NoError(t, err)
+			require.Equal(t, tc.minor, amount)
+			require.Equal(t, tc.decimal, FormatDecimal(tc.minor, tc.currency))
+		})
+	}
+
+	// Fewer decimals than the currency has are padded.
+	amount, err := ParseDecimal("10.5", USD)
+	require.NoError(t, err)
+	require.Equal(t, int64(1050), amount)
+
+	require.Equal(t, "-92233720368547758.08", FormatDecimal(math.MinInt64, USD))
+
+	for _, tc := range []struct{ currency, decimal string }{
+		{USD, "10.505"},
+		{JPY, "10.5"},
+		{USD, ""},
+		{USD, "."},
+		{USD, "1."},
+		{USD, ".5"},
+		{USD, "+1"},
+		{USD, "1e3"},
+		{USD, "1,000.00"},
+		{USD, "92233720368547758.08"},
+	} {
+		_, err := ParseDecimal(tc.decimal, tc.currency)
+		require.Error(t, err, tc.decimal)
+	}
+}
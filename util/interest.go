@@ -0,0 +1,128 @@
+package util
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// MicrosPerMinorUnit is the precision interest accrues in: a millionth of a
+// minor unit, so daily interest on small balances is not rounded away.
+const MicrosPerMinorUnit = 1_000_000
+
+// DaysPerYear is the day count interest accrues on (actual/365).
+const DaysPerYear = 365
+
+// InterestRates holds the annual rate, in basis points (250 = 2.50%), that
+// savings accounts earn in each currency.
+type InterestRates struct {
+	rates map[string]int64
+}
+
+// NewInterestRates parses a SAVINGS_INTEREST_RATES value: a comma-separated
+// list of CODE:percent items such as "USD:2.5,EUR:1.75". Percentages have at
+// most two decimals and are between 0 and 100. Currencies that are not listed
+// earn no interest.
+func NewInterestRates(spec string) (*InterestRates, error) {
+	rates := &InterestRates{rates: make(map[string]int64)}
+
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		code, percent, ok := strings.Cut(item, ":")
+		if !ok || len(code) != 3 || strings.ToUpper(code) != code {
+			return nil, fmt.Errorf("interest rate %q: want CODE:percent", item)
+		}
+		if _, ok := rates.rates[code]; ok {
+			return nil, fmt.Errorf("interest rate %q: %s is listed twice", item, code)
+		}
+
+		bps, err := parseBasisPoints(percent)
+		if err != nil {
+			return nil, fmt.Errorf("interest rate %q: %w", item, err)
+		}
+		rates.rates[code] = bps
+	}
+
+	return rates, nil
+}
+
+// parseBasisPoints parses a percentage with at most two decimals.
+func parseBasisPoints(percent string) (int64, error) {
+	whole, fraction, hasPoint := strings.Cut(percent, ".")
+	if whole == "" || (hasPoint && fraction == "") || len(fraction) > 2 || !isDigits(whole) || !isDigits(fraction) {
+		return 0, fmt.Errorf("percent must be a number with at most two decimals")
+	}
+
+	bps, err := strconv.ParseInt(whole+fraction+strings.Repeat("0", 2-len(fraction)), 10, 64)
+	if err != nil || bps > 100_00 {
+		return 0, fmt.Errorf("percent must be between 0 and 100")
+	}
+	return bps, nil
+}
+
+// Rate returns the annual rate of currency in basis points, or 0 when it
+// earns no interest.
+func (rates *InterestRates) Rate(currency string) int64 {
+	return rates.rates[currency]
+}
+
+var interestRates atomic.Pointer[InterestRates]
+
+func init() {
+	interestRates.Store(&InterestRates{rates: map[string]int64{}})
+}
+
+// SetInterestRates replaces the rates used by InterestRate. main calls it
+// once with the rates built from SAVINGS_INTEREST_RATES.
+func SetInterestRates(rates *InterestRates) {
+	interestRates.Store(rates)
+}
+
+// InterestRate returns the annual rate savings accounts in currency earn, in
+// basis points.
+func InterestRate(currency string) int64 {
+	return interestRates.Load().Rate(currency)
+}
+
+// DailyInterestMicros returns one day of interest on balance (in minor units)
+// at an annual rate of rateBPS basis points, in millionths of a minor unit,
+// rounded to the nearest. Balances that are not positive earn nothing. The
+// result fits in an int64 for balances below about 3×10^15 minor units.
+// Example: DailyInterestMicros(100_000, 250) → 6_849_315 (6.849315 cents on $1000 at 2.5%)
+func DailyInterestMicros(balance, rateBPS int64) int64 {
+	if balance <= 0 || rateBPS <= 0 {
+		return 0
+	}
+
+	// balance × rate/10_000 / 365 × 1_000_000 = balance × rate × 100 / 365
+	n := new(big.Int).Mul(big.NewInt(balance), big.NewInt(rateBPS))
+	n.Mul(n, big.NewInt(MicrosPerMinorUnit/100_00))
+
+	q, r := n.QuoRem(n, big.NewInt(DaysPerYear), new(big.Int))
+	// 365 is odd, so there is never a tie to break.
+	if r.Int64()*2 > DaysPerYear {
+		q.Add(q, big.NewInt(1))
+	}
+	return q.Int64()
+}
+
+// FromMicros converts an amount in millionths of a minor unit of currency
+// to major units.
+// Example: FromMicros(6_849_315, "USD") → 0.06849315
+func FromMicros(micros int64, currency string) float64 {
+	return float64(micros) / MicrosPerMinorUnit / math.Pow10(MinorUnits(currency))
+}
+
+// FormatRate formats a rate in basis points as an exact percentage with two
+// decimals.
+// Example: FormatRate(250) → "2.50"
+func FormatRate(rateBPS int64) string {
+	return fmt.Sprintf("%d.%02d", rateBPS/100, rateBPS%100)
+}
@@ -0,0 +1,51 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewInterestRates(t *testing.T) {
+	rates, err := NewInterestRates("USD:2.5, EUR:1.75,JPY:0,EGP:100")
+	require.NoError(t, err)
+	require.Equal(t, int64(250), rates.Rate(USD))
+	require.Equal(t, int64(175), rates.Rate(EUR))
+	require.Zero(t, rates.Rate(JPY))
+	require.Equal(t, int64(100_00), rates.Rate(EGP))
+	require.Zero(t, rates.Rate("GBP"))
+
+	empty, err := NewInterestRates("")
+	require.NoError(t, err)
+	require.Zero(t, empty.Rate(USD))
+
+	for _, spec := range []string{"USD", "usd:2", "USD:", "USD:2.", "USD:2.505", "USD:-1", "USD:100.01", "USD:x", "USD:1,USD:2"} {
+		_, err := NewInterestRates(spec)
+		require.Error(t, err, spec)
+	}
+}
+
+func TestDailyInterestMicros(t *testing.T) {
+	// $1000 at 2.5% earns 6.849315 cents a day.
+	require.Equal(t, int64(6_849_315), DailyInterestMicros(100_000, 250))
+	// $1 at 0.01% earns 27.4 micros, rounded to the nearest.
+	require.Equal(t, int64(27), DailyInterestMicros(100, 1))
+	require.Zero(t, DailyInterestMicros(0, 250))
+	require.Zero(t, DailyInterestMicros(-100_000, 250))
+	require.Zero(t, DailyInterestMicros(100_000, 0))
+
+	// A year of accruals adds up to the annual rate, give or take rounding.
+	require.InDelta(t, 2_500*MicrosPerMinorUnit, 365*DailyInterestMicros(100_000, 250), 365)
+}
+
+func TestFromMicros(t *testing.T) {
+	require.InDelta(t, 0.06849315, FromMicros(6_849_315, USD), 1e-12)
+	require.InDelta(t, 6.849315, FromMicros(6_849_315, JPY), 1e-12)
+}
+
+func TestFormatRate(t *testing.T) {
+	require.Equal(t, "2.50", FormatRate(250))
+	require.Equal(t, "0.05", FormatRate(5))
+	require.Equal(t, "0.00", FormatRate(0))
+	require.Equal(t, "100.00", FormatRate(100_00))
+}
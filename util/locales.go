@@ -0,0 +1,18 @@
+package util
+
+// Locales users can pick for emails and other user-facing text.
+const (
+	LocaleEnglish = "en"
+	LocaleArabic  = "ar"
+)
+
+// DefaultLocale is used when a user has not chosen a locale.
+const DefaultLocale = LocaleEnglish
+
+func IsSupportedLocale(locale string) bool {
+	switch locale {
+	case LocaleEnglish, LocaleArabic:
+		return true
+	}
+	return false
+}
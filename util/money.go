@@ -1,31 +1,112 @@
 package util
 
 import (
+	"errors"
 	"fmt"
 	"math"
+	"strconv"
+	"strings"
 )
 
-// FloatToCents converts dollars (or any currency) to cents
-// Example: 10.50 → 1050
-func FloatToCents(amount float64) int64 {
-	return int64(math.Round(amount * 100))
+// defaultMinorUnits is used for a currency missing from the registry.
+const defaultMinorUnits = 2
+
+// MinorUnits returns the number of decimal places of currency.
+func MinorUnits(currency string) int {
+	if c, ok := LookupCurrency(currency); ok {
+		return c.MinorUnits
+	}
+	return defaultMinorUnits
+}
+
+// ToMinorUnits converts an amount in major units of currency to minor units.
+// Example: ToMinorUnits(10.50, "USD") → 1050, ToMinorUnits(1000, "JPY") → 1000
+func ToMinorUnits(amount float64, currency string) int64 {
+	return int64(math.Round(amount * math.Pow10(MinorUnits(currency))))
+}
+
+// FromMinorUnits converts an amount in minor units of currency to major units.
+// Example: FromMinorUnits(1050, "USD") → 10.50, FromMinorUnits(1500, "KWD") → 1.5
+func FromMinorUnits(amount int64, currency string) float64 {
+	return float64(amount) / math.Pow10(MinorUnits(currency))
+}
+
+// HasMinorUnitPrecision reports whether amount has no more decimal places
+// than currency allows, e.g. 10.5 JPY does not.
+func HasMinorUnitPrecision(amount float64, currency string) bool {
+	scaled := amount * math.Pow10(MinorUnits(currency))
+	return math.Abs(scaled-math.Round(scaled)) < 1e-6
+}
+
+// FormatMoney formats an amount in minor units with the currency's symbol
+// and number of decimals. Currencies missing from the registry use their
+// code as the symbol.
+// Example: FormatMoney(1050, "USD") → "$10.50", FormatMoney(1500, "JPY") → "¥1500"
+func FormatMoney(amount int64, currency string) string {
+	symbol := currency + " "
+	if c, ok := LookupCurrency(currency); ok {
+		symbol = c.Symbol
+	}
+	return symbol + FormatDecimal(amount, currency)
+}
+
+// FormatDecimal formats an amount in minor units as an exact decimal string
+// with as many decimals as currency has. It never goes through float64.
+// Example: FormatDecimal(1050, "USD") → "10.50", FormatDecimal(-5, "KWD") → "-0.005"
+func FormatDecimal(amount int64, currency string) string {
+	units := MinorUnits(currency)
+
+	sign := ""
+	magnitude := uint64(amount)
+	if amount < 0 {
+		sign = "-"
+		magnitude = -magnitude
+	}
+
+	digits := strconv.FormatUint(magnitude, 10)
+	if units == 0 {
+		return sign + digits
+	}
+	if len(digits) <= units {
+		digits = strings.Repeat("0", units-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-units] + "." + digits[len(digits)-units:]
 }
 
-// CentsToFloat converts cents to dollars
-// Example: 1050 → 10.50
-func CentsToFloat(cents int64) float64 {
-	return float64(cents) / 100.0
+// ParseDecimal parses an exact decimal string such as "10.50" into minor
+// units of currency. It rejects more decimals than the currency has instead
+// of rounding them away.
+// Example: ParseDecimal("10.5", "USD") → 1050, ParseDecimal("10.5", "JPY") → error
+func ParseDecimal(s string, currency string) (int64, error) {
+	units := MinorUnits(currency)
+
+	negative := strings.HasPrefix(s, "-")
+	whole, fraction, hasPoint := strings.Cut(strings.TrimPrefix(s, "-"), ".")
+	if whole == "" || (hasPoint && fraction == "") || !isDigits(whole) || !isDigits(fraction) {
+		return 0, fmt.Errorf("%q is not a decimal number", s)
+	}
+	if len(fraction) > units {
+		return 0, fmt.Errorf("%s amounts have at most %d decimal places", currency, units)
+	}
+
+	digits := whole + fraction + strings.Repeat("0", units-len(fraction))
+	magnitude, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil || magnitude > math.MaxInt64 {
+		return 0, errors.New("amount is out of range")
+	}
+
+	amount := int64(magnitude)
+	if negative {
+		amount = -amount
+	}
+	return amount, nil
 }
 
-// FormatMoney formats cents as a currency string
-// Example: FormatMoney(1050, "USD") → "$10.50"
-func FormatMoney(cents int64, currency string) string {
-	symbol := "$"
-	switch currency {
-	case "EUR":
-		symbol = "€"
-	case "EGP":
-		symbol = "E£"
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
 	}
-	return fmt.Sprintf("%s%.2f", symbol, CentsToFloat(cents))
-}
\ No newline at end of file
+	return true
+}
@@ -0,0 +1,8 @@
+package util
+
+// Roles a user can have. Every user is a customer; admins can also use the
+// operator RPCs. There is no API to grant a role — set it in the database.
+const (
+	RoleCustomer = "customer"
+	RoleAdmin    = "admin"
+)
@@ -3,7 +3,12 @@ package val
 import (
 	"fmt"
 	"net/mail"
+	"net/netip"
+	"net/url"
 	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/a7medalyapany/GoBank.git/util"
 )
@@ -11,6 +16,8 @@ import (
 var (
 	isValidUsername = regexp.MustCompile(`^[a-z0-9_]+$`).MatchString
 	isValidFullname = regexp.MustCompile(`^[a-zA-Z\s]+$`).MatchString
+	// GO, two check digits and 12 digits.
+	isValidAccountNumber = regexp.MustCompile(`^` + util.AccountNumberPrefix + `[0-9]{14}$`).MatchString
 )
 
 func ValidateString(value string, minLength int, maxLength int) error {
@@ -58,6 +65,50 @@ func ValidateFullname(fullname string) error {
 	return nil
 }
 
+// ValidateWebhookURL accepts absolute http or https URLs, only https if
+// requireHTTPS is set. Hosts that are IP literals must be public addresses;
+// host names are resolved and checked by webhook.CheckHost.
+func ValidateWebhookURL(value string, requireHTTPS bool) error {
+	if err := ValidateString(value, 10, 2048); err != nil {
+		return fmt.Errorf("invalid url: %w", err)
+	}
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("must be an absolute http or https url")
+	}
+	if requireHTTPS && u.Scheme != "https" {
+		return fmt.Errorf("must be an https url")
+	}
+	if u.User != nil {
+		return fmt.Errorf("must not contain credentials")
+	}
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("must not point to a loopback or private address")
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && !util.IsPublicAddr(addr) {
+		return fmt.Errorf("must not point to a loopback or private address")
+	}
+	return nil
+}
+
+// ValidateAccountNickname allows up to 40 printable characters in any script.
+// An empty nickname means the account is unnamed.
+func ValidateAccountNickname(nickname string) error {
+	if utf8.RuneCountInString(nickname) > 40 {
+		return fmt.Errorf("must be at most 40 characters")
+	}
+	if strings.TrimSpace(nickname) != nickname {
+		return fmt.Errorf("must not start or end with spaces")
+	}
+	for _, r := range nickname {
+		if !unicode.IsPrint(r) {
+			return fmt.Errorf("must contain only printable characters")
+		}
+	}
+	return nil
+}
+
 func ValidateID(id int64) error {
 	if id <= 0 {
 		return fmt.Errorf("must be a positive integer")
@@ -65,12 +116,57 @@ func ValidateID(id int64) error {
 	return nil
 }
 
+// ValidateAccountNumber checks the format and the check digits of an account
+// number normalized with util.NormalizeAccountNumber. As in IBAN, the number
+// with its first four characters moved to the end and letters replaced by
+// 10-35 must equal 1 mod 97, which catches any single mistyped digit and most
+// swapped pairs.
+func ValidateAccountNumber(number string) error {
+	if !isValidAccountNumber(number) {
+		return fmt.Errorf("must be %s followed by 14 digits", util.AccountNumberPrefix)
+	}
+	if mod97(number[4:]+number[:4]) != 1 {
+		return fmt.Errorf("check digits do not match; check the number for typos")
+	}
+	return nil
+}
+
+// mod97 computes s mod 97 a digit at a time, reading each letter as its
+// two-digit value (A = 10 … Z = 35).
+func mod97(s string) int {
+	remainder := 0
+	for _, r := range s {
+		if r >= 'A' && r <= 'Z' {
+			remainder = (remainder*100 + int(r-'A') + 10) % 97
+		} else {
+			remainder = (remainder*10 + int(r-'0')) % 97
+		}
+	}
+	return remainder
+}
+
 func ValidateCurrency(currency string) error {
 	if err := ValidateString(currency, 3, 3); err != nil {
 		return fmt.Errorf("invalid currency: %w", err)
 	}
 	if !util.IsSupportedCurrency(currency) {
-		return fmt.Errorf("unsupported currency: must be one of USD, EUR, EGP")
+		return fmt.Errorf("unsupported currency: must be one of %s", strings.Join(util.Currencies().EnabledCodes(), ", "))
+	}
+	return nil
+}
+
+// ValidateMinorUnits checks that amount has no more decimal places than
+// currency allows, e.g. 0 for JPY.
+func ValidateMinorUnits(amount float64, currency string) error {
+	if !util.HasMinorUnitPrecision(amount, currency) {
+		return fmt.Errorf("must have at most %d decimal places for %s", util.MinorUnits(currency), currency)
+	}
+	return nil
+}
+
+func ValidateLocale(locale string) error {
+	if !util.IsSupportedLocale(locale) {
+		return fmt.Errorf("unsupported locale: must be one of en, ar")
 	}
 	return nil
 }
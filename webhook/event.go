@@ -0,0 +1,108 @@
+package webhook
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Event types an endpoint can subscribe to. Transfer events are delivered to
+// each side separately: the sender's endpoints get transfer.sent and the
+// recipient's get transfer.received.
+const (
+	EventAccountCreated   = "account.created"
+	EventAccountUpdated   = "account.updated"
+	EventAccountDeleted   = "account.deleted"
+	EventTransferSent     = "transfer.sent"
+	EventTransferReceived = "transfer.received"
+)
+
+var eventTypes = []string{
+	EventAccountCreated,
+	EventAccountUpdated,
+	EventAccountDeleted,
+	EventTransferSent,
+	EventTransferReceived,
+}
+
+// EventTypes returns every event type endpoints can subscribe to.
+func EventTypes() []string {
+	return slices.Clone(eventTypes)
+}
+
+// IsKnownEventType reports whether eventType is one of EventTypes.
+func IsKnownEventType(eventType string) bool {
+	return slices.Contains(eventTypes, eventType)
+}
+
+// Delivery statuses stored in webhook_deliveries.status. A delivery stays
+// pending while it is being retried and becomes dead once retries run out.
+const (
+	DeliveryStatusPending   = "pending"
+	DeliveryStatusSucceeded = "succeeded"
+	DeliveryStatusDead      = "dead"
+)
+
+// IsKnownDeliveryStatus reports whether status is a delivery status.
+func IsKnownDeliveryStatus(status string) bool {
+	switch status {
+	case DeliveryStatusPending, DeliveryStatusSucceeded, DeliveryStatusDead:
+		return true
+	}
+	return false
+}
+
+// Event is the JSON body POSTed to an endpoint. ID is stable across retries
+// and replays, so receivers can use it to drop duplicates.
+type Event struct {
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// NewEvent creates an event of eventType with data encoded as JSON.
+func NewEvent(eventType string, data any) (Event, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return Event{}, err
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, err
+	}
+
+	return Event{
+		ID:        id.String(),
+		Type:      eventType,
+		CreatedAt: time.Now().UTC(),
+		Data:      raw,
+	}, nil
+}
+
+// AccountData is the data of account.* events. Balance is in minor units of
+// Currency (cents for USD).
+type AccountData struct {
+	ID            int64     `json:"id"`
+	Owner         string    `json:"owner"`
+	Balance       int64     `json:"balance"`
+	Currency      string    `json:"currency"`
+	Type          string    `json:"type"`
+	Nickname      string    `json:"nickname"`
+	AccountNumber string    `json:"account_number"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// TransferData is the data of transfer.* events. Amount is in minor units of
+// Currency (cents for USD).
+type TransferData struct {
+	ID            int64     `json:"id"`
+	FromAccountID int64     `json:"from_account_id"`
+	ToAccountID   int64     `json:"to_account_id"`
+	Amount        int64     `json:"amount"`
+	Currency      string    `json:"currency"`
+	CreatedAt     time.Time `json:"created_at"`
+}
@@ -0,0 +1,170 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/a7medalyapany/GoBank.git/util"
+)
+
+// maxDrainBody limits how much of a response is read so the connection can be
+// reused.
+const maxDrainBody = 512
+
+// ErrForbiddenAddress is returned when an endpoint resolves to an address that
+// is not on the public internet, such as loopback or a private network.
+var ErrForbiddenAddress = errors.New("endpoint address is not public")
+
+// Request is a single delivery attempt.
+type Request struct {
+	URL        string
+	Secret     string
+	DeliveryID int64
+	EventType  string
+	Body       []byte
+}
+
+// Sender POSTs signed events to endpoints.
+type Sender struct {
+	client *http.Client
+}
+
+// NewSender creates a Sender whose requests time out after timeout. It only
+// connects to public addresses; see util.IsPublicAddr.
+func NewSender(timeout time.Duration) *Sender {
+	return newSender(timeout, util.IsPublicAddr)
+}
+
+func newSender(timeout time.Duration, allowAddr func(netip.Addr) bool) *Sender {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		// Check the address actually dialled rather than the one seen at
+		// registration, so a DNS record changed later cannot point the
+		// sender at an internal service.
+		Control: func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return fmt.Errorf("%w: %s", ErrForbiddenAddress, address)
+			}
+			if !allowAddr(addrPort.Addr()) {
+				return fmt.Errorf("%w: %s", ErrForbiddenAddress, addrPort.Addr())
+			}
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// A proxy would be dialled instead of the endpoint, bypassing the check.
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &Sender{
+		client: &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+			// Endpoints must answer at the registered URL; following redirects
+			// would let them bounce signed payloads somewhere else.
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// CheckHost resolves host and returns ErrForbiddenAddress if any of its
+// addresses is not public. A host that does not resolve yet is accepted:
+// the Sender checks every address it connects to anyway.
+func CheckHost(ctx context.Context, host string) error {
+	if addr, err := netip.ParseAddr(host); err == nil {
+		if !util.IsPublicAddr(addr) {
+			return ErrForbiddenAddress
+		}
+		return nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return nil
+	}
+	for _, addr := range addrs {
+		if !util.IsPublicAddr(addr) {
+			return ErrForbiddenAddress
+		}
+	}
+	return nil
+}
+
+// Send delivers req and returns the response status code. Any status outside
+// 2xx is returned as an error together with the code; the code is zero if
+// no response was received. The response body is never read into the error,
+// since it comes from a server the endpoint owner controls.
+func (sender *Sender) Send(ctx context.Context, req Request) (int, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("User-Agent", "GoBank-Webhooks/1.0")
+	httpReq.Header.Set(EventHeader, req.EventType)
+	httpReq.Header.Set(DeliveryHeader, strconv.FormatInt(req.DeliveryID, 10))
+	httpReq.Header.Set(SignatureHeader, Sign(req.Secret, time.Now(), req.Body))
+
+	resp, err := sender.client.Do(httpReq)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Drain so the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrainBody))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("endpoint returned status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// DeliveryError describes a failed Send for the delivery log, which the
+// endpoint owner can read: the status code when a response came back,
+// otherwise only the kind of failure, so the log reveals nothing about the
+// network the sender runs in.
+func DeliveryError(statusCode int, err error) string {
+	var netErr net.Error
+	switch {
+	case statusCode != 0:
+		return fmt.Sprintf("endpoint returned status %d", statusCode)
+	case errors.Is(err, ErrForbiddenAddress):
+		return ErrForbiddenAddress.Error()
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return "request timed out"
+	default:
+		return "request failed"
+	}
+}
+
+// Backoff returns the delay before retry n (counting from 0) of a failed
+// delivery: 30s doubling on every attempt, capped at 6h, with up to 10%
+// jitter so endpoints that fail together don't get retried together.
+func Backoff(n int) time.Duration {
+	const (
+		base     = 30 * time.Second
+		maxDelay = 6 * time.Hour
+	)
+
+	delay := maxDelay
+	if n < 20 {
+		delay = min(base<<n, maxDelay)
+	}
+	return delay + rand.N(delay/10+1)
+}
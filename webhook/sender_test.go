@@ -0,0 +1,154 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newTestSender returns a Sender that may connect to the loopback servers
+// httptest starts.
+func newTestSender(timeout time.Duration) *Sender {
+	return newSender(timeout, func(netip.Addr) bool { return true })
+}
+
+func TestSend(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+
+	event, err := NewEvent(EventTransferReceived, TransferData{ID: 1, FromAccountID: 2, ToAccountID: 3, Amount: 1250, Currency: "USD"})
+	require.NoError(t, err)
+	body, err := json.Marshal(event)
+	require.NoError(t, err)
+
+	received := make(chan *http.Request, 1)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		if err := Verify(secret, r.Header.Get(SignatureHeader), b, time.Minute); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		var got Event
+		require.NoError(t, json.Unmarshal(b, &got))
+		require.Equal(t, event.ID, got.ID)
+
+		received <- r
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	sender := newTestSender(5 * time.Second)
+
+	t.Run("delivered", func(t *testing.T) {
+		code, err := sender.Send(context.Background(), Request{
+			URL:        receiver.URL,
+			Secret:     secret,
+			DeliveryID: 42,
+			EventType:  event.Type,
+			Body:       body,
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNoContent, code)
+
+		r := <-received
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.Equal(t, EventTransferReceived, r.Header.Get(EventHeader))
+		require.Equal(t, "42", r.Header.Get(DeliveryHeader))
+	})
+
+	t.Run("rejected signature", func(t *testing.T) {
+		code, err := sender.Send(context.Background(), Request{
+			URL:        receiver.URL,
+			Secret:     "whsec_wrong",
+			DeliveryID: 43,
+			EventType:  event.Type,
+			Body:       body,
+		})
+		require.Error(t, err)
+		require.Equal(t, http.StatusUnauthorized, code)
+		// The response body is not echoed back.
+		require.NotContains(t, err.Error(), "signature is invalid")
+		require.Equal(t, "endpoint returned status 401", DeliveryError(code, err))
+	})
+}
+
+func TestSendFailures(t *testing.T) {
+	t.Run("server error", func(t *testing.T) {
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "boom", http.StatusInternalServerError)
+		}))
+		defer receiver.Close()
+
+		code, err := newTestSender(time.Second).Send(context.Background(), Request{URL: receiver.URL, Body: []byte(`{}`)})
+		require.Error(t, err)
+		require.Equal(t, http.StatusInternalServerError, code)
+	})
+
+	t.Run("redirect is not followed", func(t *testing.T) {
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, "http://example.com", http.StatusFound)
+		}))
+		defer receiver.Close()
+
+		code, err := newTestSender(time.Second).Send(context.Background(), Request{URL: receiver.URL, Body: []byte(`{}`)})
+		require.Error(t, err)
+		require.Equal(t, http.StatusFound, code)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(200 * time.Millisecond)
+		}))
+		defer receiver.Close()
+
+		code, err := newTestSender(50*time.Millisecond).Send(context.Background(), Request{URL: receiver.URL, Body: []byte(`{}`)})
+		require.Error(t, err)
+		require.Zero(t, code)
+		require.Equal(t, "request timed out", DeliveryError(code, err))
+	})
+
+	t.Run("internal address", func(t *testing.T) {
+		called := false
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		}))
+		defer receiver.Close()
+
+		code, err := NewSender(time.Second).Send(context.Background(), Request{URL: receiver.URL, Body: []byte(`{}`)})
+		require.ErrorIs(t, err, ErrForbiddenAddress)
+		require.Zero(t, code)
+		require.False(t, called)
+		require.Equal(t, ErrForbiddenAddress.Error(), DeliveryError(code, err))
+	})
+}
+
+func TestCheckHost(t *testing.T) {
+	for _, host := range []string{"127.0.0.1", "::1", "10.0.0.1", "169.254.169.254", "fd12::1"} {
+		require.ErrorIs(t, CheckHost(context.Background(), host), ErrForbiddenAddress, host)
+	}
+	require.NoError(t, CheckHost(context.Background(), "93.184.215.14"))
+}
+
+func TestBackoff(t *testing.T) {
+	require.GreaterOrEqual(t, Backoff(0), 30*time.Second)
+	require.Less(t, Backoff(0), 34*time.Second)
+
+	require.GreaterOrEqual(t, Backoff(3), 4*time.Minute)
+	require.Less(t, Backoff(3), 265*time.Second)
+
+	for _, n := range []int{10, 25, 100} {
+		require.GreaterOrEqual(t, Backoff(n), 6*time.Hour)
+		require.LessOrEqual(t, Backoff(n), 6*time.Hour+36*time.Minute)
+	}
+}
@@ -0,0 +1,51 @@
+package webhook
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateSecret(t *testing.T) {
+	secret1, err := GenerateSecret()
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(secret1, "whsec_"))
+
+	secret2, err := GenerateSecret()
+	require.NoError(t, err)
+	require.NotEqual(t, secret1, secret2)
+}
+
+func TestSignAndVerify(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+
+	body := []byte(`{"id":"evt","type":"transfer.sent"}`)
+	header := Sign(secret, time.Now(), body)
+	require.NoError(t, Verify(secret, header, body, 5*time.Minute))
+
+	t.Run("tampered body", func(t *testing.T) {
+		err := Verify(secret, header, []byte(`{"id":"evt","type":"transfer.received"}`), 5*time.Minute)
+		require.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("wrong secret", func(t *testing.T) {
+		other, err := GenerateSecret()
+		require.NoError(t, err)
+		require.ErrorIs(t, Verify(other, header, body, 5*time.Minute), ErrInvalidSignature)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		old := Sign(secret, time.Now().Add(-time.Hour), body)
+		require.ErrorIs(t, Verify(secret, old, body, 5*time.Minute), ErrExpiredSignature)
+		require.NoError(t, Verify(secret, old, body, 0))
+	})
+
+	t.Run("malformed header", func(t *testing.T) {
+		for _, h := range []string{"", "garbage", "t=1", "v1=abcd", "t=x,v1=abcd", "t=1,v1=zz"} {
+			require.ErrorIs(t, Verify(secret, h, body, 0), ErrInvalidSignature, h)
+		}
+	})
+}
@@ -2,18 +2,70 @@ package worker
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/a7medalyapany/GoBank.git/logger"
+	"github.com/a7medalyapany/GoBank.git/tracing"
 	"github.com/hibiken/asynq"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
 )
 
 // TaskDistributor is the producer-side interface.
 // Add a new method signature here for every new task type.
 type TaskDistributor interface {
+	// DistributeTask enqueues an already-encoded payload. The outbox relay
+	// uses it to publish any task type without knowing its payload.
+	DistributeTask(
+		ctx context.Context,
+		taskType string,
+		payload []byte,
+		opts ...asynq.Option,
+	) error
 	DistributeTaskSendVerifyEmail(
 		ctx context.Context,
 		payload *PayloadSendVerifyEmail,
 		opts ...asynq.Option,
 	) error
+	DistributeTaskSendTransferNotification(
+		ctx context.Context,
+		payload *PayloadSendTransferNotification,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskDispatchWebhookEvent(
+		ctx context.Context,
+		payload *PayloadDispatchWebhookEvent,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskDeliverWebhook(
+		ctx context.Context,
+		payload *PayloadDeliverWebhook,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskCleanupVerifyEmails(
+		ctx context.Context,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendEmailChange(
+		ctx context.Context,
+		payload *PayloadSendEmailChange,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskAnchorLedger(
+		ctx context.Context,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskAccrueInterest(
+		ctx context.Context,
+		payload *PayloadAccrueInterest,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskPostInterest(
+		ctx context.Context,
+		opts ...asynq.Option,
+	) error
 }
 
 type RedisTaskDistributor struct {
@@ -24,4 +76,42 @@ func NewRedisTaskDistributor(redisOpt asynq.RedisClientOpt) TaskDistributor {
 	return &RedisTaskDistributor{
 		client: asynq.NewClient(redisOpt),
 	}
-}
\ No newline at end of file
+}
+
+func (distributor *RedisTaskDistributor) DistributeTask(
+	ctx context.Context,
+	taskType string,
+	payload []byte,
+	opts ...asynq.Option,
+) error {
+	l := logger.G()
+
+	ctx, span := tracing.Tracer().Start(ctx, taskType+" publish",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(messagingAttributes(taskType)...),
+	)
+	defer span.End()
+
+	// The trace context travels in the task headers; see traceTask.
+	t := asynq.NewTaskWithHeaders(taskType, payload, tracing.Inject(ctx), opts...)
+
+	info, err := distributor.client.EnqueueContext(ctx, t)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+	span.SetAttributes(
+		semconv.MessagingMessageID(info.ID),
+		semconv.MessagingDestinationName(info.Queue),
+	)
+
+	l.Info("enqueued task",
+		zap.String("type", t.Type()),
+		zap.ByteString("payload", t.Payload()),
+		zap.String("queue", info.Queue),
+		zap.Int("max_retry", info.MaxRetry),
+	)
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package worker
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/hibiken/asynq"
+)
+
+// Task states that can be listed with TaskInspector.ListTasks.
+const (
+	TaskStatePending   = "pending"
+	TaskStateActive    = "active"
+	TaskStateScheduled = "scheduled"
+	TaskStateRetry     = "retry"
+	TaskStateArchived  = "archived"
+	TaskStateCompleted = "completed"
+)
+
+var taskStates = []string{
+	TaskStatePending,
+	TaskStateActive,
+	TaskStateScheduled,
+	TaskStateRetry,
+	TaskStateArchived,
+	TaskStateCompleted,
+}
+
+// IsKnownTaskState reports whether state can be passed to ListTasks.
+func IsKnownTaskState(state string) bool {
+	return slices.Contains(taskStates, state)
+}
+
+// Queues returns the queues the processor consumes, highest priority first.
+func Queues() []string {
+	return []string{QueueCritical, QueueDefault, QueueLow}
+}
+
+// TaskInspector is the operator-side interface to the task queues.
+type TaskInspector interface {
+	// ListQueues returns a snapshot of every queue in Queues plus any other
+	// queue that currently holds tasks.
+	ListQueues() ([]*asynq.QueueInfo, error)
+	// ListTasks returns one page (1-based) of the tasks in queue that are in
+	// state.
+	ListTasks(queue string, state string, pageID int, pageSize int) ([]*asynq.TaskInfo, error)
+	// RunTask moves a scheduled, retry or archived task back to pending.
+	RunTask(queue string, taskID string) error
+	// DeleteTask deletes a task that is not being processed.
+	DeleteTask(queue string, taskID string) error
+}
+
+type RedisTaskInspector struct {
+	inspector *asynq.Inspector
+}
+
+func NewRedisTaskInspector(redisOpt asynq.RedisClientOpt) TaskInspector {
+	return &RedisTaskInspector{
+		inspector: asynq.NewInspector(redisOpt),
+	}
+}
+
+func (inspector *RedisTaskInspector) ListQueues() ([]*asynq.QueueInfo, error) {
+	names, err := inspector.inspector.Queues()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list queues: %w", err)
+	}
+
+	queues := Queues()
+	for _, name := range names {
+		if !slices.Contains(queues, name) {
+			queues = append(queues, name)
+		}
+	}
+
+	infos := make([]*asynq.QueueInfo, 0, len(queues))
+	for _, queue := range queues {
+		info, err := inspector.inspector.GetQueueInfo(queue)
+		if errors.Is(err, asynq.ErrQueueNotFound) {
+			// Redis only knows a queue once a task was enqueued to it.
+			info = &asynq.QueueInfo{Queue: queue}
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to get queue %s: %w", queue, err)
+		}
+		infos = append(infos, info)
+	}
+
+	return infos, nil
+}
+
+func (inspector *RedisTaskInspector) ListTasks(queue string, state string, pageID int, pageSize int) ([]*asynq.TaskInfo, error) {
+	opts := []asynq.ListOption{asynq.Page(pageID), asynq.PageSize(pageSize)}
+
+	var (
+		tasks []*asynq.TaskInfo
+		err   error
+	)
+	switch state {
+	case TaskStatePending:
+		tasks, err = inspector.inspector.ListPendingTasks(queue, opts...)
+	case TaskStateActive:
+		tasks, err = inspector.inspector.ListActiveTasks(queue, opts...)
+	case TaskStateScheduled:
+		tasks, err = inspector.inspector.ListScheduledTasks(queue, opts...)
+	case TaskStateRetry:
+		tasks, err = inspector.inspector.ListRetryTasks(queue, opts...)
+	case TaskStateArchived:
+		tasks, err = inspector.inspector.ListArchivedTasks(queue, opts...)
+	case TaskStateCompleted:
+		tasks, err = inspector.inspector.ListCompletedTasks(queue, opts...)
+	default:
+		return nil, fmt.Errorf("unknown task state %q", state)
+	}
+	if errors.Is(err, asynq.ErrQueueNotFound) && slices.Contains(Queues(), queue) {
+		return []*asynq.TaskInfo{}, nil
+	}
+	return tasks, err
+}
+
+func (inspector *RedisTaskInspector) RunTask(queue string, taskID string) error {
+	return inspector.inspector.RunTask(queue, taskID)
+}
+
+func (inspector *RedisTaskInspector) DeleteTask(queue string, taskID string) error {
+	return inspector.inspector.DeleteTask(queue, taskID)
+}
@@ -0,0 +1,215 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
+	"github.com/a7medalyapany/GoBank.git/logger"
+	"github.com/a7medalyapany/GoBank.git/metrics"
+	"github.com/a7medalyapany/GoBank.git/tracing"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// defaultMaxRetry matches asynq's own default when no MaxRetry option is given.
+const defaultMaxRetry = 25
+
+// maxOutboxBackoff caps the wait between two attempts to publish a message.
+const maxOutboxBackoff = time.Hour
+
+// NewOutboxMessage encodes a task so it can be written to the outbox inside a
+// Store transaction. Only options that can be stored on the outbox row are
+// accepted: Queue, MaxRetry, ProcessIn and ProcessAt.
+func NewOutboxMessage(taskType string, payload any, opts ...asynq.Option) (db.CreateOutboxMessageParams, error) {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return db.CreateOutboxMessageParams{}, fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	message := db.CreateOutboxMessageParams{
+		TaskType: taskType,
+		Payload:  jsonPayload,
+		Queue:    QueueDefault,
+		MaxRetry: defaultMaxRetry,
+	}
+
+	for _, opt := range opts {
+		switch opt.Type() {
+		case asynq.QueueOpt:
+			message.Queue = opt.Value().(string)
+		case asynq.MaxRetryOpt:
+			message.MaxRetry = int32(opt.Value().(int))
+		case asynq.ProcessInOpt:
+			processAt := time.Now().Add(opt.Value().(time.Duration))
+			message.ProcessAt = pgtype.Timestamptz{Time: processAt, Valid: true}
+		case asynq.ProcessAtOpt:
+			message.ProcessAt = pgtype.Timestamptz{Time: opt.Value().(time.Time), Valid: true}
+		default:
+			return db.CreateOutboxMessageParams{}, fmt.Errorf("unsupported outbox task option: %s", opt)
+		}
+	}
+
+	return message, nil
+}
+
+// OutboxRelayConfig controls how often the relay polls, how it retries
+// messages it failed to publish and how long published messages are kept
+// around for debugging.
+type OutboxRelayConfig struct {
+	PollInterval time.Duration
+	BatchSize    int32
+	Retention    time.Duration
+	// MaxAttempts is how many failed publishes make a message dead.
+	MaxAttempts int32
+	// RetryBackoff is the wait after the first failure. It doubles with every
+	// further failure, up to an hour.
+	RetryBackoff time.Duration
+}
+
+// backoff returns how long to wait after a message failed attempts times.
+func (config OutboxRelayConfig) backoff(attempts int32) time.Duration {
+	delay := config.RetryBackoff
+	for i := int32(1); i < attempts && delay < maxOutboxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, maxOutboxBackoff)
+}
+
+// OutboxRelay moves messages from the outbox table to the task queue.
+//
+// Delivery is at-least-once: a message is marked published only after Redis
+// accepted it, so a crash in between publishes it again on the next poll.
+// Every task gets the id "outbox:<row id>", which lets asynq drop a duplicate
+// while the first copy is still queued. Task handlers must still be
+// idempotent.
+//
+// A message that cannot be published is retried with exponential backoff.
+// After MaxAttempts failures it is marked dead, logged as an error and left in
+// the table for an operator to inspect.
+type OutboxRelay struct {
+	store       *db.Store
+	distributor TaskDistributor
+	config      OutboxRelayConfig
+}
+
+func NewOutboxRelay(store *db.Store, distributor TaskDistributor, config OutboxRelayConfig) *OutboxRelay {
+	return &OutboxRelay{
+		store:       store,
+		distributor: distributor,
+		config:      config,
+	}
+}
+
+// Run polls the outbox until ctx is cancelled.
+func (relay *OutboxRelay) Run(ctx context.Context) {
+	l := logger.G()
+
+	ticker := time.NewTicker(relay.config.PollInterval)
+	defer ticker.Stop()
+
+	lastCleanup := time.Now()
+
+	for {
+		// Keep draining while full batches come back, then wait for the next tick.
+		for {
+			result, err := relay.publishBatch(ctx)
+			if err != nil {
+				l.Error("failed to publish outbox messages", zap.Error(err))
+				break
+			}
+			if result.Published+result.Failed < int(relay.config.BatchSize) || result.Published == 0 {
+				break
+			}
+		}
+
+		if relay.config.Retention > 0 && time.Since(lastCleanup) >= time.Hour {
+			relay.cleanup(ctx)
+			lastCleanup = time.Now()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (relay *OutboxRelay) publishBatch(ctx context.Context) (db.PublishOutboxTxResult, error) {
+	l := logger.G()
+
+	result, err := relay.store.PublishOutboxTx(ctx, db.PublishOutboxTxParams{
+		BatchSize:   relay.config.BatchSize,
+		MaxAttempts: relay.config.MaxAttempts,
+		Backoff:     relay.config.backoff,
+		Publish: func(message db.Outbox) error {
+			// Publish under the trace of the request that wrote the message.
+			var headers map[string]string
+			if err := json.Unmarshal(message.Headers, &headers); err != nil {
+				l.Warn("ignoring malformed outbox headers", zap.Int64("outbox_id", message.ID), zap.Error(err))
+			}
+
+			err := relay.distributor.DistributeTask(tracing.Extract(ctx, headers), message.TaskType, message.Payload,
+				asynq.TaskID(fmt.Sprintf("outbox:%d", message.ID)),
+				asynq.Queue(message.Queue),
+				asynq.MaxRetry(int(message.MaxRetry)),
+				asynq.ProcessAt(message.ProcessAt.Time),
+			)
+			if errors.Is(err, asynq.ErrTaskIDConflict) {
+				// Already enqueued by an earlier attempt whose commit was lost.
+				return nil
+			}
+			if err != nil {
+				l.Warn("failed to publish outbox message",
+					zap.Int64("outbox_id", message.ID),
+					zap.String("type", message.TaskType),
+					zap.Int32("attempts", message.Attempts),
+					zap.Error(err),
+				)
+			}
+			return err
+		},
+	})
+	if err != nil {
+		return result, err
+	}
+
+	for _, message := range result.Dead {
+		l.Error("gave up publishing outbox message",
+			zap.Int64("outbox_id", message.ID),
+			zap.String("type", message.TaskType),
+			zap.Int32("attempts", message.Attempts),
+			zap.String("last_error", message.LastError.String),
+		)
+		metrics.ObserveOutboxDead(message.TaskType)
+	}
+
+	if result.Published > 0 || result.Failed > 0 {
+		l.Debug("published outbox messages",
+			zap.Int("published", result.Published),
+			zap.Int("failed", result.Failed),
+		)
+	}
+
+	return result, nil
+}
+
+func (relay *OutboxRelay) cleanup(ctx context.Context) {
+	l := logger.G()
+
+	before := time.Now().Add(-relay.config.Retention)
+	deleted, err := relay.store.DeletePublishedOutboxMessages(ctx, pgtype.Timestamptz{Time: before, Valid: true})
+	if err != nil {
+		l.Error("failed to delete published outbox messages", zap.Error(err))
+		return
+	}
+
+	if deleted > 0 {
+		l.Info("deleted published outbox messages", zap.Int64("count", deleted))
+	}
+}
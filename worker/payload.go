@@ -1,5 +1,7 @@
 package worker
 
+import "github.com/a7medalyapany/GoBank.git/webhook"
+
 // Queue priority names — used when enqueuing tasks (distributor side)
 // and when configuring the server (processor side).
 const (
@@ -11,7 +13,15 @@ const (
 // Task type constants — one per task.
 // Add new constants here as you add new task files.
 const (
-	TaskSendVerifyEmail = "task:send_verify_email"
+	TaskSendVerifyEmail          = "task:send_verify_email"
+	TaskSendTransferNotification = "task:send_transfer_notification"
+	TaskDispatchWebhookEvent     = "task:dispatch_webhook_event"
+	TaskDeliverWebhook           = "task:deliver_webhook"
+	TaskCleanupVerifyEmails      = "task:cleanup_verify_emails"
+	TaskSendEmailChange          = "task:send_email_change"
+	TaskAnchorLedger             = "task:anchor_ledger"
+	TaskAccrueInterest           = "task:accrue_interest"
+	TaskPostInterest             = "task:post_interest"
 )
 
 // PayloadSendVerifyEmail carries the minimum data needed to process the task.
@@ -19,4 +29,62 @@ const (
 // store the full user object here to avoid stale data.
 type PayloadSendVerifyEmail struct {
 	Username string `json:"username"`
-}
\ No newline at end of file
+	// VerifyEmailID is set when the code was already created, e.g. by
+	// ResendVerifyEmail. Otherwise the worker creates one.
+	VerifyEmailID int64 `json:"verify_email_id,omitempty"`
+}
+
+// Email change recipients — which address an email change message goes to.
+const (
+	EmailChangeRecipientNew = "new" // confirmation link
+	EmailChangeRecipientOld = "old" // notice with a cancellation link
+)
+
+// PayloadSendEmailChange sends one of the two email change messages. Each
+// address gets its own task so a retry for one never repeats the other.
+type PayloadSendEmailChange struct {
+	EmailChangeID int64  `json:"email_change_id"`
+	Recipient     string `json:"recipient"`
+}
+
+// Transfer directions — which side of a transfer a notification is for.
+const (
+	TransferDirectionSent     = "sent"
+	TransferDirectionReceived = "received"
+)
+
+// Notification types stored in notifications.type.
+const (
+	NotificationTransferSent     = "transfer_sent"
+	NotificationTransferReceived = "transfer_received"
+)
+
+// PayloadSendTransferNotification notifies one member of one side of a
+// transfer. Every member of each account gets a separate task so a retry for
+// one never repeats the others.
+type PayloadSendTransferNotification struct {
+	TransferID int64  `json:"transfer_id"`
+	Direction  string `json:"direction"`
+	// Username is the member to notify. Tasks queued before members were
+	// notified leave it empty and go to the account owner.
+	Username string `json:"username,omitempty"`
+}
+
+// PayloadDispatchWebhookEvent fans an event out to the user's endpoints that
+// subscribe to its type. Unlike other payloads it carries the full event:
+// webhooks describe what happened at the time, not the current state.
+type PayloadDispatchWebhookEvent struct {
+	Username string        `json:"username"`
+	Event    webhook.Event `json:"event"`
+}
+
+// PayloadDeliverWebhook sends one delivery to its endpoint.
+type PayloadDeliverWebhook struct {
+	DeliveryID int64 `json:"delivery_id"`
+}
+
+// PayloadAccrueInterest names the day to accrue savings interest for, as
+// YYYY-MM-DD. The scheduler leaves it empty, which means yesterday (UTC).
+type PayloadAccrueInterest struct {
+	Date string `json:"date,omitempty"`
+}
@@ -2,11 +2,14 @@ package worker
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
 	"github.com/a7medalyapany/GoBank.git/logger"
 	"github.com/a7medalyapany/GoBank.git/mail"
 	"github.com/a7medalyapany/GoBank.git/util"
+	"github.com/a7medalyapany/GoBank.git/webhook"
 	"github.com/hibiken/asynq"
 	"go.uber.org/zap"
 )
@@ -16,17 +19,26 @@ import (
 type TaskProcessor interface {
 	Start() error
 	ProcessTaskSendVerifyEmail(ctx context.Context, t *asynq.Task) error
+	ProcessTaskSendTransferNotification(ctx context.Context, t *asynq.Task) error
+	ProcessTaskDispatchWebhookEvent(ctx context.Context, t *asynq.Task) error
+	ProcessTaskDeliverWebhook(ctx context.Context, t *asynq.Task) error
+	ProcessTaskCleanupVerifyEmails(ctx context.Context, t *asynq.Task) error
+	ProcessTaskSendEmailChange(ctx context.Context, t *asynq.Task) error
+	ProcessTaskAnchorLedger(ctx context.Context, t *asynq.Task) error
+	ProcessTaskAccrueInterest(ctx context.Context, t *asynq.Task) error
+	ProcessTaskPostInterest(ctx context.Context, t *asynq.Task) error
 }
 
 type RedisTaskProcessor struct {
 	server *asynq.Server
 	store  *db.Store
 	mailer mail.EmailSender 
+	templates *mail.Templates
+	webhooks  *webhook.Sender
 	config util.Config
 }
 
-func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store *db.Store, mailer mail.EmailSender, config util.Config) TaskProcessor {
-	l := logger.G()
+func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store *db.Store, mailer mail.EmailSender, templates *mail.Templates, config util.Config) TaskProcessor {
 	server := asynq.NewServer(
 		redisOpt,
 		asynq.Config{
@@ -36,12 +48,9 @@ func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store *db.Store, maile
 				QueueDefault:  5,
 				QueueLow:      1,
 			},
-			ErrorHandler: asynq.ErrorHandlerFunc(
-				func(ctx context.Context, task *asynq.Task, err error) {
-					l.Error("failed to process task:", zap.String("type", task.Type()), zap.String("payload", string(task.Payload())), zap.Error(err))
-				},
-			),
-			Logger: NewLogger(),
+			ErrorHandler: asynq.ErrorHandlerFunc(handleTaskError),
+			RetryDelayFunc: retryDelay,
+			Logger:         NewLogger(),
 		},
 	)
 
@@ -49,16 +58,64 @@ func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store *db.Store, maile
 		server: server,
 		store:  store,
 		mailer: mailer,
+		templates: templates,
+		webhooks:  webhook.NewSender(config.WEBHOOK_TIMEOUT),
 		 config: config,
 	}
 }
 
+// handleTaskError logs a failed attempt. Retries are warnings; the last
+// attempt (or a SkipRetry) archives the task, which operators can find and
+// re-run with the admin queue RPCs.
+func handleTaskError(ctx context.Context, task *asynq.Task, err error) {
+	l := logger.G()
+
+	taskID, _ := asynq.GetTaskID(ctx)
+	queue, _ := asynq.GetQueueName(ctx)
+	retried, _ := asynq.GetRetryCount(ctx)
+	maxRetry, _ := asynq.GetMaxRetry(ctx)
+
+	fields := []zap.Field{
+		zap.String("type", task.Type()),
+		zap.String("task_id", taskID),
+		zap.String("queue", queue),
+		zap.Int("retried", retried),
+		zap.Int("max_retry", maxRetry),
+		zap.String("payload", string(task.Payload())),
+		zap.Error(err),
+	}
+
+	if retried >= maxRetry || errors.Is(err, asynq.SkipRetry) {
+		l.Error("task failed and was archived", fields...)
+		return
+	}
+	l.Warn("task failed, will retry", fields...)
+}
+
+// retryDelay backs webhook deliveries off exponentially so a struggling
+// endpoint gets time to recover; other tasks use asynq's default.
+func retryDelay(n int, err error, t *asynq.Task) time.Duration {
+	if t.Type() == TaskDeliverWebhook {
+		return webhook.Backoff(n)
+	}
+	return asynq.DefaultRetryDelayFunc(n, err, t)
+}
+
 // Start registers all task handlers and begins polling Redis.
 // Add one mux.HandleFunc line here for every new task type.
 func (processor *RedisTaskProcessor) Start() error {
 	mux := asynq.NewServeMux()
+	mux.Use(traceTask)
 
 	mux.HandleFunc(TaskSendVerifyEmail, processor.ProcessTaskSendVerifyEmail)
+	mux.HandleFunc(TaskSendTransferNotification, processor.ProcessTaskSendTransferNotification)
+	mux.HandleFunc(TaskDispatchWebhookEvent, processor.ProcessTaskDispatchWebhookEvent)
+	mux.HandleFunc(TaskDeliverWebhook, processor.ProcessTaskDeliverWebhook)
+	mux.HandleFunc(TaskCleanupVerifyEmails, processor.ProcessTaskCleanupVerifyEmails)
+	mux.HandleFunc(TaskSendEmailChange, processor.ProcessTaskSendEmailChange)
+	mux.HandleFunc(TaskAnchorLedger, processor.ProcessTaskAnchorLedger)
+	mux.HandleFunc(TaskAccrueInterest, processor.ProcessTaskAccrueInterest)
+	mux.HandleFunc(TaskPostInterest, processor.ProcessTaskPostInterest)
 
 	return processor.server.Start(mux)
 }
\ No newline at end of file
@@ -0,0 +1,75 @@
+package worker
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/a7medalyapany/GoBank.git/util"
+	"github.com/hibiken/asynq"
+)
+
+// TaskScheduler enqueues periodic tasks.
+type TaskScheduler interface {
+	Start() error
+}
+
+type RedisTaskScheduler struct {
+	scheduler *asynq.Scheduler
+}
+
+// NewRedisTaskScheduler registers every periodic task. Each app instance runs
+// its own scheduler; the Unique option stops replicas from enqueueing the same
+// run twice.
+func NewRedisTaskScheduler(redisOpt asynq.RedisClientOpt, config util.Config) (TaskScheduler, error) {
+	scheduler := asynq.NewScheduler(redisOpt, &asynq.SchedulerOpts{
+		Location: time.UTC,
+		Logger:   NewLogger(),
+	})
+
+	if _, err := scheduler.Register(
+		config.VERIFY_EMAIL_CLEANUP_SCHEDULE,
+		asynq.NewTask(TaskCleanupVerifyEmails, nil),
+		asynq.Queue(QueueLow),
+		asynq.MaxRetry(3),
+		asynq.Unique(time.Minute),
+	); err != nil {
+		return nil, fmt.Errorf("failed to schedule %s: %w", TaskCleanupVerifyEmails, err)
+	}
+
+	if _, err := scheduler.Register(
+		config.LEDGER_ANCHOR_SCHEDULE,
+		asynq.NewTask(TaskAnchorLedger, nil),
+		asynq.Queue(QueueDefault),
+		asynq.MaxRetry(3),
+		asynq.Unique(time.Minute),
+	); err != nil {
+		return nil, fmt.Errorf("failed to schedule %s: %w", TaskAnchorLedger, err)
+	}
+
+	if _, err := scheduler.Register(
+		config.INTEREST_ACCRUAL_SCHEDULE,
+		asynq.NewTask(TaskAccrueInterest, nil),
+		asynq.Queue(QueueDefault),
+		asynq.MaxRetry(5),
+		asynq.Unique(time.Hour),
+	); err != nil {
+		return nil, fmt.Errorf("failed to schedule %s: %w", TaskAccrueInterest, err)
+	}
+
+	if _, err := scheduler.Register(
+		config.INTEREST_POSTING_SCHEDULE,
+		asynq.NewTask(TaskPostInterest, nil),
+		asynq.Queue(QueueDefault),
+		asynq.MaxRetry(5),
+		asynq.Unique(time.Hour),
+	); err != nil {
+		return nil, fmt.Errorf("failed to schedule %s: %w", TaskPostInterest, err)
+	}
+
+	return &RedisTaskScheduler{scheduler: scheduler}, nil
+}
+
+// Start begins enqueueing periodic tasks in the background.
+func (scheduler *RedisTaskScheduler) Start() error {
+	return scheduler.scheduler.Start()
+}
@@ -0,0 +1,115 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
+	"github.com/a7medalyapany/GoBank.git/logger"
+	"github.com/a7medalyapany/GoBank.git/util"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// interestBatchSize is how many accounts the interest tasks read at a time.
+const interestBatchSize = 500
+
+// ─── Distribute (producer side)
+
+// DistributeTaskAccrueInterest enqueues an accrual run. The scheduler
+// normally does this; the method exists for manual runs, e.g. for a day the
+// scheduler missed.
+func (distributor *RedisTaskDistributor) DistributeTaskAccrueInterest(
+	ctx context.Context,
+	payload *PayloadAccrueInterest,
+	opts ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	return distributor.DistributeTask(ctx, TaskAccrueInterest, jsonPayload, opts...)
+}
+
+// ─── Process (consumer side)
+
+// ProcessTaskAccrueInterest records one day of interest for every savings
+// account in a currency with a rate. The balance when the task runs, shortly
+// after midnight UTC, stands in for the balance at the end of the day. Each
+// account accrues at most once per day, so the task is safe to retry.
+func (processor *RedisTaskProcessor) ProcessTaskAccrueInterest(ctx context.Context, t *asynq.Task) error {
+	l := logger.G()
+
+	var payload PayloadAccrueInterest
+	if len(t.Payload()) > 0 {
+		if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+			return fmt.Errorf("failed to unmarshal payload: %w", err)
+		}
+	}
+
+	day := time.Now().UTC().AddDate(0, 0, -1).Truncate(24 * time.Hour)
+	if payload.Date != "" {
+		var err error
+		day, err = time.Parse(time.DateOnly, payload.Date)
+		if err != nil {
+			return fmt.Errorf("invalid date %q: %w", payload.Date, asynq.SkipRetry)
+		}
+	}
+	accrualDate := pgtype.Date{Time: day, Valid: true}
+
+	var accrued, skipped int64
+	var afterID int64
+	for {
+		accounts, err := processor.store.ListSavingsAccounts(ctx, db.ListSavingsAccountsParams{
+			AfterID:  afterID,
+			LimitArg: interestBatchSize,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list savings accounts: %w", err)
+		}
+		if len(accounts) == 0 {
+			break
+		}
+
+		for _, account := range accounts {
+			rate := util.InterestRate(account.Currency)
+			amount := util.DailyInterestMicros(account.Balance, rate)
+			if amount == 0 {
+				continue
+			}
+
+			_, err := processor.store.CreateInterestAccrual(ctx, db.CreateInterestAccrualParams{
+				AccountID:     account.ID,
+				AccrualDate:   accrualDate,
+				Balance:       account.Balance,
+				AnnualRateBps: rate,
+				AmountMicros:  amount,
+			})
+			switch {
+			case err == nil:
+				accrued++
+			case errors.Is(err, pgx.ErrNoRows):
+				// Already accrued by an earlier attempt.
+				skipped++
+			default:
+				return fmt.Errorf("failed to accrue interest for account %d: %w", account.ID, err)
+			}
+		}
+		afterID = accounts[len(accounts)-1].ID
+	}
+
+	l.Info("processed task",
+		zap.String("type", t.Type()),
+		zap.String("date", day.Format(time.DateOnly)),
+		zap.Int64("accrued", accrued),
+		zap.Int64("already_accrued", skipped),
+	)
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/a7medalyapany/GoBank.git/logger"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// ─── Distribute (producer side)
+
+// DistributeTaskCleanupVerifyEmails enqueues a cleanup run. The scheduler
+// normally does this; the method exists for manual runs.
+func (distributor *RedisTaskDistributor) DistributeTaskCleanupVerifyEmails(
+	ctx context.Context,
+	opts ...asynq.Option,
+) error {
+	return distributor.DistributeTask(ctx, TaskCleanupVerifyEmails, nil, opts...)
+}
+
+// ─── Process (consumer side)
+
+func (processor *RedisTaskProcessor) ProcessTaskCleanupVerifyEmails(ctx context.Context, t *asynq.Task) error {
+	l := logger.G()
+
+	deleted, err := processor.store.DeleteExpiredVerifyEmails(ctx, pgtype.Timestamptz{Time: time.Now(), Valid: true})
+	if err != nil {
+		return fmt.Errorf("failed to delete expired verify emails: %w", err)
+	}
+
+	l.Info("processed task",
+		zap.String("type", t.Type()),
+		zap.Int64("deleted", deleted),
+	)
+
+	return nil
+}
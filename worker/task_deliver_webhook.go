@@ -0,0 +1,130 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
+	"github.com/a7medalyapany/GoBank.git/logger"
+	"github.com/a7medalyapany/GoBank.git/webhook"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// NewWebhookDeliveryMessage builds the outbox message that sends delivery
+// deliveryID, retrying up to maxRetry times with webhook.Backoff.
+func NewWebhookDeliveryMessage(deliveryID int64, maxRetry int) (db.CreateOutboxMessageParams, error) {
+	return NewOutboxMessage(TaskDeliverWebhook, &PayloadDeliverWebhook{
+		DeliveryID: deliveryID,
+	}, asynq.MaxRetry(maxRetry), asynq.Queue(QueueDefault))
+}
+
+// ─── Distribute (producer side)
+
+func (distributor *RedisTaskDistributor) DistributeTaskDeliverWebhook(
+	ctx context.Context,
+	payload *PayloadDeliverWebhook,
+	opts ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	return distributor.DistributeTask(ctx, TaskDeliverWebhook, jsonPayload, opts...)
+}
+
+// ─── Process (consumer side)
+
+func (processor *RedisTaskProcessor) ProcessTaskDeliverWebhook(ctx context.Context, t *asynq.Task) error {
+	l := logger.G()
+
+	var payload PayloadDeliverWebhook
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+
+	delivery, err := processor.store.GetWebhookDelivery(ctx, payload.DeliveryID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// The endpoint was deleted, taking its deliveries with it.
+			return fmt.Errorf("webhook delivery does not exist: %w", asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to get webhook delivery: %w", err)
+	}
+
+	if delivery.Status == webhook.DeliveryStatusSucceeded {
+		// Duplicate task from the outbox relay; the endpoint already has it.
+		return nil
+	}
+
+	endpoint, err := processor.store.GetWebhookEndpoint(ctx, delivery.EndpointID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("webhook endpoint does not exist: %w", asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to get webhook endpoint: %w", err)
+	}
+
+	statusCode, sendErr := processor.webhooks.Send(ctx, webhook.Request{
+		URL:        endpoint.Url,
+		Secret:     endpoint.Secret,
+		DeliveryID: delivery.ID,
+		EventType:  delivery.EventType,
+		Body:       delivery.Payload,
+	})
+
+	responseStatus := pgtype.Int4{Int32: int32(statusCode), Valid: statusCode != 0}
+
+	if sendErr == nil {
+		err = processor.store.MarkWebhookDeliverySucceeded(ctx, db.MarkWebhookDeliverySucceededParams{
+			ID:             delivery.ID,
+			ResponseStatus: responseStatus,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to mark webhook delivery succeeded: %w", err)
+		}
+
+		l.Info("processed task",
+			zap.String("type", t.Type()),
+			zap.Int64("delivery_id", delivery.ID),
+			zap.Int64("endpoint_id", endpoint.ID),
+			zap.Int("status_code", statusCode),
+		)
+		return nil
+	}
+
+	// Once asynq has no retries left the delivery goes to the dead-letter
+	// log, from where it can be replayed with ReplayWebhookDelivery.
+	status := webhook.DeliveryStatusPending
+	retried, _ := asynq.GetRetryCount(ctx)
+	maxRetry, _ := asynq.GetMaxRetry(ctx)
+	if retried >= maxRetry {
+		status = webhook.DeliveryStatusDead
+	}
+
+	err = processor.store.MarkWebhookDeliveryFailed(ctx, db.MarkWebhookDeliveryFailedParams{
+		Status:         status,
+		ResponseStatus: responseStatus,
+		LastError:      pgtype.Text{String: webhook.DeliveryError(statusCode, sendErr), Valid: true},
+		ID:             delivery.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mark webhook delivery failed: %w", err)
+	}
+
+	if status == webhook.DeliveryStatusDead {
+		l.Warn("webhook delivery moved to dead-letter log",
+			zap.Int64("delivery_id", delivery.ID),
+			zap.Int64("endpoint_id", endpoint.ID),
+			zap.Int32("attempts", delivery.Attempts+1),
+			zap.Error(sendErr),
+		)
+	}
+
+	return fmt.Errorf("failed to deliver webhook: %w", sendErr)
+}
@@ -0,0 +1,104 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
+	"github.com/a7medalyapany/GoBank.git/logger"
+	"github.com/a7medalyapany/GoBank.git/webhook"
+	"github.com/hibiken/asynq"
+	"go.uber.org/zap"
+)
+
+// NewWebhookEventMessage builds the outbox message that publishes a webhook
+// event for username. Write it in the same transaction as the change it
+// describes.
+func NewWebhookEventMessage(username string, eventType string, data any) (db.CreateOutboxMessageParams, error) {
+	event, err := webhook.NewEvent(eventType, data)
+	if err != nil {
+		return db.CreateOutboxMessageParams{}, fmt.Errorf("failed to create webhook event: %w", err)
+	}
+
+	return NewOutboxMessage(TaskDispatchWebhookEvent, &PayloadDispatchWebhookEvent{
+		Username: username,
+		Event:    event,
+	}, asynq.Queue(QueueLow))
+}
+
+// ─── Distribute (producer side)
+
+func (distributor *RedisTaskDistributor) DistributeTaskDispatchWebhookEvent(
+	ctx context.Context,
+	payload *PayloadDispatchWebhookEvent,
+	opts ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	return distributor.DistributeTask(ctx, TaskDispatchWebhookEvent, jsonPayload, opts...)
+}
+
+// ─── Process (consumer side)
+
+func (processor *RedisTaskProcessor) ProcessTaskDispatchWebhookEvent(ctx context.Context, t *asynq.Task) error {
+	l := logger.G()
+
+	var payload PayloadDispatchWebhookEvent
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+
+	body, err := json.Marshal(payload.Event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook event: %w", err)
+	}
+
+	var deliveries int
+	err = processor.store.OutboxTx(ctx, func(q *db.Queries) ([]db.CreateOutboxMessageParams, error) {
+		endpoints, err := q.ListWebhookEndpointsForEvent(ctx, db.ListWebhookEndpointsForEventParams{
+			Username:  payload.Username,
+			EventType: payload.Event.Type,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list webhook endpoints: %w", err)
+		}
+
+		var messages []db.CreateOutboxMessageParams
+		for _, endpoint := range endpoints {
+			delivery, err := q.CreateWebhookDelivery(ctx, db.CreateWebhookDeliveryParams{
+				EndpointID: endpoint.ID,
+				EventID:    payload.Event.ID,
+				EventType:  payload.Event.Type,
+				Payload:    body,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to create webhook delivery: %w", err)
+			}
+
+			message, err := NewWebhookDeliveryMessage(delivery.ID, processor.config.WEBHOOK_MAX_RETRY)
+			if err != nil {
+				return nil, err
+			}
+			messages = append(messages, message)
+		}
+
+		deliveries = len(messages)
+		return messages, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	l.Info("processed task",
+		zap.String("type", t.Type()),
+		zap.String("event_id", payload.Event.ID),
+		zap.String("event_type", payload.Event.Type),
+		zap.Int("deliveries", deliveries),
+	)
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
+	"github.com/a7medalyapany/GoBank.git/logger"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// ─── Distribute (producer side)
+
+// DistributeTaskPostInterest enqueues a posting run. The scheduler normally
+// does this; the method exists for manual runs.
+func (distributor *RedisTaskDistributor) DistributeTaskPostInterest(
+	ctx context.Context,
+	opts ...asynq.Option,
+) error {
+	return distributor.DistributeTask(ctx, TaskPostInterest, nil, opts...)
+}
+
+// ─── Process (consumer side)
+
+// ProcessTaskPostInterest credits every account with the interest it accrued
+// before the current month (UTC). An account that fails does not stop the
+// others; the task then fails so a retry picks it up, and accounts already
+// posted have nothing left to post.
+func (processor *RedisTaskProcessor) ProcessTaskPostInterest(ctx context.Context, t *asynq.Task) error {
+	l := logger.G()
+
+	now := time.Now().UTC()
+	before := pgtype.Date{Time: time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC), Valid: true}
+
+	var posted, failed int64
+	var afterID int64
+	for {
+		accountIDs, err := processor.store.ListUnpostedInterestAccounts(ctx, db.ListUnpostedInterestAccountsParams{
+			Before:   before,
+			AfterID:  afterID,
+			LimitArg: interestBatchSize,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list accounts with unposted interest: %w", err)
+		}
+		if len(accountIDs) == 0 {
+			break
+		}
+
+		for _, accountID := range accountIDs {
+			result, err := processor.store.PostInterestTx(ctx, db.PostInterestTxParams{
+				AccountID: accountID,
+				Before:    before,
+			})
+			switch {
+			case err == nil:
+				posted++
+				l.Debug("posted interest",
+					zap.Int64("account_id", accountID),
+					zap.Int64("posting_id", result.Posting.ID),
+					zap.Int64("amount", result.Posting.Amount),
+					zap.Int64("carry_micros", result.Posting.CarryMicros),
+				)
+			case errors.Is(err, db.ErrNoUnpostedInterest):
+				// Posted by a concurrent run.
+			default:
+				failed++
+				l.Error("failed to post interest", zap.Int64("account_id", accountID), zap.Error(err))
+			}
+		}
+		afterID = accountIDs[len(accountIDs)-1]
+	}
+
+	l.Info("processed task",
+		zap.String("type", t.Type()),
+		zap.String("before", before.Time.Format(time.DateOnly)),
+		zap.Int64("posted", posted),
+		zap.Int64("failed", failed),
+	)
+
+	if failed > 0 {
+		return fmt.Errorf("failed to post interest for %d accounts", failed)
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+
+	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
+	"github.com/a7medalyapany/GoBank.git/logger"
+	"github.com/a7medalyapany/GoBank.git/mail"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// NewEmailChangeMessages builds the outbox messages for a new email change:
+// a confirmation link to the new address and a notice to the old one.
+func NewEmailChangeMessages(emailChangeID int64) ([]db.CreateOutboxMessageParams, error) {
+	messages := make([]db.CreateOutboxMessageParams, 0, 2)
+	for _, recipient := range []string{EmailChangeRecipientNew, EmailChangeRecipientOld} {
+		message, err := NewOutboxMessage(TaskSendEmailChange, &PayloadSendEmailChange{
+			EmailChangeID: emailChangeID,
+			Recipient:     recipient,
+		}, asynq.MaxRetry(10), asynq.Queue(QueueCritical))
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+	return messages, nil
+}
+
+// ─── Distribute (producer side)
+
+func (distributor *RedisTaskDistributor) DistributeTaskSendEmailChange(
+	ctx context.Context,
+	payload *PayloadSendEmailChange,
+	opts ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	return distributor.DistributeTask(ctx, TaskSendEmailChange, jsonPayload, opts...)
+}
+
+// ─── Process (consumer side)
+
+func (processor *RedisTaskProcessor) ProcessTaskSendEmailChange(ctx context.Context, t *asynq.Task) error {
+	l := logger.G()
+
+	var payload PayloadSendEmailChange
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+
+	change, err := processor.store.GetEmailChange(ctx, payload.EmailChangeID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("email change does not exist: %w", asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to get email change: %w", err)
+	}
+
+	// The confirmation link is useless once the change is no longer pending.
+	// The notice still goes out after a quick confirmation, since its link is
+	// what lets the old address revert the change.
+	if change.Status == db.EmailChangeCancelled ||
+		(payload.Recipient == EmailChangeRecipientNew && change.Status != db.EmailChangePending) {
+		l.Info("skipping email change message",
+			zap.Int64("email_change_id", change.ID),
+			zap.String("recipient", payload.Recipient),
+			zap.String("status", change.Status),
+		)
+		return nil
+	}
+
+	user, err := processor.store.GetUser(ctx, change.Username)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	query := url.Values{}
+	query.Set("id", fmt.Sprint(change.ID))
+
+	var (
+		templateName string
+		to           string
+		link         string
+	)
+	switch payload.Recipient {
+	case EmailChangeRecipientNew:
+		query.Set("code", change.SecretCode)
+		templateName, to = mail.TemplateEmailChangeVerify, change.NewEmail
+		link = processor.config.BASE_URL + "/confirm-email-change?" + query.Encode()
+	case EmailChangeRecipientOld:
+		query.Set("code", change.CancelCode)
+		templateName, to = mail.TemplateEmailChangeNotice, change.OldEmail
+		link = processor.config.BASE_URL + "/cancel-email-change?" + query.Encode()
+	default:
+		return fmt.Errorf("unknown email change recipient %q: %w", payload.Recipient, asynq.SkipRetry)
+	}
+
+	msg, err := processor.templates.Render(templateName, user.Locale, mail.EmailChangeData{
+		FullName:       user.FullName,
+		OldEmail:       change.OldEmail,
+		NewEmail:       change.NewEmail,
+		URL:            link,
+		ExpiresInHours: int(change.ExpiresAt.Time.Sub(change.CreatedAt.Time).Hours()),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to render email change email: %w", err)
+	}
+	msg.To = []string{to}
+
+	if err := processor.mailer.SendMessage(msg); err != nil {
+		return fmt.Errorf("failed to send email change email: %w", err)
+	}
+
+	l.Info("processed task",
+		zap.String("type", t.Type()),
+		zap.ByteString("payload", t.Payload()),
+		zap.String("username", user.Username),
+		zap.Int64("email_change_id", change.ID),
+	)
+
+	return nil
+}
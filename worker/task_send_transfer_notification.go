@@ -0,0 +1,159 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
+	"github.com/a7medalyapany/GoBank.git/logger"
+	"github.com/a7medalyapany/GoBank.git/mail"
+	"github.com/a7medalyapany/GoBank.git/util"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// ─── Distribute (producer side)
+
+func (distributor *RedisTaskDistributor) DistributeTaskSendTransferNotification(
+	ctx context.Context,
+	payload *PayloadSendTransferNotification,
+	opts ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	return distributor.DistributeTask(ctx, TaskSendTransferNotification, jsonPayload, opts...)
+}
+
+// ─── Process (consumer side)
+
+func (processor *RedisTaskProcessor) ProcessTaskSendTransferNotification(ctx context.Context, t *asynq.Task) error {
+	l := logger.G()
+
+	var payload PayloadSendTransferNotification
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+
+	transfer, err := processor.store.GetTransfer(ctx, payload.TransferID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("transfer does not exist: %w", asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to get transfer: %w", err)
+	}
+
+	var (
+		accountID             int64
+		counterpartyAccountID int64
+		notificationType      string
+		templateName          string
+	)
+	switch payload.Direction {
+	case TransferDirectionSent:
+		accountID, counterpartyAccountID = transfer.FromAccountID, transfer.ToAccountID
+		notificationType, templateName = NotificationTransferSent, mail.TemplateTransferSent
+	case TransferDirectionReceived:
+		accountID, counterpartyAccountID = transfer.ToAccountID, transfer.FromAccountID
+		notificationType, templateName = NotificationTransferReceived, mail.TemplateTransferReceived
+	default:
+		return fmt.Errorf("unknown transfer direction %q: %w", payload.Direction, asynq.SkipRetry)
+	}
+
+	account, err := processor.store.GetAccount(ctx, accountID)
+	if err != nil {
+		return fmt.Errorf("failed to get account: %w", err)
+	}
+	counterparty, err := processor.store.GetAccount(ctx, counterpartyAccountID)
+	if err != nil {
+		return fmt.Errorf("failed to get counterparty account: %w", err)
+	}
+
+	username := payload.Username
+	if username == "" {
+		username = account.Owner
+	}
+	user, err := processor.store.GetUser(ctx, username)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	prefs, err := processor.store.GetNotificationPreferencesOrDefault(ctx, user.Username)
+	if err != nil {
+		return fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+	if !prefs.TransferEmail && !prefs.TransferInApp {
+		l.Info("transfer notifications disabled",
+			zap.String("username", user.Username),
+			zap.Int64("transfer_id", transfer.ID),
+		)
+		return nil
+	}
+
+	data := mail.TransferData{
+		FullName:              user.FullName,
+		Amount:                util.FormatMoney(transfer.Amount, account.Currency),
+		AccountID:             account.ID,
+		Counterparty:          counterparty.Owner,
+		CounterpartyAccountID: counterparty.ID,
+		TransferID:            transfer.ID,
+	}
+
+	msg, err := processor.templates.Render(templateName, user.Locale, data)
+	if err != nil {
+		return fmt.Errorf("failed to render transfer email: %w", err)
+	}
+
+	var notification db.Notification
+	if prefs.TransferInApp {
+		body, err := processor.templates.Translate(user.Locale, templateName+".summary",
+			data.Amount, data.AccountID, data.Counterparty, data.CounterpartyAccountID)
+		if err != nil {
+			return fmt.Errorf("failed to render notification: %w", err)
+		}
+
+		notification, err = processor.store.CreateNotification(ctx, db.CreateNotificationParams{
+			Username:   user.Username,
+			Type:       notificationType,
+			Title:      msg.Subject,
+			Body:       body,
+			TransferID: pgtype.Int8{Int64: transfer.ID, Valid: true},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create notification: %w", err)
+		}
+
+		if notification.EmailedAt.Valid {
+			// An earlier attempt already sent the email.
+			return nil
+		}
+	}
+
+	if prefs.TransferEmail {
+		msg.To = []string{user.Email}
+		if err := processor.mailer.SendMessage(msg); err != nil {
+			return fmt.Errorf("failed to send transfer email: %w", err)
+		}
+
+		if notification.ID != 0 {
+			if err := processor.store.MarkNotificationEmailed(ctx, notification.ID); err != nil {
+				return fmt.Errorf("failed to mark notification emailed: %w", err)
+			}
+		}
+	}
+
+	l.Info("processed task",
+		zap.String("type", t.Type()),
+		zap.ByteString("payload", t.Payload()),
+		zap.String("username", user.Username),
+		zap.Int64("notification_id", notification.ID),
+	)
+
+	return nil
+}
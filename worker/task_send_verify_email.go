@@ -5,9 +5,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	db "github.com/a7medalyapany/GoBank.git/db/sqlc"
 	"github.com/a7medalyapany/GoBank.git/logger"
+	"github.com/a7medalyapany/GoBank.git/mail"
 	"github.com/a7medalyapany/GoBank.git/util"
 	"github.com/hibiken/asynq"
 	"github.com/jackc/pgx/v5"
@@ -21,28 +23,12 @@ func (distributor *RedisTaskDistributor) DistributeTaskSendVerifyEmail(
 	payload *PayloadSendVerifyEmail,
 	opts ...asynq.Option,
 ) error {
-	l := logger.G()
-
 	jsonPayload, err := json.Marshal(payload)
 	if err != nil {
 		return fmt.Errorf("failed to marshal task payload: %w", err)
 	}
 
-	t := asynq.NewTask(TaskSendVerifyEmail, jsonPayload, opts...)
-
-	info, err := distributor.client.EnqueueContext(ctx, t)
-	if err != nil {
-		return fmt.Errorf("failed to enqueue task: %w", err)
-	}
-
-	l.Info("enqueued task",
-		zap.String("type", t.Type()),
-		zap.ByteString("payload", t.Payload()),
-		zap.String("queue", info.Queue),
-		zap.Int("max_retry", info.MaxRetry),
-	)
-
-	return nil
+	return distributor.DistributeTask(ctx, TaskSendVerifyEmail, jsonPayload, opts...)
 }
 
 // ─── Process (consumer side)
@@ -64,14 +50,33 @@ func (processor *RedisTaskProcessor) ProcessTaskSendVerifyEmail(ctx context.Cont
 		return fmt.Errorf("failed to get user: %w", err)
 	}
 
-	 verifyEmail, err := processor.store.CreateVerifyEmail(ctx, db.CreateVerifyEmailParams{
-        Username:   user.Username,
-        Email:      user.Email,
-        SecretCode: util.RandomString(32),
-    })
-    if err != nil {
-        return fmt.Errorf("failed to create verify email: %w", err)
-    }
+	var verifyEmail db.VerifyEmail
+	if payload.VerifyEmailID != 0 {
+		verifyEmail, err = processor.store.GetVerifyEmail(ctx, payload.VerifyEmailID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("verify email does not exist: %w", asynq.SkipRetry)
+			}
+			return fmt.Errorf("failed to get verify email: %w", err)
+		}
+		if verifyEmail.IsUsed || !verifyEmail.ExpiresAt.Time.After(time.Now()) {
+			// A newer code replaced this one, or it was used, before we got to it.
+			l.Info("skipping stale verify email",
+				zap.String("username", user.Username),
+				zap.Int64("verify_email_id", verifyEmail.ID),
+			)
+			return nil
+		}
+	} else {
+		verifyEmail, err = processor.store.CreateVerifyEmail(ctx, db.CreateVerifyEmailParams{
+			Username:   user.Username,
+			Email:      user.Email,
+			SecretCode: util.RandomString(32),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create verify email: %w", err)
+		}
+	}
 
 
     // Build verification URL
@@ -79,17 +84,17 @@ func (processor *RedisTaskProcessor) ProcessTaskSendVerifyEmail(ctx context.Cont
     verifyURL := fmt.Sprintf("%s/verify-email?email_id=%d&secret_code=%s", // redirects to frontend page, which then calls backend API to verify email
     processor.config.BASE_URL, verifyEmail.ID, verifyEmail.SecretCode)
 		
-    subject := "Welcome to GoBank — please verify your email"
-	content := fmt.Sprintf(`Hi %s,
-
-	Thanks for registering. Click the link below to verify your email address:
-
-	%s
-
-	This link expires in 15 minutes. If you didn't create this account, ignore this email.
-	`, user.FullName, verifyURL)
+    msg, err := processor.templates.Render(mail.TemplateVerifyEmail, user.Locale, mail.VerifyEmailData{
+		FullName:         user.FullName,
+		VerifyURL:        verifyURL,
+		ExpiresInMinutes: int(verifyEmail.ExpiresAt.Time.Sub(verifyEmail.CreatedAt.Time).Minutes()),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to render verification email: %w", err)
+	}
+	msg.To = []string{user.Email}
 
-    err = processor.mailer.SendEmail(subject, content, []string{user.Email}, nil, nil, nil)
+    err = processor.mailer.SendMessage(msg)
     if err != nil {
         return fmt.Errorf("failed to send verification email: %w", err)
     }
@@ -0,0 +1,52 @@
+package worker
+
+import (
+	"context"
+
+	"github.com/a7medalyapany/GoBank.git/tracing"
+	"github.com/hibiken/asynq"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func messagingAttributes(taskType string) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		semconv.MessagingSystemKey.String("asynq"),
+		attribute.String("messaging.asynq.task_type", taskType),
+	}
+}
+
+// traceTask is asynq middleware that continues the trace recorded in the task
+// headers by DistributeTask, so a task shows up under the request that
+// queued it.
+func traceTask(next asynq.Handler) asynq.Handler {
+	return asynq.HandlerFunc(func(ctx context.Context, t *asynq.Task) error {
+		ctx = tracing.Extract(ctx, t.Headers())
+
+		attrs := messagingAttributes(t.Type())
+		if id, ok := asynq.GetTaskID(ctx); ok {
+			attrs = append(attrs, semconv.MessagingMessageID(id))
+		}
+		if queue, ok := asynq.GetQueueName(ctx); ok {
+			attrs = append(attrs, semconv.MessagingDestinationName(queue))
+		}
+		if retried, ok := asynq.GetRetryCount(ctx); ok {
+			attrs = append(attrs, attribute.Int("messaging.asynq.retry_count", retried))
+		}
+
+		ctx, span := tracing.Tracer().Start(ctx, t.Type()+" process",
+			trace.WithSpanKind(trace.SpanKindConsumer),
+			trace.WithAttributes(attrs...),
+		)
+		defer span.End()
+
+		err := next.ProcessTask(ctx, t)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		return err
+	})
+}